@@ -0,0 +1,129 @@
+package zomato
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache keeps successful API responses in memory for use with Intercept.
+//
+// Cached responses are shared by callers and must not be modified.
+// Cache is safe for use by multiple go routines.
+type Cache struct {
+	// TTL is how long responses are kept; 0 keeps them until purged
+	TTL time.Duration
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	resp    interface{}
+	expires time.Time
+}
+
+// NewCache returns a cache keeping responses for 'ttl'.
+//
+//	api := zomato.Intercept(client, zomato.NewCache(time.Hour).Intercept)
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{TTL: ttl, Now: time.Now}
+}
+
+// Intercept implements Interceptor, answering calls from the cache.
+func (c *Cache) Intercept(ctx context.Context, method string, req Requester,
+	invoke func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	key, ok := requestKey(method, req)
+	if !ok {
+		return invoke(ctx)
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	e, found := c.entries[key]
+	c.mu.Unlock()
+	if found && (e.expires.IsZero() || now.Before(e.expires)) {
+		return e.resp, nil
+	}
+
+	resp, err := invoke(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	e = cacheEntry{resp: resp}
+	if c.TTL > 0 {
+		e.expires = now.Add(c.TTL)
+	}
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]cacheEntry)
+	}
+	c.entries[key] = e
+	c.mu.Unlock()
+	return resp, nil
+}
+
+// Len returns the number of cached responses, including expired ones.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Purge removes expired responses, or all responses if 'all' is true.
+func (c *Cache) Purge(all bool) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.entries {
+		if all || (!e.expires.IsZero() && !now.Before(e.expires)) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *Cache) now() time.Time {
+	if c.Now == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// Logger returns an Interceptor logging each call with its duration and
+// error to 'l'.
+//
+//	api := zomato.Intercept(client, zomato.Logger(log.New(os.Stderr, "zomato: ", log.LstdFlags)))
+func Logger(l *log.Logger) Interceptor {
+	return func(ctx context.Context, method string, req Requester,
+		invoke func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		start := time.Now()
+		resp, err := invoke(ctx)
+		took := time.Since(start).Round(time.Millisecond)
+
+		call := method
+		if r, rerr := req.Request(); rerr == nil {
+			call += " " + strings.TrimPrefix(r.URL.String(), DefaultBaseURL)
+		}
+		if err != nil {
+			l.Printf("%s failed after %s: %v", call, took, err)
+		} else {
+			l.Printf("%s took %s", call, took)
+		}
+		return resp, err
+	}
+}
+
+// requestKey returns the key of a call to 'method' with 'req', and whether
+// the request is valid.
+func requestKey(method string, req Requester) (string, bool) {
+	r, err := req.Request()
+	if err != nil {
+		return "", false
+	}
+	return method + " " + r.URL.String(), true
+}
@@ -0,0 +1,126 @@
+package zomato_test
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/zomatotest"
+	"github.com/pkg/errors"
+)
+
+func TestIntercept(t *testing.T) {
+	name := "Karim's"
+	m := &zomatotest.Mock{
+		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+			return zomato.Restaurant{ID: &restaurantID, Name: &name}, nil
+		},
+	}
+
+	var methods []string
+	var reqs []zomato.Requester
+	api := zomato.Intercept(m, func(ctx context.Context, method string, req zomato.Requester,
+		invoke func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		methods = append(methods, method)
+		reqs = append(reqs, req)
+		return invoke(ctx)
+	})
+
+	r, err := api.Restaurant(context.Background(), 463)
+	if err != nil || *r.ID != 463 || *r.Name != name {
+		t.Errorf("Restaurant = %+v, %v", r, err)
+	}
+	if _, err := api.Categories(context.Background()); errors.Cause(err) != zomatotest.ErrNotMocked {
+		t.Errorf("Categories error = %v, want ErrNotMocked", err)
+	}
+
+	if strings.Join(methods, ",") != "Restaurant,Categories" {
+		t.Errorf("methods = %v", methods)
+	}
+	if reqs[0] != (zomato.RestaurantReq{RestaurantID: 463}) || reqs[1] != (zomato.CategoriesReq{}) {
+		t.Errorf("requests = %+v", reqs)
+	}
+}
+
+func TestCache(t *testing.T) {
+	var calls int
+	fail := false
+	m := &zomatotest.Mock{
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			calls++
+			if fail {
+				return zomato.SearchResp{}, errors.New("quota exceeded")
+			}
+			return zomato.SearchResp{ResultsFound: int64(calls)}, nil
+		},
+	}
+
+	now := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	cache := zomato.NewCache(time.Hour)
+	cache.Now = func() time.Time { return now }
+	api := zomato.Intercept(m, cache.Intercept)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		req     zomato.SearchReq
+		advance time.Duration
+		fail    bool
+		found   int64
+		calls   int
+		err     bool
+	}{
+		{"miss", zomato.SearchReq{Query: "biryani"}, 0, false, 1, 1, false},
+		{"hit", zomato.SearchReq{Query: "biryani"}, 30 * time.Minute, false, 1, 1, false},
+		{"other request", zomato.SearchReq{Query: "kebab"}, 0, false, 2, 2, false},
+		{"expired", zomato.SearchReq{Query: "biryani"}, time.Hour, false, 3, 3, false},
+		{"error", zomato.SearchReq{Query: "pizza"}, 0, true, 0, 4, true},
+		{"error not cached", zomato.SearchReq{Query: "pizza"}, 0, false, 5, 5, false},
+	}
+	for _, tt := range tests {
+		now = now.Add(tt.advance)
+		fail = tt.fail
+		resp, err := api.Search(ctx, tt.req)
+		if (err != nil) != tt.err || resp.ResultsFound != tt.found || calls != tt.calls {
+			t.Errorf("%s: found %d, calls %d, error %v; want %d, %d", tt.name, resp.ResultsFound, calls, err, tt.found, tt.calls)
+		}
+	}
+
+	if cache.Len() != 3 {
+		t.Errorf("cache has %d responses, want 3", cache.Len())
+	}
+	now = now.Add(59 * time.Minute)
+	cache.Purge(false)
+	if cache.Len() != 2 {
+		t.Errorf("cache has %d responses after purging expired, want 2", cache.Len())
+	}
+	cache.Purge(true)
+	if cache.Len() != 0 {
+		t.Errorf("cache has %d responses after purging all, want 0", cache.Len())
+	}
+}
+
+func TestLogger(t *testing.T) {
+	m := &zomatotest.Mock{
+		DailyMenuFunc: func(ctx context.Context, restaurantID int64) (zomato.DailyMenuResp, error) {
+			return zomato.DailyMenuResp{}, nil
+		},
+	}
+	var buf bytes.Buffer
+	api := zomato.Intercept(m, zomato.Logger(log.New(&buf, "", 0)))
+
+	api.DailyMenu(context.Background(), 463)
+	api.Cuisines(context.Background(), zomato.CuisinesReq{CityID: 1})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 ||
+		!strings.HasPrefix(lines[0], "DailyMenu /v2.1/dailymenu?res_id=463 took ") ||
+		!strings.HasPrefix(lines[1], "Cuisines /v2.1/cuisines?city_id=1 failed after ") ||
+		!strings.HasSuffix(lines[1], "Cuisines: zomatotest: method not mocked") {
+		t.Errorf("log = %q", buf.String())
+	}
+}
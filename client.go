@@ -46,7 +46,10 @@ func WithCtx(ctx context.Context, r Requester) Requester {
 	}
 	return RequesterFunc(func() (*http.Request, error) {
 		req, err := r.Request()
-		return req.WithContext(ctx), err
+		if err != nil {
+			return req, err
+		}
+		return req.WithContext(ctx), nil
 	})
 }
 
@@ -2,6 +2,7 @@ package zomato_test
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -136,3 +137,21 @@ func (mr mockRequester) Request() (*http.Request, error) { return mr() }
 type mockTransport func(*http.Request) (*http.Response, error)
 
 func (mt mockTransport) RoundTrip(r *http.Request) (*http.Response, error) { return mt(r) }
+
+func TestWithCtx(t *testing.T) {
+	r := zomato.WithCtx(context.Background(), zomato.RestaurantReq{})
+
+	if _, err := r.Request(); err == nil || !strings.Contains(err.Error(), "invalid request") {
+		t.Fatalf("expected invalid request error, actual `%v`", err)
+	}
+
+	r = zomato.WithCtx(context.Background(), zomato.RestaurantReq{RestaurantID: 463})
+
+	req, err := r.Request()
+	if err != nil {
+		t.Fatalf("Request failed: %+v", err)
+	}
+	if req.Context() != context.Background() {
+		t.Fatal("context not applied to request")
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/go-india/zomato/cmd/zomato-proxy
+
+go 1.26.0
+
+require (
+	github.com/go-india/zomato v0.0.0
+	github.com/pkg/errors v0.9.1
+	golang.org/x/sync v0.23.0
+	golang.org/x/time v0.16.0
+)
+
+require (
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/leodido/go-urn v1.2.4 // indirect
+	gopkg.in/go-playground/validator.v9 v9.31.0 // indirect
+)
+
+replace github.com/go-india/zomato => ../..
@@ -0,0 +1,183 @@
+// Command zomato-proxy serves the Zomato API to internal callers, sharing
+// API keys, a response cache and the quota among them.
+//
+// Usage:
+//
+//	zomato-proxy [-addr :8080] [-keys KEY,...] [-ttl 1h] [-rate 1] [-callers FILE]
+//
+// Requests to /api/v2.1/* are forwarded with the same path and query
+// through a zomato.Client holding one of the server-side keys, used in
+// turn, so callers need no key of their own. Successful responses are
+// cached for -ttl, concurrent requests for the same data share a single
+// API call and API calls are limited to -rate per second.
+//
+// Callers are named by the X-Caller header or their address. With a
+// -callers JSON file mapping tokens to names, callers must send their
+// token in the X-Proxy-Token header or as a bearer token. Each caller may
+// be limited to -caller-rate requests per second. GET /usage reports the
+// requests, cache hits and API calls of each caller.
+//
+// API keys are read from the -keys flag or the ZOMATO_API_KEYS
+// environment variable as a comma separated list, or ZOMATO_API_KEY.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// Environment variables holding API keys
+const (
+	EnvAPIKeys = "ZOMATO_API_KEYS"
+	EnvAPIKey  = "ZOMATO_API_KEY"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Getenv, os.Stderr, nil)
+	switch errors.Cause(err) {
+	case nil, flag.ErrHelp:
+	default:
+		fmt.Fprintln(os.Stderr, "zomato-proxy:", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the proxy configured by 'args' until 'ctx' is done.
+//
+// If 'ready' is not nil, it receives the listening address.
+func run(ctx context.Context, args []string, getenv func(string) string,
+	stderr io.Writer, ready chan<- string) error {
+	var (
+		fs          = flag.NewFlagSet("zomato-proxy", flag.ContinueOnError)
+		addr        = fs.String("addr", ":8080", "listen address")
+		keys        = fs.String("keys", "", "comma separated API keys; overrides "+EnvAPIKeys+" and "+EnvAPIKey)
+		upstream    = fs.String("upstream", "", "base URL of the API (default "+zomato.DefaultBaseURL+")")
+		ttl         = fs.Duration("ttl", time.Hour, "how long responses are cached; 0 keeps them")
+		apiRate     = fs.Float64("rate", 1, "API calls per second; 0 is unlimited")
+		apiBurst    = fs.Int("burst", 5, "API calls allowed at once")
+		callerRate  = fs.Float64("caller-rate", 0, "requests per second of each caller; 0 is unlimited")
+		callerBurst = fs.Int("caller-burst", 10, "requests of each caller allowed at once")
+		callersPath = fs.String("callers", "", "JSON file mapping caller tokens to names")
+		timeout     = fs.Duration("timeout", 15*time.Second, "API call timeout")
+	)
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	p, err := newProxy(*keys, *upstream, *timeout, getenv)
+	if err != nil {
+		return err
+	}
+	p.cache = zomato.NewCache(*ttl)
+	if *apiRate > 0 {
+		p.upstream = rate.NewLimiter(rate.Limit(*apiRate), *apiBurst)
+	}
+	p.callerRate, p.callerBurst = rate.Limit(*callerRate), *callerBurst
+	if *callersPath != "" {
+		if p.callers, err = loadCallers(*callersPath); err != nil {
+			return err
+		}
+	}
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return errors.Wrap(err, "listen failed")
+	}
+	logger := log.New(stderr, "zomato-proxy: ", log.LstdFlags)
+	logger.Printf("serving %d keys on %s", len(p.clients), l.Addr())
+	if ready != nil {
+		ready <- l.Addr().String()
+	}
+
+	srv := &http.Server{Handler: p, ReadHeaderTimeout: 10 * time.Second, ErrorLog: logger}
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(l) }()
+
+	purge := time.NewTicker(time.Minute)
+	defer purge.Stop()
+	for {
+		select {
+		case err := <-done:
+			return errors.Wrap(err, "serve failed")
+		case <-purge.C:
+			p.cache.Purge(false)
+		case <-ctx.Done():
+			shutdown, cancel := context.WithTimeout(context.Background(), *timeout)
+			defer cancel()
+			err := srv.Shutdown(shutdown)
+			for _, name := range p.callerNames() {
+				p.account(name, func(u *Usage) {
+					logger.Printf("%s: %d requests, %d cache hits, %d coalesced, %d API calls, %d errors, %d rate limited",
+						name, u.Requests, u.Hits, u.Coalesced, u.Upstream, u.Errors, u.RateLimited)
+				})
+			}
+			return errors.Wrap(err, "shutdown failed")
+		}
+	}
+}
+
+// newProxy returns a proxy with a client for each API key, bounding API
+// calls by 'timeout'.
+func newProxy(keys, upstream string, timeout time.Duration, getenv func(string) string) (*proxy, error) {
+	if keys == "" {
+		if keys = getenv(EnvAPIKeys); keys == "" {
+			keys = getenv(EnvAPIKey)
+		}
+	}
+	base, err := baseURL(upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &proxy{timeout: timeout}
+	for _, key := range strings.Split(keys, ",") {
+		if key = strings.TrimSpace(key); key == "" {
+			continue
+		}
+		c := zomato.NewClient(key)
+		c.BaseURL = base
+		c.UserAgent = zomato.DefaultUserAgent
+		// Clients without their own use and modify http.DefaultClient
+		c.HTTPClient = &http.Client{Timeout: timeout}
+		p.clients = append(p.clients, c)
+	}
+	if len(p.clients) == 0 {
+		return nil, errors.Errorf("no API keys; set %s or use -keys", EnvAPIKeys)
+	}
+	return p, nil
+}
+
+// loadCallers reads the JSON object mapping caller tokens to names at
+// 'path'.
+func loadCallers(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read callers failed")
+	}
+	defer f.Close()
+
+	callers := map[string]string{}
+	if err := json.NewDecoder(f).Decode(&callers); err != nil {
+		return nil, errors.Wrapf(err, "decode callers %s failed", path)
+	}
+	return callers, nil
+}
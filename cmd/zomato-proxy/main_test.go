@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestRun(t *testing.T) {
+	u := &upstream{}
+	srv := httptest.NewServer(u)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ready := make(chan string, 1)
+	var stderr bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		done <- run(ctx, []string{"-addr", "127.0.0.1:0", "-upstream", srv.URL}, func(name string) string {
+			return map[string]string{EnvAPIKey: "env-key"}[name]
+		}, &stderr, ready)
+	}()
+
+	addr := <-ready
+	req, err := http.NewRequest(http.MethodGet, "http://"+addr+"/api/v2.1/restaurant?res_id=463", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(CallerHeader, "orders")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "Karim's") {
+		t.Errorf("GET restaurant = %d %s", resp.StatusCode, body)
+	}
+	if len(u.keys) != 1 || u.keys[0] != "env-key" {
+		t.Errorf("API called with keys %q, want env-key", u.keys)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if want := "orders: 1 requests, 0 cache hits, 0 coalesced, 1 API calls"; !strings.Contains(stderr.String(), want) {
+		t.Errorf("log = %q, want usage %q", stderr.String(), want)
+	}
+}
+
+func TestRunNoKeys(t *testing.T) {
+	err := run(context.Background(), nil, func(string) string { return "" }, ioutil.Discard, nil)
+	if err == nil || !strings.Contains(err.Error(), "no API keys") {
+		t.Errorf("run() = %v, want no API keys error", err)
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	var stderr bytes.Buffer
+	err := run(context.Background(), []string{"-h"}, func(string) string { return "" }, &stderr, nil)
+	if errors.Cause(err) != flag.ErrHelp || !strings.Contains(stderr.String(), "-addr") {
+		t.Errorf("run(-h) = %v with usage %q, want flag.ErrHelp and usage", err, stderr.String())
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+)
+
+// Headers of the proxy
+const (
+	// CacheHeader tells whether a response was HIT in the cache, a MISS
+	// fetched for the request, or SHARED with a concurrent request
+	CacheHeader = "X-Cache"
+	// CallerHeader names the caller when no callers file is configured
+	CallerHeader = "X-Caller"
+	// TokenHeader holds the caller token when a callers file is configured
+	TokenHeader = "X-Proxy-Token"
+)
+
+// apiPrefix is the path prefix of forwarded API requests
+const apiPrefix = "/api/v2.1/"
+
+// Usage is the usage of the proxy by a caller
+type Usage struct {
+	Requests int64 `json:"requests"`
+	// Requests answered from the cache or by another caller's request
+	Hits      int64 `json:"cache_hits"`
+	Coalesced int64 `json:"coalesced"`
+	// Upstream is the number of API calls made for the caller
+	Upstream    int64            `json:"upstream_calls"`
+	Errors      int64            `json:"errors"`
+	RateLimited int64            `json:"rate_limited"`
+	Endpoints   map[string]int64 `json:"endpoints"`
+}
+
+// proxy forwards API requests through clients holding server-side keys
+type proxy struct {
+	// clients are used in turn, one per API key
+	clients []zomato.Client
+	cache   *zomato.Cache
+	// upstream limits API calls; unlimited if nil
+	upstream *rate.Limiter
+	// callerRate and callerBurst limit the requests of each caller;
+	// unlimited if callerRate is 0
+	callerRate  rate.Limit
+	callerBurst int
+	// callers maps tokens to caller names; callers are trusted to name
+	// themselves if nil
+	callers map[string]string
+	// timeout bounds API calls
+	timeout time.Duration
+
+	next  uint32
+	group singleflight.Group
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	usage    map[string]*Usage
+}
+
+// ServeHTTP implements http.Handler, serving API paths and the usage report.
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, apiPrefix):
+		p.forward(w, r)
+	case r.URL.Path == "/usage":
+		p.report(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// forward answers an API request from the cache, a concurrent request for
+// the same data or the API.
+func (p *proxy) forward(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	caller, ok := p.caller(r)
+	if !ok {
+		http.Error(w, "unknown caller", http.StatusUnauthorized)
+		return
+	}
+	endpoint := path.Base(r.URL.Path)
+	p.account(caller, func(u *Usage) {
+		u.Requests++
+		u.Endpoints[endpoint]++
+	})
+
+	if l := p.limiter(caller); l != nil && !l.Allow() {
+		p.account(caller, func(u *Usage) { u.RateLimited++ })
+		res := l.Reserve()
+		delay := res.Delay()
+		res.Cancel()
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return
+	}
+
+	// Keys of callers are never forwarded
+	values := r.URL.Query()
+	values.Del("apikey")
+	req := zomato.RequesterFunc(func() (*http.Request, error) {
+		u := zomato.DefaultBaseURL + strings.TrimPrefix(r.URL.Path, "/api")
+		if q := values.Encode(); q != "" {
+			u += "?" + q
+		}
+		return http.NewRequest(http.MethodGet, u, nil)
+	})
+	raw, status, err := p.fetch(endpoint, req)
+	switch status {
+	case "MISS":
+		p.account(caller, func(u *Usage) { u.Upstream++ })
+	case "HIT":
+		p.account(caller, func(u *Usage) { u.Hits++ })
+	case "SHARED":
+		p.account(caller, func(u *Usage) { u.Coalesced++ })
+	}
+	w.Header().Set(CacheHeader, status)
+
+	if apiErr, ok := errors.Cause(err).(*zomato.ErrAPI); ok {
+		p.account(caller, func(u *Usage) { u.Errors++ })
+		if ct := apiErr.Header.Get("Content-Type"); ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+		w.WriteHeader(apiErr.StatusCode)
+		w.Write(apiErr.Body)
+		return
+	}
+	if err != nil {
+		p.account(caller, func(u *Usage) { u.Errors++ })
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(raw)
+}
+
+// fetch returns the response to 'req' and whether it was a cache HIT, a MISS
+// or SHARED with a concurrent request.
+func (p *proxy) fetch(endpoint string, req zomato.Requester) (json.RawMessage, string, error) {
+	r, err := req.Request()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "generate HTTP request failed")
+	}
+
+	var leader, fetched bool
+	v, err, _ := p.group.Do(r.URL.String(), func() (interface{}, error) {
+		leader = true
+		// Calls outlive the request that started them, as others may share
+		// their result
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		defer cancel()
+
+		return p.cache.Intercept(ctx, endpoint, req, func(ctx context.Context) (interface{}, error) {
+			fetched = true
+			if p.upstream != nil {
+				if err := p.upstream.Wait(ctx); err != nil {
+					return nil, errors.Wrap(err, "wait for rate limit failed")
+				}
+			}
+			c := p.clients[int(atomic.AddUint32(&p.next, 1)-1)%len(p.clients)]
+			var raw json.RawMessage
+			err := c.Do(c.Auth(zomato.WithCtx(ctx, req)), &raw)
+			return raw, errors.Wrap(err, "Client.Do failed")
+		})
+	})
+
+	status := "SHARED"
+	if leader {
+		status = "HIT"
+		if fetched {
+			status = "MISS"
+		}
+	}
+	raw, _ := v.(json.RawMessage)
+	return raw, status, err
+}
+
+// report writes the usage of each caller as JSON.
+func (p *proxy) report(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	data, err := json.MarshalIndent(p.usage, "", "  ")
+	p.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// caller returns the name of the caller of 'r', and false if it is unknown.
+func (p *proxy) caller(r *http.Request) (string, bool) {
+	if p.callers != nil {
+		token := r.Header.Get(TokenHeader)
+		if token == "" {
+			token = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		}
+		name, ok := p.callers[token]
+		return name, ok && token != ""
+	}
+
+	if name := r.Header.Get(CallerHeader); name != "" {
+		return name, true
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr, true
+	}
+	return host, true
+}
+
+// limiter returns the rate limiter of 'caller', or nil if callers are not
+// limited.
+func (p *proxy) limiter(caller string) *rate.Limiter {
+	if p.callerRate == 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.limiters == nil {
+		p.limiters = make(map[string]*rate.Limiter)
+	}
+	l, ok := p.limiters[caller]
+	if !ok {
+		l = rate.NewLimiter(p.callerRate, p.callerBurst)
+		p.limiters[caller] = l
+	}
+	return l
+}
+
+// account updates the usage of 'caller' with 'f'.
+func (p *proxy) account(caller string, f func(u *Usage)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.usage == nil {
+		p.usage = make(map[string]*Usage)
+	}
+	u, ok := p.usage[caller]
+	if !ok {
+		u = &Usage{Endpoints: map[string]int64{}}
+		p.usage[caller] = u
+	}
+	f(u)
+}
+
+// callerNames returns the names of the callers that used the proxy.
+func (p *proxy) callerNames() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	names := make([]string, 0, len(p.usage))
+	for name := range p.usage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// baseURL parses 'raw' for Client.BaseURL, returning nil if it is empty.
+func baseURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(raw)
+	return u, errors.Wrapf(err, "invalid upstream %q", raw)
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"golang.org/x/time/rate"
+)
+
+// fixtures maps API paths to testdata files
+var fixtures = map[string]string{
+	"/api/v2.1/categories": "Categories.json",
+	"/api/v2.1/restaurant": "Restaurant.json",
+	"/api/v2.1/reviews":    "Reviews.json",
+	"/api/v2.1/search":     "Search.json",
+}
+
+// upstream is a fake API serving testdata files and recording requests
+type upstream struct {
+	mu   sync.Mutex
+	keys []string
+	urls []string
+	// gate blocks responses until closed if not nil
+	gate chan struct{}
+}
+
+func (u *upstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	u.mu.Lock()
+	u.keys = append(u.keys, r.Header.Get("user-key"))
+	u.urls = append(u.urls, r.URL.String())
+	gate := u.gate
+	u.mu.Unlock()
+	if gate != nil {
+		<-gate
+	}
+
+	if r.URL.Path == "/api/v2.1/restaurant" && r.URL.Query().Get("res_id") == "1" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":404,"status":"","message":"Invalid or missing restaurant id"}`))
+		return
+	}
+	data, err := ioutil.ReadFile(filepath.Join("../../testdata", fixtures[r.URL.Path]))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Write(data)
+}
+
+func (u *upstream) calls() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.urls)
+}
+
+// newTestProxy returns a proxy with keys "k1" and "k2" forwarding to a fake
+// API.
+func newTestProxy(t *testing.T) (*proxy, *upstream) {
+	u := &upstream{}
+	srv := httptest.NewServer(u)
+	t.Cleanup(srv.Close)
+
+	p, err := newProxy("k1, k2", srv.URL, 15*time.Second, func(string) string { return "" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.cache = zomato.NewCache(time.Hour)
+	return p, u
+}
+
+// get requests 'target' from 'p' as 'caller'.
+func get(p *proxy, target, caller string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	if caller != "" {
+		r.Header.Set(CallerHeader, caller)
+	}
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, r)
+	return w
+}
+
+func TestProxyForward(t *testing.T) {
+	p, u := newTestProxy(t)
+
+	tests := []struct {
+		target string
+		status int
+		cache  string
+		calls  int
+	}{
+		{"/api/v2.1/restaurant?res_id=463", http.StatusOK, "MISS", 1},
+		{"/api/v2.1/restaurant?res_id=463", http.StatusOK, "HIT", 1},
+		{"/api/v2.1/search?q=kachori&entity_id=1", http.StatusOK, "MISS", 2},
+		// Same query in another order and with a caller key
+		{"/api/v2.1/search?entity_id=1&q=kachori&apikey=mine", http.StatusOK, "HIT", 2},
+		{"/api/v2.1/restaurant?res_id=1", http.StatusNotFound, "MISS", 3},
+		// Errors are not cached
+		{"/api/v2.1/restaurant?res_id=1", http.StatusNotFound, "MISS", 4},
+		{"/api/v2.1/categories", http.StatusOK, "MISS", 5},
+		{"/other", http.StatusNotFound, "", 5},
+	}
+	for _, tt := range tests {
+		w := get(p, tt.target, "orders")
+		if w.Code != tt.status || w.Header().Get(CacheHeader) != tt.cache {
+			t.Errorf("GET %s = %d %s %q, want %d %s", tt.target, w.Code, CacheHeader, w.Header().Get(CacheHeader), tt.status, tt.cache)
+		}
+		if calls := u.calls(); calls != tt.calls {
+			t.Errorf("GET %s made %d API calls in all, want %d", tt.target, calls, tt.calls)
+		}
+	}
+
+	w := get(p, "/api/v2.1/restaurant?res_id=463", "")
+	var r zomato.Restaurant
+	if err := json.Unmarshal(w.Body.Bytes(), &r); err != nil || r.Name == nil || *r.Name != "Karim's" {
+		t.Errorf("GET restaurant = %s, %v, want Karim's", w.Body, err)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	if !strings.Contains(get(p, "/api/v2.1/restaurant?res_id=1", "").Body.String(), "Invalid or missing restaurant id") {
+		t.Error("API error body not relayed")
+	}
+
+	// Keys are used in turn and caller keys dropped
+	for i, key := range u.keys {
+		if want := []string{"k1", "k2"}[i%2]; key != want {
+			t.Errorf("call %d used key %q, want %q", i, key, want)
+		}
+	}
+	for _, url := range u.urls {
+		if strings.Contains(url, "apikey") {
+			t.Errorf("caller key forwarded in %s", url)
+		}
+	}
+
+	r2 := httptest.NewRequest(http.MethodPost, "/api/v2.1/search", nil)
+	w = httptest.NewRecorder()
+	p.ServeHTTP(w, r2)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestProxyCoalesce(t *testing.T) {
+	p, u := newTestProxy(t)
+	u.gate = make(chan struct{})
+
+	const n = 5
+	var wg sync.WaitGroup
+	statuses := make(chan string, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := get(p, "/api/v2.1/reviews?res_id=463", "orders")
+			if w.Code != http.StatusOK {
+				t.Errorf("GET reviews = %d", w.Code)
+			}
+			statuses <- w.Header().Get(CacheHeader)
+		}()
+	}
+
+	// Let the requests join the first one before it completes
+	for u.calls() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(u.gate)
+	wg.Wait()
+	close(statuses)
+
+	counts := map[string]int{}
+	for s := range statuses {
+		counts[s]++
+	}
+	if u.calls() != 1 || counts["MISS"] != 1 || counts["SHARED"] != n-1 {
+		t.Errorf("made %d API calls with statuses %v, want 1 MISS and %d SHARED", u.calls(), counts, n-1)
+	}
+}
+
+func TestProxyConcurrent(t *testing.T) {
+	defaultClient := *http.DefaultClient
+	p, u := newTestProxy(t)
+
+	// Distinct requests call the API at once; run with -race
+	const n = 20
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			target := fmt.Sprintf("/api/v2.1/restaurant?res_id=%d", 1000+i)
+			if w := get(p, target, "orders"); w.Code != http.StatusOK {
+				t.Errorf("GET %s = %d", target, w.Code)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if u.calls() != n {
+		t.Errorf("made %d API calls, want %d", u.calls(), n)
+	}
+	for i, c := range p.clients {
+		if c.HTTPClient == nil || c.HTTPClient == http.DefaultClient || c.HTTPClient.Timeout != 15*time.Second {
+			t.Errorf("client %d HTTPClient = %+v, want its own with the proxy timeout", i, c.HTTPClient)
+		}
+	}
+	if http.DefaultClient.Timeout != defaultClient.Timeout || http.DefaultClient.Transport != defaultClient.Transport {
+		t.Error("proxy modified http.DefaultClient")
+	}
+}
+
+func TestProxyCallers(t *testing.T) {
+	p, _ := newTestProxy(t)
+	p.callers = map[string]string{"t1": "orders", "t2": "search"}
+	p.callerRate, p.callerBurst = rate.Every(time.Hour), 2
+
+	tests := []struct {
+		header string
+		value  string
+		status int
+	}{
+		{TokenHeader, "t1", http.StatusOK},
+		{"Authorization", "Bearer t1", http.StatusOK},
+		{TokenHeader, "t1", http.StatusTooManyRequests},
+		{TokenHeader, "t2", http.StatusOK},
+		{TokenHeader, "t3", http.StatusUnauthorized},
+		{CallerHeader, "orders", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/api/v2.1/categories", nil)
+		r.Header.Set(tt.header, tt.value)
+		w := httptest.NewRecorder()
+		p.ServeHTTP(w, r)
+		if w.Code != tt.status {
+			t.Errorf("GET with %s %q = %d, want %d", tt.header, tt.value, w.Code, tt.status)
+		}
+		if w.Code == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+			t.Error("no Retry-After when rate limited")
+		}
+	}
+
+	w := get(p, "/usage", "")
+	var usage map[string]Usage
+	if err := json.Unmarshal(w.Body.Bytes(), &usage); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Usage{
+		"orders": {Requests: 3, Hits: 1, Upstream: 1, RateLimited: 1, Endpoints: map[string]int64{"categories": 3}},
+		"search": {Requests: 1, Hits: 1, Endpoints: map[string]int64{"categories": 1}},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("usage = %+v, want %+v", usage, want)
+	}
+}
@@ -0,0 +1,653 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+)
+
+// Page sizes used by the browser
+const (
+	searchPageSize  = 20
+	reviewsPageSize = 5
+)
+
+// session caches client responses for the lifetime of a browser so that
+// revisiting a location, restaurant or review page does not make API calls.
+type session struct {
+	client zomato.Client
+	cache  map[string]interface{}
+	// calls is the number of API calls made
+	calls int
+}
+
+func newSession(client zomato.Client) *session {
+	return &session{client: client, cache: make(map[string]interface{})}
+}
+
+// get returns the cached response of request 'req', calling 'fetch' on a
+// cache miss. Failed calls are not cached.
+func (s *session) get(req interface{}, fetch func() (interface{}, error)) (interface{}, error) {
+	key := fmt.Sprintf("%T%+v", req, req)
+	if resp, ok := s.cache[key]; ok {
+		return resp, nil
+	}
+
+	s.calls++
+	resp, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = resp
+	return resp, nil
+}
+
+func (s *session) locations(ctx context.Context, req zomato.LocationsReq) (zomato.LocationsResp, error) {
+	resp, err := s.get(req, func() (interface{}, error) { return s.client.Locations(ctx, req) })
+	if err != nil {
+		return zomato.LocationsResp{}, err
+	}
+	return resp.(zomato.LocationsResp), nil
+}
+
+func (s *session) search(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+	resp, err := s.get(req, func() (interface{}, error) { return s.client.Search(ctx, req) })
+	if err != nil {
+		return zomato.SearchResp{}, err
+	}
+	return resp.(zomato.SearchResp), nil
+}
+
+func (s *session) restaurant(ctx context.Context, req zomato.RestaurantReq) (zomato.Restaurant, error) {
+	resp, err := s.get(req, func() (interface{}, error) { return s.client.Restaurant(ctx, req.RestaurantID) })
+	if err != nil {
+		return zomato.Restaurant{}, err
+	}
+	return resp.(zomato.Restaurant), nil
+}
+
+func (s *session) reviews(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+	resp, err := s.get(req, func() (interface{}, error) { return s.client.Reviews(ctx, req) })
+	if err != nil {
+		return zomato.ReviewsResp{}, err
+	}
+	return resp.(zomato.ReviewsResp), nil
+}
+
+func (s *session) dailyMenu(ctx context.Context, req zomato.DailyMenuReq) (zomato.DailyMenuResp, error) {
+	resp, err := s.get(req, func() (interface{}, error) { return s.client.DailyMenu(ctx, req.RestaurantID) })
+	if err != nil {
+		return zomato.DailyMenuResp{}, err
+	}
+	return resp.(zomato.DailyMenuResp), nil
+}
+
+// screen is a view of the browser
+type screen int
+
+// Browser screens
+const (
+	screenLocations screen = iota
+	screenResults
+	screenDetail
+)
+
+// sortField is a field search results are sorted by
+type sortField int
+
+// Sort fields
+const (
+	sortNone sortField = iota
+	sortRating
+	sortCost
+	sortDistance
+)
+
+var sortNames = []string{"none", "rating", "cost", "distance"}
+
+// pane is a tab of the restaurant detail screen
+type pane int
+
+// Detail panes
+const (
+	paneInfo pane = iota
+	paneReviews
+	paneMenu
+)
+
+var paneNames = []string{"Info", "Reviews", "Daily Menu"}
+
+// browser holds the state of the interactive restaurant browser.
+//
+// Keys are fed to handle and the screen is drawn from view, keeping the
+// browser independent of the terminal.
+type browser struct {
+	ctx     context.Context
+	session *session
+	screen  screen
+	status  string
+	quit    bool
+
+	// Locations screen
+	query     string
+	queried   string
+	locations []zomato.Location
+	locSel    int
+
+	// Results screen
+	location *zomato.Location
+	results  []*zomato.Restaurant
+	found    int64
+	filter   string
+	sortBy   sortField
+	desc     bool
+	visible  []*zomato.Restaurant
+	resSel   int
+
+	// Detail screen
+	restaurant  *zomato.Restaurant
+	pane        pane
+	reviews     *zomato.ReviewsResp
+	reviewStart uint64
+	menu        *zomato.DailyMenuResp
+	menuErr     error
+}
+
+// newBrowser returns a browser looking up locations matching 'query', if any.
+func newBrowser(ctx context.Context, s *session, query string) *browser {
+	b := &browser{ctx: ctx, session: s, query: query, sortBy: sortRating, desc: true}
+	if query != "" {
+		b.searchLocations()
+	}
+	return b
+}
+
+// handle updates the browser on key 'k'.
+func (b *browser) handle(k key) {
+	if k.code == keyQuit {
+		b.quit = true
+		return
+	}
+	b.status = ""
+
+	switch b.screen {
+	case screenLocations:
+		b.handleLocations(k)
+	case screenResults:
+		b.handleResults(k)
+	case screenDetail:
+		b.handleDetail(k)
+	}
+}
+
+func (b *browser) handleLocations(k key) {
+	switch k.code {
+	case keyRune:
+		b.query += string(k.r)
+	case keyBackspace:
+		b.query = dropLast(b.query)
+	case keyUp:
+		b.locSel = clamp(b.locSel-1, len(b.locations))
+	case keyDown:
+		b.locSel = clamp(b.locSel+1, len(b.locations))
+	case keyEsc:
+		b.quit = true
+	case keyEnter:
+		if b.query != b.queried || len(b.locations) == 0 {
+			b.searchLocations()
+			return
+		}
+		b.openLocation(b.locations[b.locSel])
+	}
+}
+
+func (b *browser) searchLocations() {
+	if strings.TrimSpace(b.query) == "" {
+		b.status = "Type a location to search"
+		return
+	}
+
+	resp, err := b.session.locations(b.ctx, zomato.LocationsReq{Query: b.query})
+	b.queried = b.query
+	b.locations, b.locSel = nil, 0
+	if err != nil {
+		b.status = "Locations failed: " + err.Error()
+		return
+	}
+	b.locations = resp.LocationSuggestions
+	if len(b.locations) == 0 {
+		b.status = "No locations found"
+	}
+}
+
+func (b *browser) openLocation(l zomato.Location) {
+	b.location = &l
+	b.results, b.found = nil, 0
+	b.filter, b.resSel = "", 0
+	b.screen = screenResults
+	b.loadResults()
+}
+
+// loadResults fetches the next page of search results of the location.
+func (b *browser) loadResults() {
+	req := zomato.SearchReq{
+		Start: uint64(len(b.results)),
+		Count: searchPageSize,
+	}
+	if b.location.EntityID != nil && b.location.EntityType != nil {
+		req.EntityID = *b.location.EntityID
+		req.EntityType = zomato.EntityType(*b.location.EntityType)
+	}
+
+	resp, err := b.session.search(b.ctx, req)
+	if err != nil {
+		b.status = "Search failed: " + err.Error()
+		return
+	}
+	b.found = resp.ResultsFound
+	for _, r := range resp.Restaurants {
+		if r.Restaurant != nil {
+			b.results = append(b.results, r.Restaurant)
+		}
+	}
+	if len(resp.Restaurants) == 0 {
+		// Search does not page past the last result
+		b.found = int64(len(b.results))
+	}
+	b.refresh()
+}
+
+func (b *browser) handleResults(k key) {
+	switch k.code {
+	case keyRune:
+		b.filter += string(k.r)
+		b.refresh()
+	case keyBackspace:
+		b.filter = dropLast(b.filter)
+		b.refresh()
+	case keyTab:
+		b.sortBy = (b.sortBy + 1) % sortField(len(sortNames))
+		b.desc = b.sortBy == sortRating
+		b.refresh()
+	case keyBacktab:
+		b.desc = !b.desc
+		b.refresh()
+	case keyUp:
+		b.resSel = clamp(b.resSel-1, len(b.visible))
+	case keyDown:
+		if b.resSel == len(b.visible)-1 && int64(len(b.results)) < b.found {
+			b.loadResults()
+		}
+		b.resSel = clamp(b.resSel+1, len(b.visible))
+	case keyPgDn:
+		if int64(len(b.results)) < b.found {
+			b.loadResults()
+		}
+	case keyEsc:
+		b.screen = screenLocations
+	case keyEnter:
+		if len(b.visible) > 0 {
+			b.openRestaurant(b.visible[b.resSel])
+		}
+	}
+}
+
+// refresh filters and sorts the loaded results.
+func (b *browser) refresh() {
+	filter := strings.ToLower(b.filter)
+	b.visible = b.visible[:0]
+	for _, r := range b.results {
+		if filter == "" || strings.Contains(strings.ToLower(searchText(r)), filter) {
+			b.visible = append(b.visible, r)
+		}
+	}
+
+	if b.sortBy != sortNone {
+		key := b.sortKey
+		sort.SliceStable(b.visible, func(i, j int) bool {
+			ki, kj := key(b.visible[i]), key(b.visible[j])
+			if b.desc {
+				return ki > kj
+			}
+			return ki < kj
+		})
+	}
+	b.resSel = clamp(b.resSel, len(b.visible))
+}
+
+// sortKey returns the value restaurant 'r' is sorted by. Missing values sort
+// last in ascending order.
+func (b *browser) sortKey(r *zomato.Restaurant) float64 {
+	switch b.sortBy {
+	case sortRating:
+		if r.UserRating != nil && r.UserRating.AggregateRating != nil {
+			return *r.UserRating.AggregateRating
+		}
+	case sortCost:
+		if r.AverageCostForTwo != nil {
+			return float64(*r.AverageCostForTwo)
+		}
+	case sortDistance:
+		if d, ok := b.distance(r); ok {
+			return d
+		}
+	}
+	if b.desc {
+		return math.Inf(-1)
+	}
+	return math.Inf(1)
+}
+
+// distance returns the distance in km of restaurant 'r' from the centre of
+// the location.
+func (b *browser) distance(r *zomato.Restaurant) (float64, bool) {
+	if b.location == nil {
+		return 0, false
+	}
+	from, ok := geo.OfLocation(*b.location)
+	if !ok {
+		return 0, false
+	}
+	c, ok := geo.Of(*r)
+	if !ok {
+		return 0, false
+	}
+	return geo.Haversine(from, c) / 1000, true
+}
+
+func (b *browser) openRestaurant(r *zomato.Restaurant) {
+	b.screen = screenDetail
+	b.pane = paneInfo
+	b.restaurant = r
+	b.reviews, b.reviewStart = nil, 0
+	b.menu, b.menuErr = nil, nil
+
+	if r.ID == nil {
+		return
+	}
+	res, err := b.session.restaurant(b.ctx, zomato.RestaurantReq{RestaurantID: *r.ID})
+	if err != nil {
+		b.status = "Restaurant failed: " + err.Error()
+		return
+	}
+	b.restaurant = &res
+}
+
+func (b *browser) handleDetail(k key) {
+	switch k.code {
+	case keyTab:
+		b.openPane((b.pane + 1) % pane(len(paneNames)))
+	case keyBacktab:
+		b.openPane((b.pane + pane(len(paneNames)) - 1) % pane(len(paneNames)))
+	case keyRight, keyPgDn:
+		if b.pane == paneReviews && b.reviews != nil && b.reviews.ReviewsCount != nil &&
+			int64(b.reviewStart+reviewsPageSize) < *b.reviews.ReviewsCount {
+			b.reviewStart += reviewsPageSize
+			b.loadReviews()
+		}
+	case keyLeft, keyPgUp:
+		if b.pane == paneReviews && b.reviewStart > 0 {
+			b.reviewStart -= reviewsPageSize
+			b.loadReviews()
+		}
+	case keyEsc:
+		b.screen = screenResults
+	}
+}
+
+func (b *browser) openPane(p pane) {
+	b.pane = p
+	switch {
+	case p == paneReviews && b.reviews == nil:
+		b.loadReviews()
+	case p == paneMenu && b.menu == nil && b.menuErr == nil:
+		b.loadMenu()
+	}
+}
+
+func (b *browser) loadReviews() {
+	if b.restaurant.ID == nil {
+		return
+	}
+	resp, err := b.session.reviews(b.ctx, zomato.ReviewsReq{
+		RestaurantID: *b.restaurant.ID,
+		Start:        b.reviewStart,
+		Count:        reviewsPageSize,
+	})
+	if err != nil {
+		b.status = "Reviews failed: " + err.Error()
+		return
+	}
+	b.reviews = &resp
+}
+
+func (b *browser) loadMenu() {
+	if b.restaurant.ID == nil {
+		return
+	}
+	resp, err := b.session.dailyMenu(b.ctx, zomato.DailyMenuReq{RestaurantID: *b.restaurant.ID})
+	if err != nil {
+		b.menuErr = err
+		return
+	}
+	b.menu = &resp
+}
+
+// view returns the lines of the screen of 'width' columns and 'height' rows.
+func (b *browser) view(width, height int) []string {
+	var title, help string
+	var body []string
+	switch b.screen {
+	case screenLocations:
+		title = "Locations"
+		help = "type to edit  enter search/open  ↑↓ select  esc quit"
+		body = b.viewLocations(height - 4)
+	case screenResults:
+		title = "Restaurants in " + str(b.location.Title)
+		help = "type to filter  tab sort  shift-tab order  pgdn more  enter open  esc back"
+		body = b.viewResults(height - 4)
+	case screenDetail:
+		title = str(b.restaurant.Name)
+		help = "tab/shift-tab pane  ←→ page reviews  esc back  ctrl-c quit"
+		body = b.viewDetail()
+	}
+
+	lines := []string{"zomato ▸ " + title, ""}
+	lines = append(lines, body...)
+	if len(lines) > height-2 {
+		lines = lines[:height-2]
+	}
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+	status := b.status
+	if status == "" {
+		status = fmt.Sprintf("%d API calls this session", b.session.calls)
+	}
+	lines = append(lines, status, help)
+
+	for i, l := range lines {
+		lines[i] = clip(l, width)
+	}
+	return lines
+}
+
+func (b *browser) viewLocations(height int) []string {
+	lines := []string{"Search: " + b.query + "▏", ""}
+	for i, l := range b.locations {
+		lines = append(lines, cursor(i == b.locSel)+fmt.Sprintf("%s (%s) %s",
+			str(l.Title), str(l.EntityType), str(l.CountryName)))
+	}
+	return window(lines, 2+b.locSel, height)
+}
+
+func (b *browser) viewResults(height int) []string {
+	order := "asc"
+	if b.desc {
+		order = "desc"
+	}
+	lines := []string{
+		fmt.Sprintf("Filter: %s▏  Sort: %s %s  Showing %d of %d loaded, %d found",
+			b.filter, sortNames[b.sortBy], order, len(b.visible), len(b.results), b.found),
+		"",
+	}
+	for i, r := range b.visible {
+		var rating, locality, dist string
+		if r.UserRating != nil {
+			rating = f64(r.UserRating.AggregateRating)
+		}
+		if r.Location != nil {
+			locality = str(r.Location.Locality)
+		}
+		if d, ok := b.distance(r); ok {
+			dist = fmt.Sprintf("%.1fkm", d)
+		}
+		lines = append(lines, cursor(i == b.resSel)+fmt.Sprintf("%-32s %4s %8s %7s  %s",
+			truncate(str(r.Name), 32), rating, str(r.Currency)+i64(r.AverageCostForTwo), dist, locality))
+	}
+	return window(lines, 2+b.resSel, height)
+}
+
+func (b *browser) viewDetail() []string {
+	var tabs []string
+	for i, name := range paneNames {
+		if pane(i) == b.pane {
+			name = "[" + name + "]"
+		}
+		tabs = append(tabs, name)
+	}
+	lines := []string{strings.Join(tabs, "  "), ""}
+
+	r := b.restaurant
+	switch b.pane {
+	case paneInfo:
+		if r.Location != nil {
+			lines = append(lines, "Address:   "+str(r.Location.Address))
+		}
+		lines = append(lines, "Cuisines:  "+strings.Join(r.Cuisines, ","))
+		if r.UserRating != nil {
+			lines = append(lines, fmt.Sprintf("Rating:    %s %s (%s votes)",
+				f64(r.UserRating.AggregateRating), str(r.UserRating.RatingText), i64(r.UserRating.Votes)))
+		}
+		lines = append(lines, "Cost:      "+str(r.Currency)+i64(r.AverageCostForTwo)+" for two")
+		if r.PriceRange != nil {
+			lines = append(lines, "Price:     "+strings.Repeat(str(r.Currency), int(*r.PriceRange)))
+		}
+		lines = append(lines,
+			"Delivery:  "+yesNo(r.HasOnlineDelivery),
+			"Booking:   "+yesNo(r.HasTableBooking),
+			"Phone:     "+str(r.PhoneNumbers),
+			"URL:       "+str(r.URL),
+		)
+
+	case paneReviews:
+		if b.reviews == nil {
+			break
+		}
+		lines = append(lines, fmt.Sprintf("Reviews %d-%d of %s", b.reviewStart+1,
+			b.reviewStart+uint64(len(b.reviews.UserReviews)), i64(b.reviews.ReviewsCount)), "")
+		for _, ur := range b.reviews.UserReviews {
+			rv := ur.Review
+			if rv == nil {
+				continue
+			}
+			var user string
+			if rv.User != nil {
+				user = str(rv.User.Name)
+			}
+			lines = append(lines,
+				fmt.Sprintf("%s ★ %s, %s", f64(rv.Rating), user, str(rv.ReviewTimeFriendly)),
+				"  "+truncate(str(rv.ReviewText), 200), "")
+		}
+
+	case paneMenu:
+		if b.menuErr != nil {
+			lines = append(lines, "No daily menu: "+b.menuErr.Error())
+			break
+		}
+		if b.menu == nil {
+			break
+		}
+		for _, m := range b.menu.DailyMenus {
+			if m.DailyMenu == nil {
+				continue
+			}
+			lines = append(lines, str(m.DailyMenu.Name))
+			for _, d := range m.DailyMenu.Dishes {
+				if d.Dish != nil {
+					lines = append(lines, fmt.Sprintf("  %s  %s", str(d.Dish.Name), str(d.Dish.Price)))
+				}
+			}
+		}
+	}
+	return lines
+}
+
+// window returns at most 'height' lines of 'lines' keeping line 'sel' visible.
+func window(lines []string, sel, height int) []string {
+	if height <= 0 || len(lines) <= height {
+		return lines
+	}
+	start := sel - height + 1
+	if start < 0 {
+		start = 0
+	}
+	return lines[start : start+height]
+}
+
+// searchText returns the text of restaurant 'r' matched by the filter.
+func searchText(r *zomato.Restaurant) string {
+	s := str(r.Name) + " " + strings.Join(r.Cuisines, ",")
+	if r.Location != nil {
+		s += " " + str(r.Location.Locality)
+	}
+	return s
+}
+
+func cursor(selected bool) string {
+	if selected {
+		return "> "
+	}
+	return "  "
+}
+
+func yesNo(b *bool) string {
+	switch {
+	case b == nil:
+		return ""
+	case *b:
+		return "yes"
+	}
+	return "no"
+}
+
+// clamp returns 'i' limited to indexes of a list of length 'n'.
+func clamp(i, n int) int {
+	if i >= n {
+		i = n - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
+
+func dropLast(s string) string {
+	if r := []rune(s); len(r) > 0 {
+		return string(r[:len(r)-1])
+	}
+	return s
+}
+
+// clip returns 's' cut to 'width' runes.
+func clip(s string, width int) string {
+	if r := []rune(s); width >= 0 && len(r) > width {
+		return string(r[:width])
+	}
+	return s
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-india/zomato"
+)
+
+// press feeds terminal input 'in' to browser 'b'
+func press(b *browser, in string) {
+	for _, k := range parseKeys([]byte(in)) {
+		b.handle(k)
+	}
+}
+
+func TestBrowse(t *testing.T) {
+	client, ft := testClient()
+	s := newSession(*client)
+	b := newBrowser(context.Background(), s, "delhi")
+
+	if len(b.locations) != 1 || str(b.locations[0].Title) != "Delhi NCR" {
+		t.Fatalf("locations = %+v, want Delhi NCR", b.locations)
+	}
+
+	press(b, "\r")
+	if b.screen != screenResults {
+		t.Fatalf("screen = %v, want results", b.screen)
+	}
+	if got := ft.requests[len(ft.requests)-1].URL.RawQuery; got != "count=20&entity_id=1&entity_type=city" {
+		t.Errorf("search query = %q", got)
+	}
+	if len(b.results) != 20 || b.found != 14265 {
+		t.Fatalf("got %d results of %d", len(b.results), b.found)
+	}
+	if view := strings.Join(b.view(120, 40), "\n"); !strings.Contains(view, "Jung Bahadur Kachori Wala") {
+		t.Errorf("view missing restaurant:\n%s", view)
+	}
+
+	// Live filter
+	press(b, "kachori")
+	if len(b.visible) == 0 || len(b.visible) == len(b.results) {
+		t.Fatalf("filter kept %d of %d results", len(b.visible), len(b.results))
+	}
+	for _, r := range b.visible {
+		if !strings.Contains(strings.ToLower(searchText(r)), "kachori") {
+			t.Errorf("filter kept %q", str(r.Name))
+		}
+	}
+	press(b, strings.Repeat("\x7f", len("kachori")))
+	if len(b.visible) != len(b.results) {
+		t.Errorf("cleared filter kept %d of %d results", len(b.visible), len(b.results))
+	}
+
+	// Sorting
+	for _, field := range []sortField{sortCost, sortDistance} {
+		press(b, "\t")
+		if b.sortBy != field || b.desc {
+			t.Fatalf("sort = %v desc %v, want %v asc", b.sortBy, b.desc, field)
+		}
+		for i := 1; i < len(b.visible); i++ {
+			if b.sortKey(b.visible[i-1]) > b.sortKey(b.visible[i]) {
+				t.Errorf("%s: %q sorted before %q", sortNames[field],
+					str(b.visible[i-1].Name), str(b.visible[i].Name))
+			}
+		}
+	}
+	press(b, "\x1b[Z")
+	if !b.desc || b.sortKey(b.visible[0]) < b.sortKey(b.visible[len(b.visible)-1]) {
+		t.Errorf("shift-tab did not reverse order")
+	}
+
+	// Detail panes
+	press(b, "\x1b[B\r")
+	if b.screen != screenDetail || str(b.restaurant.Name) == "" {
+		t.Fatalf("screen = %v, restaurant = %+v", b.screen, b.restaurant)
+	}
+	press(b, "\t")
+	if b.reviews == nil || len(b.reviews.UserReviews) != 5 {
+		t.Fatalf("reviews = %+v", b.reviews)
+	}
+	press(b, "\x1b[C")
+	if b.reviewStart != reviewsPageSize {
+		t.Errorf("review start = %d, want %d", b.reviewStart, reviewsPageSize)
+	}
+	if got := ft.requests[len(ft.requests)-1].URL.Query().Get("start"); got != "5" {
+		t.Errorf("reviews start = %q, want 5", got)
+	}
+	press(b, "\t")
+	if b.menu == nil || len(b.menu.DailyMenus) == 0 {
+		t.Fatalf("menu = %+v, err = %v", b.menu, b.menuErr)
+	}
+	if view := strings.Join(b.view(120, 40), "\n"); !strings.Contains(view, "[Daily Menu]") {
+		t.Errorf("view missing daily menu tab:\n%s", view)
+	}
+
+	// Revisiting uses the session cache
+	calls := len(ft.requests)
+	press(b, "\x1b\r\t\x1b[C\x1b[D\t")
+	press(b, "\x1b\x1b\r")
+	if len(ft.requests) != calls {
+		t.Errorf("revisiting made %d API calls", len(ft.requests)-calls)
+	}
+	if s.calls != calls {
+		t.Errorf("session calls = %d, want %d", s.calls, calls)
+	}
+
+	press(b, "\x03")
+	if !b.quit {
+		t.Error("ctrl-c did not quit")
+	}
+}
+
+func TestBrowseView(t *testing.T) {
+	client, _ := testClient()
+	b := newBrowser(context.Background(), newSession(*client), "")
+	press(b, "\r")
+	if b.status == "" {
+		t.Error("empty query gave no status")
+	}
+
+	lines := b.view(20, 10)
+	if len(lines) != 10 {
+		t.Errorf("got %d lines, want 10", len(lines))
+	}
+	for _, l := range lines {
+		if n := len([]rune(l)); n > 20 {
+			t.Errorf("line %q is %d wide", l, n)
+		}
+	}
+}
+
+func TestSessionCache(t *testing.T) {
+	client, ft := testClient()
+	s := newSession(*client)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.search(ctx, zomato.SearchReq{Query: "pizza", Cuisines: []string{"1", "2"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.search(ctx, zomato.SearchReq{Query: "pizza", Cuisines: []string{"1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(ft.requests) != 2 {
+		t.Errorf("got %d API calls, want 2", len(ft.requests))
+	}
+
+	// Failed calls are not cached
+	if _, err := s.reviews(ctx, zomato.ReviewsReq{}); err == nil {
+		t.Fatal("invalid request did not fail")
+	}
+	if _, err := s.reviews(ctx, zomato.ReviewsReq{}); err == nil {
+		t.Fatal("invalid request did not fail")
+	}
+	if s.calls != 4 {
+		t.Errorf("session calls = %d, want 4", s.calls)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []key
+	}{
+		{"ab", []key{{keyRune, 'a'}, {keyRune, 'b'}}},
+		{"é\r\t", []key{{keyRune, 'é'}, {keyEnter, 0}, {keyTab, 0}}},
+		{"\x1b[A\x1b[B\x1bOC\x1b[D", []key{{keyUp, 0}, {keyDown, 0}, {keyRight, 0}, {keyLeft, 0}}},
+		{"\x1b[5~\x1b[6~\x1b[Z", []key{{keyPgUp, 0}, {keyPgDn, 0}, {keyBacktab, 0}}},
+		{"\x1b", []key{{keyEsc, 0}}},
+		{"\x7f\x03", []key{{keyBackspace, 0}, {keyQuit, 0}}},
+		{"\x1b[1;5Ax\x01", []key{{keyRune, 'x'}}},
+	}
+	for _, tt := range tests {
+		if got := parseKeys([]byte(tt.in)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseKeys(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-india/zomato"
+)
+
+// command is a subcommand mirroring a zomato.Client method
+type command struct {
+	name  string
+	usage string
+	// req returns a new request whose fields are bound to flags
+	req func() interface{}
+	// call invokes the client method with request 'req'
+	call func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error)
+	// items returns list elements of 'resp' written as NDJSON lines
+	items func(resp interface{}) []interface{}
+	// table returns header and rows of 'resp' written as a table
+	table func(resp interface{}) ([]string, [][]string)
+}
+
+var commands = []command{
+	{
+		name:  "search",
+		usage: "search for restaurants",
+		req:   func() interface{} { return &zomato.SearchReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Search(ctx, *req.(*zomato.SearchReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, r := range resp.(zomato.SearchResp).Restaurants {
+				items = append(items, r.Restaurant)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rs []*zomato.Restaurant
+			for _, r := range resp.(zomato.SearchResp).Restaurants {
+				rs = append(rs, r.Restaurant)
+			}
+			return restaurantTable(rs)
+		},
+	},
+	{
+		name:  "restaurant",
+		usage: "get restaurant details",
+		req:   func() interface{} { return &zomato.RestaurantReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Restaurant(ctx, req.(*zomato.RestaurantReq).RestaurantID)
+		},
+		items: func(resp interface{}) []interface{} { return []interface{}{resp} },
+		table: func(resp interface{}) ([]string, [][]string) {
+			r := resp.(zomato.Restaurant)
+			return restaurantTable([]*zomato.Restaurant{&r})
+		},
+	},
+	{
+		name:  "reviews",
+		usage: "get restaurant reviews",
+		req:   func() interface{} { return &zomato.ReviewsReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Reviews(ctx, *req.(*zomato.ReviewsReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, r := range resp.(zomato.ReviewsResp).UserReviews {
+				items = append(items, r.Review)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, r := range resp.(zomato.ReviewsResp).UserReviews {
+				if r.Review == nil {
+					continue
+				}
+				var user *string
+				if r.Review.User != nil {
+					user = r.Review.User.Name
+				}
+				rows = append(rows, []string{
+					i64(r.Review.ID), f64(r.Review.Rating), str(user),
+					i64(r.Review.Likes), truncate(str(r.Review.ReviewText), 60),
+				})
+			}
+			return []string{"ID", "RATING", "USER", "LIKES", "REVIEW"}, rows
+		},
+	},
+	{
+		name:  "dailymenu",
+		usage: "get daily menu of a restaurant",
+		req:   func() interface{} { return &zomato.DailyMenuReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.DailyMenu(ctx, req.(*zomato.DailyMenuReq).RestaurantID)
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, m := range resp.(zomato.DailyMenuResp).DailyMenus {
+				items = append(items, m.DailyMenu)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, m := range resp.(zomato.DailyMenuResp).DailyMenus {
+				if m.DailyMenu == nil {
+					continue
+				}
+				for _, d := range m.DailyMenu.Dishes {
+					if d.Dish == nil {
+						continue
+					}
+					rows = append(rows, []string{
+						i64(m.DailyMenu.ID), i64(d.Dish.ID), str(d.Dish.Name), str(d.Dish.Price),
+					})
+				}
+			}
+			return []string{"MENU", "DISH", "NAME", "PRICE"}, rows
+		},
+	},
+	{
+		name:  "geocode",
+		usage: "get location details and nearby restaurants for coordinates",
+		req:   func() interface{} { return &zomato.GeoCodeReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			r := req.(*zomato.GeoCodeReq)
+			return c.GeoCode(ctx, r.Latitude, r.Longitude)
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, r := range resp.(zomato.GeoCodeResp).NearbyRestaurants {
+				items = append(items, r.Restaurant)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rs []*zomato.Restaurant
+			for _, r := range resp.(zomato.GeoCodeResp).NearbyRestaurants {
+				rs = append(rs, r.Restaurant)
+			}
+			return restaurantTable(rs)
+		},
+	},
+	{
+		name:  "locations",
+		usage: "search for locations by keyword",
+		req:   func() interface{} { return &zomato.LocationsReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Locations(ctx, *req.(*zomato.LocationsReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, l := range resp.(zomato.LocationsResp).LocationSuggestions {
+				items = append(items, l)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, l := range resp.(zomato.LocationsResp).LocationSuggestions {
+				rows = append(rows, []string{
+					i64(l.EntityID), str(l.EntityType), str(l.Title), str(l.CityName),
+					f64(l.Latitude), f64(l.Longitude),
+				})
+			}
+			return []string{"ENTITY_ID", "ENTITY_TYPE", "TITLE", "CITY", "LATITUDE", "LONGITUDE"}, rows
+		},
+	},
+	{
+		name:  "location-details",
+		usage: "get best rated restaurants of a location",
+		req:   func() interface{} { return &zomato.LocationDetailsReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			r := req.(*zomato.LocationDetailsReq)
+			return c.LocationDetails(ctx, r.EntityID, r.EntityType)
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, r := range resp.(zomato.LocationDetailsResp).BestRatedRestaurant {
+				items = append(items, r.Restaurant)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rs []*zomato.Restaurant
+			for _, r := range resp.(zomato.LocationDetailsResp).BestRatedRestaurant {
+				rs = append(rs, r.Restaurant)
+			}
+			return restaurantTable(rs)
+		},
+	},
+	{
+		name:  "cities",
+		usage: "get city details",
+		req:   func() interface{} { return &zomato.CitiesReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Cities(ctx, *req.(*zomato.CitiesReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, c := range resp.(zomato.CitiesResp).LocationSuggestions {
+				items = append(items, c)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, c := range resp.(zomato.CitiesResp).LocationSuggestions {
+				rows = append(rows, []string{
+					itoa(c.ID), c.Name, str(c.StateName), str(c.CountryName),
+				})
+			}
+			return []string{"ID", "NAME", "STATE", "COUNTRY"}, rows
+		},
+	},
+	{
+		name:  "collections",
+		usage: "get restaurant collections in a city",
+		req:   func() interface{} { return &zomato.CollectionsReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Collections(ctx, *req.(*zomato.CollectionsReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, c := range resp.(zomato.CollectionsResp).Collections {
+				items = append(items, c.Collection)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, c := range resp.(zomato.CollectionsResp).Collections {
+				if c.Collection == nil {
+					continue
+				}
+				rows = append(rows, []string{
+					i64(c.Collection.ID), str(c.Collection.Title),
+					i64(c.Collection.RestaurantsCount), str(c.Collection.ShareURL),
+				})
+			}
+			return []string{"ID", "TITLE", "RESTAURANTS", "URL"}, rows
+		},
+	},
+	{
+		name:  "cuisines",
+		usage: "get cuisines of restaurants in a city",
+		req:   func() interface{} { return &zomato.CuisinesReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Cuisines(ctx, *req.(*zomato.CuisinesReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, c := range resp.(zomato.CuisinesResp).Cuisines {
+				items = append(items, c.Cuisine)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, c := range resp.(zomato.CuisinesResp).Cuisines {
+				if c.Cuisine != nil {
+					rows = append(rows, []string{itoa(c.Cuisine.ID), c.Cuisine.Name})
+				}
+			}
+			return []string{"ID", "NAME"}, rows
+		},
+	},
+	{
+		name:  "establishments",
+		usage: "get restaurant types in a city",
+		req:   func() interface{} { return &zomato.EstablishmentsReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Establishments(ctx, *req.(*zomato.EstablishmentsReq))
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, e := range resp.(zomato.EstablishmentsResp).Establishments {
+				items = append(items, e.Establishment)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, e := range resp.(zomato.EstablishmentsResp).Establishments {
+				if e.Establishment != nil {
+					rows = append(rows, []string{itoa(e.Establishment.ID), e.Establishment.Name})
+				}
+			}
+			return []string{"ID", "NAME"}, rows
+		},
+	},
+	{
+		name:  "categories",
+		usage: "get restaurant categories",
+		req:   func() interface{} { return &zomato.CategoriesReq{} },
+		call: func(ctx context.Context, c zomato.Client, req interface{}) (interface{}, error) {
+			return c.Categories(ctx)
+		},
+		items: func(resp interface{}) []interface{} {
+			var items []interface{}
+			for _, c := range resp.(zomato.CategoriesResp).Categories {
+				items = append(items, c.Categorie)
+			}
+			return items
+		},
+		table: func(resp interface{}) ([]string, [][]string) {
+			var rows [][]string
+			for _, c := range resp.(zomato.CategoriesResp).Categories {
+				if c.Categorie != nil {
+					rows = append(rows, []string{itoa(c.Categorie.ID), c.Categorie.Name})
+				}
+			}
+			return []string{"ID", "NAME"}, rows
+		},
+	},
+}
+
+// lookup returns the command named 'name'.
+func lookup(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+func restaurantTable(rs []*zomato.Restaurant) ([]string, [][]string) {
+	var rows [][]string
+	for _, r := range rs {
+		if r == nil {
+			continue
+		}
+
+		var locality, rating, votes string
+		if r.Location != nil {
+			locality = str(r.Location.Locality)
+		}
+		if r.UserRating != nil {
+			rating = f64(r.UserRating.AggregateRating)
+			votes = i64(r.UserRating.Votes)
+		}
+
+		rows = append(rows, []string{
+			i64(r.ID), str(r.Name), locality, strings.Join(r.Cuisines, ", "),
+			rating, votes, str(r.Currency) + i64(r.AverageCostForTwo),
+		})
+	}
+	return []string{"ID", "NAME", "LOCALITY", "CUISINES", "RATING", "VOTES", "COST_FOR_TWO"}, rows
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func i64(i *int64) string {
+	if i == nil {
+		return ""
+	}
+	return itoa(*i)
+}
+
+func itoa(i int64) string { return strconv.FormatInt(i, 10) }
+
+func f64(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return fmt.Sprint(*f)
+}
+
+func truncate(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-1]) + "…"
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// usages holds flag usage by API parameter name
+var usages = map[string]string{
+	"q":                  "search keyword",
+	"query":              "suggestion for location name",
+	"lat":                "latitude",
+	"lon":                "longitude",
+	"count":              "max number of results to retrieve",
+	"start":              "fetch results after this offset",
+	"city_id":            "id of the city",
+	"city_ids":           "comma separated city ids",
+	"entity_id":          "location id obtained from locations command",
+	"entity_type":        "location type obtained from locations command: city, subzone, zone, landmark, metro or group",
+	"res_id":             "id of the restaurant",
+	"radius":             "radius around (lat,lon) to define search area, in meters",
+	"cuisines":           "comma separated cuisine ids obtained from cuisines command",
+	"establishment_type": "establishment id obtained from establishments command",
+	"collection_id":      "collection id obtained from collections command",
+	"category":           "category ids obtained from categories command",
+	"sort":               "sort restaurants by cost, rating or real_distance",
+	"order":              "sort order: asc or desc",
+}
+
+// bindFlags defines a flag on 'fs' for every url tagged field of struct
+// pointer 'req'; e.g. field tagged `url:"entity_id"` gets flag -entity-id.
+func bindFlags(fs *flag.FlagSet, req interface{}) {
+	v := reflect.ValueOf(req).Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		param := strings.Split(t.Field(i).Tag.Get("url"), ",")[0]
+		if param == "" || param == "-" {
+			continue
+		}
+
+		usage := usages[param]
+		if usage == "" {
+			usage = t.Field(i).Name
+		}
+		if strings.Contains(t.Field(i).Tag.Get("validate"), "required") {
+			usage += " (required)"
+		}
+
+		fs.Var(fieldValue{v.Field(i)}, strings.Replace(param, "_", "-", -1), usage)
+	}
+}
+
+// fieldValue implements flag.Value for a struct field
+type fieldValue struct{ v reflect.Value }
+
+func (f fieldValue) String() string {
+	if !f.v.IsValid() || f.v.IsZero() {
+		return ""
+	}
+	if f.v.Kind() == reflect.Slice {
+		var s []string
+		for i := 0; i < f.v.Len(); i++ {
+			s = append(s, fmt.Sprint(f.v.Index(i).Interface()))
+		}
+		return strings.Join(s, ",")
+	}
+	return fmt.Sprint(f.v.Interface())
+}
+
+func (f fieldValue) Set(s string) error {
+	if f.v.Kind() != reflect.Slice {
+		return setScalar(f.v, s)
+	}
+
+	parts := strings.Split(s, ",")
+	slice := reflect.MakeSlice(f.v.Type(), len(parts), len(parts))
+	for i, p := range parts {
+		if err := setScalar(slice.Index(i), strings.TrimSpace(p)); err != nil {
+			return err
+		}
+	}
+	f.v.Set(slice)
+	return nil
+}
+
+func setScalar(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return errors.Errorf("invalid integer %q", s)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return errors.Errorf("invalid unsigned integer %q", s)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errors.Errorf("invalid number %q", s)
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return errors.Errorf("invalid boolean %q", s)
+		}
+		v.SetBool(b)
+	default:
+		return errors.Errorf("unsupported flag type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Command zomato queries the Zomato API from the shell.
+//
+// Usage:
+//
+//	zomato [-key KEY] [-config FILE] [-o table|json|ndjson] <command> [flags]
+//
+// Commands mirror the zomato.Client methods and their flags map onto the
+// fields of the matching request type, e.g.
+//
+//	zomato search -q pizza -entity-id 1 -entity-type city -sort rating -order desc
+//	zomato -o json restaurant -res-id 463
+//
+// The browse command opens an interactive browser in the terminal: pick a
+// location, filter and sort its restaurants, then page through the reviews
+// and daily menu of a restaurant. Responses are cached for the session.
+//
+//	zomato browse connaught place
+//
+// The API key is read from the -key flag, the ZOMATO_API_KEY environment
+// variable or the "api_key" field of the JSON config file, in that order.
+// The config file defaults to zomato/config.json in the user config directory.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// EnvAPIKey is the environment variable holding the API key
+const EnvAPIKey = "ZOMATO_API_KEY"
+
+// browseUsage describes the browse command
+const browseUsage = "browse restaurants interactively in the terminal"
+
+// errNoCommand is returned by run, after printing usage, if no command is given
+var errNoCommand = errors.New("no command given")
+
+// config is the config file format
+type config struct {
+	APIKey string `json:"api_key"`
+}
+
+func main() {
+	err := run(context.Background(), os.Args[1:], os.Getenv, os.Stdout, os.Stderr, nil)
+	switch errors.Cause(err) {
+	case nil, flag.ErrHelp:
+	case errNoCommand:
+		os.Exit(2)
+	default:
+		fmt.Fprintln(os.Stderr, "zomato:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command line 'args'.
+//
+// If 'client' is nil, a new client is made with the configured API key.
+func run(ctx context.Context, args []string, getenv func(string) string,
+	stdout, stderr io.Writer, client *zomato.Client) error {
+	var (
+		fs         = flag.NewFlagSet("zomato", flag.ContinueOnError)
+		key        = fs.String("key", "", "API key; overrides "+EnvAPIKey+" and config file")
+		configPath = fs.String("config", "", "config file (default zomato/config.json in user config directory)")
+		format     = fs.String("o", formatTable, "output format: table, json or ndjson")
+	)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(fs) }
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errNoCommand
+	}
+
+	if fs.Arg(0) == "browse" {
+		return runBrowse(ctx, fs.Args()[1:], stderr, func() (*zomato.Client, error) {
+			if client != nil {
+				return client, nil
+			}
+			return newClient(*key, *configPath, getenv)
+		})
+	}
+
+	cmd, ok := lookup(fs.Arg(0))
+	if !ok {
+		fs.Usage()
+		return errors.Errorf("unknown command %q", fs.Arg(0))
+	}
+
+	req := cmd.req()
+	cfs := flag.NewFlagSet("zomato "+cmd.name, flag.ContinueOnError)
+	cfs.SetOutput(stderr)
+	cfs.StringVar(format, "o", *format, "output format: table, json or ndjson")
+	bindFlags(cfs, req)
+	cfs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: zomato %s [flags]\n\n%s.\n\nFlags:\n", cmd.name, cmd.usage)
+		cfs.PrintDefaults()
+	}
+
+	if err := cfs.Parse(fs.Args()[1:]); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	if client == nil {
+		var err error
+		if client, err = newClient(*key, *configPath, getenv); err != nil {
+			return err
+		}
+	}
+
+	resp, err := cmd.call(ctx, *client, req)
+	if err != nil {
+		return errors.Wrap(err, cmd.name+" failed")
+	}
+	return write(stdout, *format, cmd, resp)
+}
+
+// runBrowse runs the browse command with arguments 'args'.
+func runBrowse(ctx context.Context, args []string, stderr io.Writer,
+	client func() (*zomato.Client, error)) error {
+	fs := flag.NewFlagSet("zomato browse", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: zomato browse [location]\n\n%s.\n", browseUsage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	c, err := client()
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(browse(ctx, *c, strings.Join(fs.Args(), " "), os.Stdin), "browse failed")
+}
+
+// newClient returns a client using the configured API key.
+func newClient(key, configPath string, getenv func(string) string) (*zomato.Client, error) {
+	apiKey, err := apiKey(key, configPath, getenv)
+	if err != nil {
+		return nil, err
+	}
+	c := zomato.NewClient(apiKey)
+	c.UserAgent = zomato.DefaultUserAgent
+	return &c, nil
+}
+
+// apiKey returns the API key from 'key', the environment or the config file.
+func apiKey(key, configPath string, getenv func(string) string) (string, error) {
+	if key != "" {
+		return key, nil
+	}
+	if key = getenv(EnvAPIKey); key != "" {
+		return key, nil
+	}
+
+	if configPath == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return "", errors.Errorf("no API key; set %s or use -key", EnvAPIKey)
+		}
+		configPath = filepath.Join(dir, "zomato", "config.json")
+	}
+
+	f, err := os.Open(configPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "no API key; set %s, use -key or add it to the config file", EnvAPIKey)
+	}
+	defer f.Close()
+
+	var c config
+	if err := json.NewDecoder(f).Decode(&c); err != nil {
+		return "", errors.Wrap(err, "decode config file failed")
+	}
+	if c.APIKey == "" {
+		return "", errors.Errorf("no api_key in config file %s", configPath)
+	}
+	return c.APIKey, nil
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintln(w, "Usage: zomato [flags] <command> [command flags]")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-18s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintf(w, "  %-18s %s\n", "browse", browseUsage)
+	fmt.Fprintln(w, "\nFlags:")
+	fs.PrintDefaults()
+	fmt.Fprintln(w, "\nRun 'zomato <command> -h' for command flags.")
+}
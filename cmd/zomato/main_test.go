@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// fixtures maps API paths to testdata files
+var fixtures = map[string]string{
+	"/api/v2.1/categories":       "Categories.json",
+	"/api/v2.1/cities":           "Cities.json",
+	"/api/v2.1/collections":      "Collections.json",
+	"/api/v2.1/cuisines":         "Cuisines.json",
+	"/api/v2.1/dailymenu":        "DailyMenu.json",
+	"/api/v2.1/establishments":   "Establishments.json",
+	"/api/v2.1/geocode":          "GeoCode.json",
+	"/api/v2.1/location_details": "LocationDetails.json",
+	"/api/v2.1/locations":        "Locations.json",
+	"/api/v2.1/restaurant":       "Restaurant.json",
+	"/api/v2.1/reviews":          "Reviews.json",
+	"/api/v2.1/search":           "Search.json",
+}
+
+// fixtureTransport serves responses from testdata and records requests
+type fixtureTransport struct{ requests []*http.Request }
+
+func (ft *fixtureTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ft.requests = append(ft.requests, r)
+	body, err := ioutil.ReadFile(filepath.Join("../../testdata", fixtures[r.URL.Path]))
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    r,
+	}, nil
+}
+
+func testClient() (*zomato.Client, *fixtureTransport) {
+	ft := &fixtureTransport{}
+	c := zomato.NewClient("API_KEY")
+	c.HTTPClient = &http.Client{Transport: ft}
+	return &c, ft
+}
+
+func TestRunCommands(t *testing.T) {
+	tests := []struct {
+		args   []string
+		header string
+		query  string
+	}{
+		{[]string{"search", "-q", "delhi", "-cuisines", "1,2", "-sort", "rating"}, "ID", "cuisines=1%2C2&q=delhi&sort=rating"},
+		{[]string{"restaurant", "-res-id", "463"}, "ID", "res_id=463"},
+		{[]string{"reviews", "-res-id", "463", "-count", "5"}, "ID", "count=5&res_id=463"},
+		{[]string{"dailymenu", "-res-id", "16514301"}, "MENU", "res_id=16514301"},
+		{[]string{"geocode", "-lat", "28.7", "-lon", "77.1"}, "ID", "lat=28.7&lon=77.1"},
+		{[]string{"locations", "-query", "delhi"}, "ENTITY_ID", "query=delhi"},
+		{[]string{"location-details", "-entity-id", "289", "-entity-type", "subzone"}, "ID", "entity_id=289&entity_type=subzone"},
+		{[]string{"cities", "-city-ids", "1,2"}, "ID", "city_ids=1%2C2"},
+		{[]string{"collections", "-city-id", "1"}, "ID", "city_id=1"},
+		{[]string{"cuisines", "-city-id", "1"}, "ID", "city_id=1"},
+		{[]string{"establishments", "-city-id", "1"}, "ID", "city_id=1"},
+		{[]string{"categories"}, "ID", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			client, ft := testClient()
+			var stdout, stderr bytes.Buffer
+
+			err := run(context.Background(), tt.args, noenv, &stdout, &stderr, client)
+			if err != nil {
+				t.Fatalf("run failed: %+v", err)
+			}
+
+			if !strings.HasPrefix(stdout.String(), tt.header) {
+				t.Fatalf("expected table header `%s`, actual `%s`", tt.header, stdout.String())
+			}
+			if len(strings.Split(strings.TrimSpace(stdout.String()), "\n")) < 2 {
+				t.Fatalf("expected table rows, actual `%s`", stdout.String())
+			}
+
+			if query := ft.requests[0].URL.RawQuery; query != tt.query {
+				t.Fatalf("expected query `%s`, actual `%s`", tt.query, query)
+			}
+		})
+	}
+}
+
+func TestRunFormats(t *testing.T) {
+	client, _ := testClient()
+	var stdout, stderr bytes.Buffer
+
+	err := run(context.Background(), []string{"-o", "ndjson", "search"}, noenv, &stdout, &stderr, client)
+	if err != nil {
+		t.Fatalf("run failed: %+v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 20 {
+		t.Fatalf("expected 20 NDJSON lines, actual %d", len(lines))
+	}
+	var r zomato.Restaurant
+	if err := json.Unmarshal([]byte(lines[0]), &r); err != nil || r.ID == nil {
+		t.Fatalf("invalid NDJSON line `%s`: %v", lines[0], err)
+	}
+
+	stdout.Reset()
+	err = run(context.Background(), []string{"restaurant", "-res-id", "463", "-o", "json"}, noenv, &stdout, &stderr, client)
+	if err != nil {
+		t.Fatalf("run failed: %+v", err)
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &r); err != nil || r.ID == nil || *r.ID != 463 {
+		t.Fatalf("invalid JSON output `%s`: %v", stdout.String(), err)
+	}
+
+	client, ft := testClient()
+	err = run(context.Background(), []string{"-o", "xml", "categories"}, noenv, &stdout, &stderr, client)
+	if err == nil || !strings.Contains(err.Error(), "unknown output format") {
+		t.Fatalf("expected unknown output format error, actual `%v`", err)
+	}
+	if len(ft.requests) != 0 {
+		t.Fatalf("expected no API call for an unknown output format, actual %d", len(ft.requests))
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	client, _ := testClient()
+	var stdout, stderr bytes.Buffer
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{nil, "no command given"},
+		{[]string{"unknown"}, "unknown command"},
+		{[]string{"restaurant"}, "invalid request"},
+		{[]string{"search", "-count", "many"}, "invalid unsigned integer"},
+		{[]string{"search", "-lat", "north"}, "invalid number"},
+	}
+
+	for _, tt := range tests {
+		err := run(context.Background(), tt.args, noenv, &stdout, &stderr, client)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Fatalf("args %v: expected: `%s`, actual `%v`", tt.args, tt.expected, err)
+		}
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	client, ft := testClient()
+	var stdout, stderr bytes.Buffer
+
+	for _, args := range [][]string{{"-h"}, {"search", "-help"}, {"browse", "-h"}} {
+		stderr.Reset()
+		err := run(context.Background(), args, noenv, &stdout, &stderr, client)
+		if errors.Cause(err) != flag.ErrHelp {
+			t.Fatalf("args %v: expected flag.ErrHelp, actual `%v`", args, err)
+		}
+		if !strings.Contains(stderr.String(), "Usage") {
+			t.Fatalf("args %v: expected usage, actual `%s`", args, stderr.String())
+		}
+	}
+	if len(ft.requests) != 0 {
+		t.Fatalf("expected no API call for help, actual %d", len(ft.requests))
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zomato")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(configPath, []byte(`{"api_key": "from-config"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	env := func(string) string { return "from-env" }
+
+	tests := []struct {
+		key, config string
+		getenv      func(string) string
+		expected    string
+	}{
+		{"from-flag", configPath, env, "from-flag"},
+		{"", configPath, env, "from-env"},
+		{"", configPath, noenv, "from-config"},
+	}
+
+	for _, tt := range tests {
+		key, err := apiKey(tt.key, tt.config, tt.getenv)
+		if err != nil {
+			t.Fatalf("apiKey failed: %+v", err)
+		}
+		if key != tt.expected {
+			t.Fatalf("expected: `%s`, actual `%s`", tt.expected, key)
+		}
+	}
+
+	if _, err := apiKey("", filepath.Join(dir, "missing.json"), noenv); err == nil {
+		t.Fatal("expected error for missing config file")
+	}
+}
+
+func noenv(string) string { return "" }
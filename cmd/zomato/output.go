@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// Output formats
+const (
+	formatTable  = "table"
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+)
+
+// checkFormat returns an error if 'format' is not an output format.
+func checkFormat(format string) error {
+	switch format {
+	case formatTable, formatJSON, formatNDJSON:
+		return nil
+	}
+	return errors.Errorf("unknown output format %q; use table, json or ndjson", format)
+}
+
+// write writes response 'resp' of command 'cmd' to 'w' in 'format'.
+func write(w io.Writer, format string, cmd command, resp interface{}) error {
+	switch format {
+	case formatTable:
+		header, rows := cmd.table(resp)
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		io.WriteString(tw, strings.Join(header, "\t")+"\n")
+		for _, row := range rows {
+			io.WriteString(tw, strings.Join(row, "\t")+"\n")
+		}
+		return errors.Wrap(tw.Flush(), "write table failed")
+
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return errors.Wrap(enc.Encode(resp), "write JSON failed")
+
+	case formatNDJSON:
+		enc := json.NewEncoder(w)
+		for _, item := range cmd.items(resp) {
+			if err := enc.Encode(item); err != nil {
+				return errors.Wrap(err, "write NDJSON failed")
+			}
+		}
+		return nil
+	}
+	return checkFormat(format)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+// keyCode identifies a key read from the terminal
+type keyCode int
+
+// Key codes
+const (
+	keyRune keyCode = iota
+	keyEnter
+	keyEsc
+	keyBackspace
+	keyTab
+	keyBacktab
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyPgUp
+	keyPgDn
+	keyQuit
+)
+
+// key is a key press; r is set for keyRune
+type key struct {
+	code keyCode
+	r    rune
+}
+
+// escapes maps terminal escape sequences to keys
+var escapes = map[string]keyCode{
+	"\x1b[A":  keyUp,
+	"\x1b[B":  keyDown,
+	"\x1b[C":  keyRight,
+	"\x1b[D":  keyLeft,
+	"\x1bOA":  keyUp,
+	"\x1bOB":  keyDown,
+	"\x1bOC":  keyRight,
+	"\x1bOD":  keyLeft,
+	"\x1b[5~": keyPgUp,
+	"\x1b[6~": keyPgDn,
+	"\x1b[Z":  keyBacktab,
+}
+
+// parseKeys returns the keys in terminal input 'b'. Unknown escape sequences
+// are dropped.
+func parseKeys(b []byte) []key {
+	var keys []key
+	for len(b) > 0 {
+		if b[0] == 0x1b {
+			if len(b) == 1 || (b[1] != '[' && b[1] != 'O') {
+				keys = append(keys, key{code: keyEsc})
+				b = b[1:]
+				continue
+			}
+			matched := false
+			for seq, code := range escapes {
+				if strings.HasPrefix(string(b), seq) {
+					keys = append(keys, key{code: code})
+					b = b[len(seq):]
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				// Skip an unknown sequence up to its final byte
+				i := 2
+				for i < len(b) && (b[i] < 0x40 || b[i] > 0x7e) {
+					i++
+				}
+				if i < len(b) {
+					i++
+				}
+				b = b[i:]
+			}
+			continue
+		}
+
+		r, n := utf8.DecodeRune(b)
+		b = b[n:]
+		switch r {
+		case '\r', '\n':
+			keys = append(keys, key{code: keyEnter})
+		case '\t':
+			keys = append(keys, key{code: keyTab})
+		case 0x7f, 0x08:
+			keys = append(keys, key{code: keyBackspace})
+		case 0x03, 0x04:
+			keys = append(keys, key{code: keyQuit})
+		default:
+			if r >= ' ' && r != utf8.RuneError {
+				keys = append(keys, key{code: keyRune, r: r})
+			}
+		}
+	}
+	return keys
+}
+
+// browse runs the restaurant browser on the terminal 'tty' until the user quits.
+func browse(ctx context.Context, client zomato.Client, query string, tty *os.File) error {
+	fd := int(tty.Fd())
+	if !term.IsTerminal(fd) {
+		return errors.New("browse needs a terminal")
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return errors.Wrap(err, "set terminal raw mode failed")
+	}
+	defer term.Restore(fd, state)
+
+	// Use the alternate screen and hide the cursor while browsing
+	io.WriteString(tty, "\x1b[?1049h\x1b[?25l")
+	defer io.WriteString(tty, "\x1b[?25h\x1b[?1049l")
+
+	b := newBrowser(ctx, newSession(client), query)
+	buf := make([]byte, 256)
+	for !b.quit {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = 80, 24
+		}
+		if err := draw(tty, b.view(width, height)); err != nil {
+			return err
+		}
+
+		n, err := tty.Read(buf)
+		if err != nil {
+			return errors.Wrap(err, "read terminal failed")
+		}
+		for _, k := range parseKeys(buf[:n]) {
+			b.handle(k)
+		}
+	}
+	return nil
+}
+
+// draw writes 'lines' over the screen of 'w'.
+func draw(w io.Writer, lines []string) error {
+	var sb strings.Builder
+	sb.WriteString("\x1b[H")
+	for i, l := range lines {
+		if i > 0 {
+			sb.WriteString("\r\n")
+		}
+		sb.WriteString(l)
+		sb.WriteString("\x1b[K")
+	}
+	sb.WriteString("\x1b[J")
+	_, err := io.WriteString(w, sb.String())
+	return errors.Wrap(err, "draw screen failed")
+}
@@ -1,24 +1,12 @@
 package zomato
 
 import (
-	"context"
 	"encoding/json"
-	"net/http"
 	"strconv"
 
-	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
 )
 
-// CategoriesReq parameters
-type CategoriesReq struct{}
-
-// Request encodes CategoriesReq parameters returning a new http.Request
-func (r CategoriesReq) Request() (*http.Request, error) {
-	urlStr := DefaultBaseURL + "/v2.1/categories"
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // Categorie has categorie details.
 type Categorie struct {
 	ID   int64  `json:"id"`   // ID of the category type
@@ -32,43 +20,6 @@ type CategoriesResp struct {
 	} `json:"categories,omitempty"`
 }
 
-// Categories gets a list of categories.
-//
-// List of all restaurants categorized under a particular restaurant type can
-// be obtained using /Search API with Category ID as inputs
-func (c Client) Categories(ctx context.Context) (resp CategoriesResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, CategoriesReq{})), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// CitiesReq parameters
-type CitiesReq struct {
-	Query     string  `url:"q,omitempty"`        // query by city name
-	Latitude  float64 `url:"lat,omitempty"`      // latitude
-	Longitude float64 `url:"lon,omitempty"`      // longitude
-	CityIDs   []int64 `url:"city_ids,omitempty"` // comma separated city_id values
-	Count     uint64  `url:"count,omitempty"`    // number of max results to display
-}
-
-// Request encodes CitiesReq parameters returning a new http.Request
-func (r CitiesReq) Request() (*http.Request, error) {
-	urlStr := DefaultBaseURL + "/v2.1/cities"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // City holds city details
 type City struct {
 	ID   int64  `json:"id"`   // ID of the city
@@ -78,11 +29,11 @@ type City struct {
 	CountryName    *string `json:"country_name,omitempty"`     // Name of the country
 	CountryFlagURL *string `json:"country_flag_url,omitempty"` // Country Flag picture URL
 
-	ShouldExperimentWith *bool // `json:"should_experiment_with,omitempty"`
-	DiscoveryEnabled     *bool // `json:"discovery_enabled,omitempty"`
-	HasNewAdFormat       *bool // `json:"has_new_ad_format,omitempty"`
+	ShouldExperimentWith *bool `json:"-"`
+	DiscoveryEnabled     *bool `json:"-"`
+	HasNewAdFormat       *bool `json:"-"`
 
-	IsState   *bool   // `json:"is_state,omitempty"`   // Whether this location is a state
+	IsState   *bool   `json:"-"`                    // Whether this location is a state
 	StateID   *int64  `json:"state_id,omitempty"`   // ID of the state
 	StateName *string `json:"state_name,omitempty"` // Name of the state
 	StateCode *string `json:"state_code,omitempty"` // Short code for the state
@@ -110,12 +61,30 @@ func (c *City) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON convert struct to JSON data
+func (c City) MarshalJSON() ([]byte, error) {
+	type Alias City
+	return json.Marshal(struct {
+		Alias
+		ShouldExperimentWith uint8 `json:"should_experiment_with"`
+		DiscoveryEnabled     uint8 `json:"discovery_enabled"`
+		HasNewAdFormat       uint8 `json:"has_new_ad_format"`
+		IsState              uint8 `json:"is_state"`
+	}{
+		Alias:                Alias(c),
+		ShouldExperimentWith: boolToUint8(c.ShouldExperimentWith),
+		DiscoveryEnabled:     boolToUint8(c.DiscoveryEnabled),
+		HasNewAdFormat:       boolToUint8(c.HasNewAdFormat),
+		IsState:              boolToUint8(c.IsState),
+	})
+}
+
 // CitiesResp has cities returned by CitiesReq query
 type CitiesResp struct {
 	LocationSuggestions []City  `json:"location_suggestions,omitempty"`
 	Status              *string `json:"status,omitempty"`
-	HasMore             *bool   // `json:"has_more,omitempty"`
-	HasTotal            *bool   // `json:"has_total,omitempty"`
+	HasMore             *bool   `json:"-"`
+	HasTotal            *bool   `json:"-"`
 }
 
 // UnmarshalJSON convert JSON data to struct
@@ -136,45 +105,18 @@ func (c *CitiesResp) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Cities gets city details.
-//
-// Find the Zomato ID and other details for a city.
-// You can obtain the Zomato City ID in one of the following ways:
-//
-// 		City Name in the Search Query - Returns list of cities matching the query
-// 		Using coordinates - Identifies the city details based on the coordinates of any location inside a city
-//
-// If you already know the Zomato City ID, this API can be used to get other details of the city.
-func (c Client) Cities(ctx context.Context, req CitiesReq) (resp CitiesResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// CollectionsReq parameters
-type CollectionsReq struct {
-	CityID    int64   `url:"city_id,omitempty"` // id of the city for which collections are needed
-	Latitude  float64 `url:"lat,omitempty"`     // latitude of any point within a city
-	Longitude float64 `url:"lon,omitempty"`     // longitude  of any point within a city
-	Count     uint64  `url:"count,omitempty"`   // max number of results needed
-}
-
-// Request encodes CollectionsReq parameters returning a new http.Request
-func (r CollectionsReq) Request() (*http.Request, error) {
-	urlStr := DefaultBaseURL + "/v2.1/collections"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
+// MarshalJSON convert struct to JSON data
+func (c CitiesResp) MarshalJSON() ([]byte, error) {
+	type Alias CitiesResp
+	return json.Marshal(struct {
+		Alias
+		HasMore  uint8 `json:"has_more"`
+		HasTotal uint8 `json:"has_total"`
+	}{
+		Alias:    Alias(c),
+		HasMore:  boolToUint8(c.HasMore),
+		HasTotal: boolToUint8(c.HasTotal),
+	})
 }
 
 // Collection holds collection details
@@ -217,44 +159,18 @@ func (c *CollectionsResp) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Collections returns Zomato Restaurant Collections in a City.
-//
-// The location/City input can be provided in the following ways:
-//
-// 		Using Zomato City ID
-//		Using coordinates of any location within a city
-//
-// List of all restaurants listed in any particular Zomato Collection can be
-// obtained using the '/search' API with Collection ID and Zomato City ID as the input
-func (c Client) Collections(ctx context.Context, req CollectionsReq) (resp CollectionsResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// CuisinesReq parameters
-type CuisinesReq struct {
-	CityID    int64   `url:"city_id,omitempty"` // id of the city for which cuisines are needed
-	Latitude  float64 `url:"lat,omitempty"`     // latitude of any point within a city
-	Longitude float64 `url:"lon,omitempty"`     // longitude  of any point within a city
-}
-
-// Request encodes CuisinesReq parameters returning a new http.Request
-func (r CuisinesReq) Request() (*http.Request, error) {
-	urlStr := DefaultBaseURL + "/v2.1/cuisines"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
+// MarshalJSON convert struct to JSON data
+func (c CollectionsResp) MarshalJSON() ([]byte, error) {
+	type Alias CollectionsResp
+	return json.Marshal(struct {
+		Alias
+		HasMore  uint8 `json:"has_more"`
+		HasTotal uint8 `json:"has_total"`
+	}{
+		Alias:    Alias(c),
+		HasMore:  boolToUint8(c.HasMore),
+		HasTotal: boolToUint8(c.HasTotal),
+	})
 }
 
 // Cuisine holds cuisine details
@@ -270,46 +186,6 @@ type CuisinesResp struct {
 	} `json:"cuisines,omitempty"`
 }
 
-// Cuisines gets a list of all cuisines of restaurants listed in a city.
-//
-// The location/City input can be provided in the following ways:
-//
-// 		Using Zomato City ID
-//		Using coordinates of any location within a city
-//
-// List of all restaurants serving a particular cuisine can be obtained
-// using '/search' API with cuisine ID and location details
-func (c Client) Cuisines(ctx context.Context, req CuisinesReq) (resp CuisinesResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// EstablishmentsReq parameters
-type EstablishmentsReq struct {
-	CityID    int64   `url:"city_id,omitempty"` // id of the city
-	Latitude  float64 `url:"lat,omitempty"`     // latitude of any point within a city
-	Longitude float64 `url:"lon,omitempty"`     // longitude  of any point within a city
-}
-
-// Request encodes EstablishmentsReq parameters returning a new http.Request
-func (r EstablishmentsReq) Request() (*http.Request, error) {
-	urlStr := DefaultBaseURL + "/v2.1/establishments"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // Establishment holds establishment details
 type Establishment struct {
 	ID   int64  `json:"id"`   // ID of the establishment type
@@ -323,50 +199,6 @@ type EstablishmentsResp struct {
 	} `json:"establishments,omitempty"`
 }
 
-// Establishments gets a list of restaurant types in a city.
-//
-// The location/City input can be provided in the following ways:
-//
-// 		Using Zomato City ID
-//		Using coordinates of any location within a city
-//
-// List of all restaurants categorized under a particular restaurant type can
-// be obtained using /Search API with Establishment ID and location details as inputs.
-func (c Client) Establishments(ctx context.Context, req EstablishmentsReq) (resp EstablishmentsResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// GeoCodeReq parameters
-type GeoCodeReq struct {
-	Latitude  float64 `url:"lat" validate:"required"` // latitude of any point within a city
-	Longitude float64 `url:"lon" validate:"required"` // longitude  of any point within a city
-}
-
-// Request encodes GeoCodeReq parameters returning a new http.Request
-func (r GeoCodeReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
-
-	urlStr := DefaultBaseURL + "/v2.1/geocode"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // GeoCodeResp holds foodie and Nightlife Index,
 // list of popular cuisines and nearby restaurants around the given coordinates
 type GeoCodeResp struct {
@@ -378,11 +210,44 @@ type GeoCodeResp struct {
 	} `json:"nearby_restaurants,omitempty"`
 }
 
+// MarshalJSON convert struct to JSON data, with location coordinates quoted
+// as GeoCode sends them
+func (g GeoCodeResp) MarshalJSON() ([]byte, error) {
+	type Alias GeoCodeResp
+	t := struct {
+		Alias
+
+		Location *geoCodeLocation `json:"location,omitempty"`
+	}{Alias: Alias(g)}
+	if g.Location != nil {
+		t.Location = &geoCodeLocation{*g.Location}
+	}
+	return json.Marshal(t)
+}
+
+// geoCodeLocation is a Location with quoted coordinates
+type geoCodeLocation struct{ Location }
+
+// MarshalJSON convert struct to JSON data
+func (l geoCodeLocation) MarshalJSON() ([]byte, error) {
+	type Alias Location
+	return json.Marshal(struct {
+		Alias
+
+		Latitude  *float64 `json:"latitude,string,omitempty"`
+		Longitude *float64 `json:"longitude,string,omitempty"`
+	}{
+		Alias:     Alias(l.Location),
+		Latitude:  l.Latitude,
+		Longitude: l.Longitude,
+	})
+}
+
 // Popularity has popularity details
 type Popularity struct {
 	Popularity           *float64 `json:"popularity,string,omitempty"`      // Foodie index of a location out of 5.00
 	NightlifeIndex       *float64 `json:"nightlife_index,string,omitempty"` // Nightlife index of a location out of 5.00
-	NearbyRestaurantIDs  []int64  // `json:"nearby_res,omitempty"`
+	NearbyRestaurantIDs  []int64  `json:"-"`
 	TopCuisines          []string `json:"top_cuisines,omitempty"` // Most popular cuisines in the locality
 	PopularityRestaurant *int64   `json:"popularity_res,string,omitempty"`
 	NightlifeRestaurant  *int64   `json:"nightlife_res,string,omitempty"`
@@ -416,15 +281,16 @@ func (p *Popularity) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// GeoCode gets location details based on coordinates.
-//
-// Get Foodie and Nightlife Index, list of popular cuisines and nearby
-// restaurants around the given coordinates
-func (c Client) GeoCode(ctx context.Context, lat, long float64) (resp GeoCodeResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
+// MarshalJSON convert struct to JSON data
+func (p Popularity) MarshalJSON() ([]byte, error) {
+	type Alias Popularity
+	t := struct {
+		Alias
+		NearbyRestaurantIDs []string `json:"nearby_res,omitempty"`
+	}{Alias: Alias(p)}
 
-	err = c.Do(c.Auth(WithCtx(ctx, GeoCodeReq{Latitude: lat, Longitude: long})), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
+	for _, id := range p.NearbyRestaurantIDs {
+		t.NearbyRestaurantIDs = append(t.NearbyRestaurantIDs, strconv.FormatInt(id, 10))
+	}
+	return json.Marshal(t)
 }
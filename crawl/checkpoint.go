@@ -0,0 +1,150 @@
+package crawl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// City is the city being crawled
+type City struct {
+	// Query is the city name the crawl was started with
+	Query     string  `json:"query,omitempty"`
+	ID        int64   `json:"id"`
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Partition is a search enumerating part of the restaurants of a city
+type Partition struct {
+	// Kind is one of city, cuisine, establishment, category or tile
+	Kind string `json:"kind"`
+	// Key identifies the partition among those of its kind
+	Key string           `json:"key"`
+	Req zomato.SearchReq `json:"req"`
+
+	// Next is the start of the next page
+	Next uint64 `json:"next"`
+	// Found is the number of restaurants the search reports
+	Found int64 `json:"found"`
+	Done  bool  `json:"done"`
+}
+
+// Progress is the progress of hydrating a restaurant
+type Progress struct {
+	// Hydrated, Reviews and DailyMenu report the details done with: stored,
+	// or rejected by the API
+	Hydrated  bool `json:"hydrated,omitempty"`
+	Reviews   bool `json:"reviews,omitempty"`
+	DailyMenu bool `json:"daily_menu,omitempty"`
+	// Failed lists the details the API rejected
+	Failed []string `json:"failed,omitempty"`
+}
+
+// failed reports whether the API rejected detail 'what' of the restaurant.
+func (p *Progress) failed(what string) bool {
+	for _, f := range p.Failed {
+		if strings.HasPrefix(f, what+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkpoint is the saved state of a crawl
+type checkpoint struct {
+	City        *City               `json:"city,omitempty"`
+	Partitions  []Partition         `json:"partitions,omitempty"`
+	Restaurants map[int64]*Progress `json:"restaurants"`
+	Calls       map[string]int      `json:"calls"`
+	Done        bool                `json:"done,omitempty"`
+}
+
+// loadCheckpoint reads the checkpoint at 'path', returning a new one if
+// 'path' is empty or does not exist.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	cp := &checkpoint{Restaurants: map[int64]*Progress{}, Calls: map[string]int{}}
+	if path == "" {
+		return cp, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read checkpoint failed")
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, errors.Wrapf(err, "decode checkpoint %s failed", path)
+	}
+	return cp, nil
+}
+
+// save writes the checkpoint to 'path', replacing it atomically.
+func (cp *checkpoint) save(path string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return errors.Wrap(err, "encode checkpoint failed")
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "save checkpoint failed")
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return errors.Wrap(err, "save checkpoint failed")
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return errors.Wrap(err, "save checkpoint failed")
+	}
+	return errors.Wrap(os.Rename(f.Name(), path), "save checkpoint failed")
+}
+
+// report summarises the checkpoint.
+func (cp *checkpoint) report() Report {
+	r := Report{Calls: map[string]int{}, Partitions: len(cp.Partitions), Found: len(cp.Restaurants), Done: cp.Done}
+	if cp.City != nil {
+		r.City = cp.City.Name
+	}
+	for m, n := range cp.Calls {
+		r.Calls[m] = n
+	}
+
+	for _, p := range cp.Partitions {
+		if p.Done {
+			r.Searched++
+		}
+		if p.Found > maxResults {
+			r.Truncated++
+		}
+		if p.Kind == cityPartition {
+			r.Estimated = p.Found
+		}
+	}
+
+	for _, p := range cp.Restaurants {
+		if len(p.Failed) > 0 {
+			r.Failed++
+		}
+		if p.Hydrated && !p.failed("restaurant") {
+			r.Hydrated++
+		}
+		if p.Reviews && !p.failed("reviews") {
+			r.Reviewed++
+		}
+		if p.DailyMenu && !p.failed("daily menu") {
+			r.DailyMenus++
+		}
+	}
+	return r
+}
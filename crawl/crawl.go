@@ -0,0 +1,369 @@
+// Package crawl snapshots every restaurant of a city into a store.
+//
+// Search returns at most 100 restaurants per query, so the crawler splits a
+// city into partitions: the whole city, each of its cuisines,
+// establishments and categories, and geo tiles covering the area around
+// its centre. Restaurants found by any partition are hydrated with
+// Restaurant and optionally Reviews and DailyMenu.
+//
+// Progress is saved to a checkpoint file after each call, so a crawl
+// stopped by a crash, quota exhaustion or its call budget resumes where it
+// left off when run again:
+//
+//	c := &crawl.Crawler{API: client, Store: s, City: "Delhi", Checkpoint: "delhi.json", MaxCalls: 900}
+//	report, err := c.Run(ctx)
+//	fmt.Print(report)
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+// ErrBudget is returned when a run has made MaxCalls calls
+var ErrBudget = errors.New("crawl: call budget exhausted")
+
+// Defaults of Crawler fields
+const (
+	DefaultTileRadius = 2000
+	DefaultExtent     = 25000
+)
+
+// Search limits of the API
+const (
+	pageSize   = 20
+	maxResults = 100
+)
+
+// Crawler crawls the restaurants of a city
+type Crawler struct {
+	API zomato.API
+	// Store receives the crawled data
+	Store *store.Store
+	// Checkpoint is the path of the file progress is saved to and resumed
+	// from; progress is not saved if empty
+	Checkpoint string
+
+	// City is the name of the city, used if CityID is 0
+	City   string
+	CityID int64
+
+	// Reviews and DailyMenus enable fetching them for each restaurant
+	Reviews    bool
+	DailyMenus bool
+
+	// TileRadius is the search radius of each geo tile in meters;
+	// defaults to DefaultTileRadius
+	TileRadius float64
+	// Extent is the distance from the city centre covered by tiles in
+	// meters; defaults to DefaultExtent
+	Extent float64
+
+	// MaxCalls limits the calls of a run; 0 is unlimited
+	MaxCalls int
+	// Logf logs progress if not nil
+	Logf func(format string, args ...interface{})
+}
+
+// Run crawls the city, resuming from the checkpoint if it exists. A run
+// stopped by an error can be resumed by calling Run again.
+func (c *Crawler) Run(ctx context.Context) (Report, error) {
+	cp, err := loadCheckpoint(c.Checkpoint)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var calls int
+	api := zomato.Intercept(c.API, func(ctx context.Context, method string, req zomato.Requester,
+		invoke func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+		if c.MaxCalls > 0 && calls >= c.MaxCalls {
+			return nil, ErrBudget
+		}
+		calls++
+		cp.Calls[method]++
+		return invoke(ctx)
+	})
+
+	err = c.run(ctx, api, cp)
+	if serr := c.save(cp); err == nil {
+		err = serr
+	}
+	return cp.report(), err
+}
+
+func (c *Crawler) run(ctx context.Context, api zomato.API, cp *checkpoint) error {
+	if cp.City == nil {
+		city, err := c.resolve(ctx, api)
+		if err != nil {
+			return errors.Wrap(err, "resolve city failed")
+		}
+		cp.City = city
+		c.logf("crawling %s (%d)", city.Name, city.ID)
+		if err := c.save(cp); err != nil {
+			return err
+		}
+	} else if !c.matches(cp.City) {
+		return errors.Errorf("checkpoint %s is of city %s (%d)", c.Checkpoint, cp.City.Name, cp.City.ID)
+	}
+
+	if cp.Partitions == nil {
+		ps, err := c.plan(ctx, api, cp.City)
+		if err != nil {
+			return errors.Wrap(err, "plan partitions failed")
+		}
+		cp.Partitions = ps
+		c.logf("searching %d partitions", len(ps))
+		if err := c.save(cp); err != nil {
+			return err
+		}
+	}
+
+	for i := range cp.Partitions {
+		p := &cp.Partitions[i]
+		for !p.Done {
+			if err := c.search(ctx, api, cp, p); err != nil {
+				return errors.Wrapf(err, "search %s %s failed", p.Kind, p.Key)
+			}
+			if err := c.save(cp); err != nil {
+				return err
+			}
+		}
+	}
+
+	ids := make([]int64, 0, len(cp.Restaurants))
+	for id := range cp.Restaurants {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if err := c.hydrate(ctx, api, cp, id); err != nil {
+			return errors.Wrapf(err, "hydrate restaurant %d failed", id)
+		}
+	}
+
+	if !cp.Done {
+		cp.Done = true
+		c.logf("crawled %d restaurants", len(cp.Restaurants))
+	}
+	return nil
+}
+
+// search fetches the next page of partition 'p'.
+func (c *Crawler) search(ctx context.Context, api zomato.API, cp *checkpoint, p *Partition) error {
+	req := p.Req
+	req.Start, req.Count = p.Next, pageSize
+	resp, err := api.Search(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var found []zomato.Restaurant
+	for _, r := range resp.Restaurants {
+		if r.Restaurant == nil || r.Restaurant.ID == nil {
+			continue
+		}
+		// Geo tiles reach into neighbouring cities
+		if l := r.Restaurant.Location; l != nil && l.CityID != nil && *l.CityID != cp.City.ID {
+			continue
+		}
+		if _, ok := cp.Restaurants[*r.Restaurant.ID]; !ok {
+			found = append(found, *r.Restaurant)
+		}
+	}
+	// Restaurants are checkpointed once stored, so a resumed crawl stores
+	// them if this fails
+	if err := c.Store.PutRestaurants(ctx, found...); err != nil {
+		return err
+	}
+	for _, r := range found {
+		cp.Restaurants[*r.ID] = &Progress{}
+	}
+
+	p.Found = resp.ResultsFound
+	p.Next += uint64(len(resp.Restaurants))
+	limit := p.Found
+	if limit > maxResults {
+		limit = maxResults
+	}
+	p.Done = len(resp.Restaurants) == 0 || int64(p.Next) >= limit
+	if p.Done {
+		c.logf("searched %s %s: %d found, %d restaurants in total", p.Kind, p.Key, p.Found, len(cp.Restaurants))
+	}
+	return nil
+}
+
+// hydrate fetches the pending details of restaurant 'id'.
+func (c *Crawler) hydrate(ctx context.Context, api zomato.API, cp *checkpoint, id int64) error {
+	p := cp.Restaurants[id]
+
+	if !p.Hydrated {
+		r, err := api.Restaurant(ctx, id)
+		if err = c.skip(err, p, "restaurant"); err != nil {
+			return err
+		}
+		if r.ID != nil {
+			if err := c.Store.PutRestaurants(ctx, r); err != nil {
+				return err
+			}
+		}
+		p.Hydrated = true
+		if err := c.save(cp); err != nil {
+			return err
+		}
+	}
+
+	if c.Reviews && !p.Reviews {
+		resp, err := api.Reviews(ctx, zomato.ReviewsReq{RestaurantID: id})
+		if err = c.skip(err, p, "reviews"); err != nil {
+			return err
+		}
+		var reviews []zomato.Review
+		for _, r := range resp.UserReviews {
+			if r.Review != nil {
+				reviews = append(reviews, *r.Review)
+			}
+		}
+		if err := c.Store.PutReviews(ctx, id, reviews...); err != nil {
+			return err
+		}
+		p.Reviews = true
+		if err := c.save(cp); err != nil {
+			return err
+		}
+	}
+
+	if c.DailyMenus && !p.DailyMenu {
+		resp, err := api.DailyMenu(ctx, id)
+		if err = c.skip(err, p, "daily menu"); err != nil {
+			return err
+		}
+		var menus []zomato.DailyMenu
+		for _, m := range resp.DailyMenus {
+			if m.DailyMenu != nil {
+				menus = append(menus, *m.DailyMenu)
+			}
+		}
+		if err := c.Store.PutDailyMenus(ctx, id, menus...); err != nil {
+			return err
+		}
+		p.DailyMenu = true
+		if err := c.save(cp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skip returns nil for errors of 'what' rejecting the restaurant, such as
+// one having no daily menu, recording them in 'p'. Other errors stop the
+// crawl.
+func (c *Crawler) skip(err error, p *Progress, what string) error {
+	if err == nil {
+		return nil
+	}
+	apiErr, ok := errors.Cause(err).(*zomato.ErrAPI)
+	if !ok || (apiErr.StatusCode != http.StatusBadRequest && apiErr.StatusCode != http.StatusNotFound) {
+		return err
+	}
+	p.Failed = append(p.Failed, fmt.Sprintf("%s: %d %s", what, apiErr.StatusCode, http.StatusText(apiErr.StatusCode)))
+	return nil
+}
+
+// matches reports whether checkpoint city 'city' is the city to crawl.
+func (c *Crawler) matches(city *City) bool {
+	if c.CityID != 0 {
+		return city.ID == c.CityID
+	}
+	return strings.EqualFold(city.Query, c.City)
+}
+
+func (c *Crawler) save(cp *checkpoint) error {
+	if c.Checkpoint == "" {
+		return nil
+	}
+	return cp.save(c.Checkpoint)
+}
+
+func (c *Crawler) logf(format string, args ...interface{}) {
+	if c.Logf != nil {
+		c.Logf(format, args...)
+	}
+}
+
+// Report summarises the progress of a crawl
+type Report struct {
+	City string
+	// Calls holds the calls made by method, over all runs
+	Calls map[string]int
+
+	// Partitions is the number of search partitions, of which Searched are
+	// complete and Truncated found more restaurants than Search returns
+	Partitions, Searched, Truncated int
+	// Estimated is the number of restaurants the search of the whole city
+	// found
+	Estimated int64
+
+	// Found is the number of restaurants found, of which Hydrated have
+	// details, Reviewed have reviews and DailyMenus have daily menus
+	Found, Hydrated, Reviewed, DailyMenus int
+	// Failed is the number of restaurants with details the API rejected,
+	// which are not counted above
+	Failed int
+
+	// Done reports whether the crawl is complete
+	Done bool
+}
+
+// TotalCalls returns the number of calls made.
+func (r Report) TotalCalls() int {
+	var n int
+	for _, c := range r.Calls {
+		n += c
+	}
+	return n
+}
+
+// Coverage returns the fraction of the Estimated restaurants found, at
+// most 1.
+func (r Report) Coverage() float64 {
+	if r.Estimated == 0 {
+		return 0
+	}
+	if cov := float64(r.Found) / float64(r.Estimated); cov < 1 {
+		return cov
+	}
+	return 1
+}
+
+// String formats the report on multiple lines.
+func (r Report) String() string {
+	var b strings.Builder
+	status := "in progress"
+	if r.Done {
+		status = "done"
+	}
+	fmt.Fprintf(&b, "city:        %s (%s)\n", r.City, status)
+	fmt.Fprintf(&b, "partitions:  %d/%d searched, %d truncated\n", r.Searched, r.Partitions, r.Truncated)
+	fmt.Fprintf(&b, "restaurants: %d found of %d estimated (%.1f%% coverage)\n", r.Found, r.Estimated, 100*r.Coverage())
+	fmt.Fprintf(&b, "hydrated:    %d restaurants, %d reviews, %d daily menus, %d failed\n",
+		r.Hydrated, r.Reviewed, r.DailyMenus, r.Failed)
+
+	methods := make([]string, 0, len(r.Calls))
+	for m := range r.Calls {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	calls := make([]string, len(methods))
+	for i, m := range methods {
+		calls[i] = fmt.Sprintf("%s %d", m, r.Calls[m])
+	}
+	fmt.Fprintf(&b, "calls:       %d (%s)\n", r.TotalCalls(), strings.Join(calls, ", "))
+	return b.String()
+}
@@ -0,0 +1,409 @@
+package crawl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/crawl"
+	"github.com/go-india/zomato/store"
+	"github.com/go-india/zomato/zomatotest"
+	"github.com/pkg/errors"
+)
+
+var ctx = context.Background()
+
+const (
+	cityLat, cityLon = 28.6, 77.2
+	restaurants      = 130
+)
+
+// city returns restaurant 'id' of a fake city 1 of 130 restaurants within
+// 9km of its centre, and a restaurant 131 of city 2.
+func city(id int64) zomato.Restaurant {
+	name := fmt.Sprintf("Restaurant %d", id)
+	cityID := int64(1)
+	angle, dist := float64(id)*0.7, float64(id%10)*900
+	lat := cityLat + dist*math.Sin(angle)/111320
+	lon := cityLon + dist*math.Cos(angle)/(111320*math.Cos(cityLat*math.Pi/180))
+	if id > restaurants {
+		cityID, lat = 2, cityLat+0.1
+	}
+	cuisine := "Chinese"
+	if id%2 == 1 {
+		cuisine = "Italian"
+	}
+	return zomato.Restaurant{
+		ID:       &id,
+		Name:     &name,
+		Cuisines: []string{cuisine},
+		Location: &zomato.RestaurantLocation{CityID: &cityID, Latitude: &lat, Longitude: &lon},
+	}
+}
+
+// search emulates Search over the fake city.
+func search(req zomato.SearchReq) zomato.SearchResp {
+	var ids []int64
+	for id := int64(1); id <= restaurants+1; id++ {
+		r := city(id)
+		l := r.Location
+		switch {
+		case req.EntityType == zomato.CityEntity && *l.CityID != req.EntityID,
+			len(req.Cuisines) > 0 && req.Cuisines[0] != strconv.FormatInt(id%2+1, 10),
+			req.Establishment != "" && id%3 != 0,
+			req.Category != "" && id%5 != 0,
+			req.Radius > 0 && distance(req.Latitude, req.Longitude, *l.Latitude, *l.Longitude) > req.Radius:
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	resp := zomato.SearchResp{ResultsFound: int64(len(ids)), ResultsStart: int64(req.Start)}
+	if len(ids) > 100 {
+		ids = ids[:100]
+	}
+	for i := int(req.Start); i < len(ids) && i < int(req.Start+req.Count); i++ {
+		r := city(ids[i])
+		resp.Restaurants = append(resp.Restaurants, struct {
+			Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+		}{&r})
+	}
+	resp.ResultsShown = int64(len(resp.Restaurants))
+	return resp
+}
+
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	y := (lat2 - lat1) * 111320
+	x := (lon2 - lon1) * 111320 * math.Cos(lat1*math.Pi/180)
+	return math.Hypot(x, y)
+}
+
+// newMock returns a mock API of the fake city, failing call number 'failAt'
+// with 'err' if it is not nil.
+func newMock(failAt int, err error) *zomatotest.Mock {
+	m := &zomatotest.Mock{}
+	var calls int
+	fail := func() error {
+		calls++
+		if err != nil && calls == failAt {
+			return err
+		}
+		return nil
+	}
+
+	m.CitiesFunc = func(ctx context.Context, req zomato.CitiesReq) (resp zomato.CitiesResp, err error) {
+		resp.LocationSuggestions = []zomato.City{{ID: 1, Name: "Delhi NCR"}}
+		return resp, fail()
+	}
+	m.LocationsFunc = func(ctx context.Context, req zomato.LocationsReq) (resp zomato.LocationsResp, err error) {
+		entityType, id, lat, lon := string(zomato.CityEntity), int64(1), cityLat, cityLon
+		resp.LocationSuggestions = []zomato.Location{{EntityType: &entityType, EntityID: &id, CityID: &id, Latitude: &lat, Longitude: &lon}}
+		return resp, fail()
+	}
+	m.CuisinesFunc = func(ctx context.Context, req zomato.CuisinesReq) (resp zomato.CuisinesResp, err error) {
+		for _, c := range []zomato.Cuisine{{ID: 1, Name: "Chinese"}, {ID: 2, Name: "Italian"}} {
+			c := c
+			resp.Cuisines = append(resp.Cuisines, struct {
+				Cuisine *zomato.Cuisine `json:"cuisine,omitempty"`
+			}{&c})
+		}
+		return resp, fail()
+	}
+	m.EstablishmentsFunc = func(ctx context.Context, req zomato.EstablishmentsReq) (resp zomato.EstablishmentsResp, err error) {
+		resp.Establishments = append(resp.Establishments, struct {
+			Establishment *zomato.Establishment `json:"establishment,omitempty"`
+		}{&zomato.Establishment{ID: 16, Name: "Casual Dining"}})
+		return resp, fail()
+	}
+	m.CategoriesFunc = func(ctx context.Context) (resp zomato.CategoriesResp, err error) {
+		resp.Categories = append(resp.Categories, struct {
+			Categorie *zomato.Categorie `json:"categories,omitempty"`
+		}{&zomato.Categorie{ID: 1, Name: "Delivery"}})
+		return resp, fail()
+	}
+	m.SearchFunc = func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+		return search(req), fail()
+	}
+	m.RestaurantFunc = func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+		r := city(restaurantID)
+		phone := "011 2326 9880"
+		r.PhoneNumbers = &phone
+		return r, fail()
+	}
+	m.ReviewsFunc = func(ctx context.Context, req zomato.ReviewsReq) (resp zomato.ReviewsResp, err error) {
+		id := req.RestaurantID * 10
+		resp.UserReviews = append(resp.UserReviews, struct {
+			Review *zomato.Review `json:"review,omitempty"`
+		}{&zomato.Review{ID: &id}})
+		return resp, fail()
+	}
+	m.DailyMenuFunc = func(ctx context.Context, restaurantID int64) (resp zomato.DailyMenuResp, err error) {
+		if err := fail(); err != nil {
+			return resp, err
+		}
+		if restaurantID%2 == 1 {
+			return resp, errors.Wrap(&zomato.ErrAPI{StatusCode: http.StatusBadRequest}, "Client.Do failed")
+		}
+		id := restaurantID * 100
+		resp.DailyMenus = append(resp.DailyMenus, struct {
+			DailyMenu *zomato.DailyMenu `json:"daily_menu,omitempty"`
+		}{&zomato.DailyMenu{ID: &id}})
+		return resp, nil
+	}
+	return m
+}
+
+// newCrawler returns a crawler of the fake city saving to a new store and
+// checkpoint in 'dir'.
+func newCrawler(t *testing.T, dir string, api zomato.API) *crawl.Crawler {
+	s, err := store.Open(filepath.Join(dir, "zomato.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return &crawl.Crawler{
+		API:        api,
+		Store:      s,
+		Checkpoint: filepath.Join(dir, "checkpoint.json"),
+		City:       "Delhi",
+		Reviews:    true,
+		DailyMenus: true,
+		TileRadius: 5000,
+		Extent:     10000,
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "crawl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	dir := tempDir(t)
+	c := newCrawler(t, dir, newMock(0, nil))
+
+	report, err := c.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done || report.City != "Delhi NCR" || report.Found != restaurants || report.Estimated != restaurants ||
+		report.Coverage() != 1 || report.Searched != report.Partitions || report.Truncated != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	// Odd restaurants have no daily menu
+	if report.Hydrated != restaurants || report.Reviewed != restaurants || report.DailyMenus != restaurants/2 ||
+		report.Failed != restaurants/2 {
+		t.Errorf("report = %+v", report)
+	}
+	for _, m := range []string{"Restaurant", "Reviews", "DailyMenu"} {
+		if report.Calls[m] != restaurants {
+			t.Errorf("%d calls to %s, want %d", report.Calls[m], m, restaurants)
+		}
+	}
+	if report.String() == "" {
+		t.Errorf("empty report")
+	}
+
+	rs, err := c.Store.Restaurants(ctx, store.RestaurantQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != restaurants {
+		t.Errorf("stored %d restaurants, want %d", len(rs), restaurants)
+	}
+	for _, r := range rs {
+		if r.Restaurant.PhoneNumbers == nil {
+			t.Errorf("restaurant %d not hydrated", *r.Restaurant.ID)
+		}
+	}
+	if reviews, _ := c.Store.Reviews(ctx, 7); len(reviews) != 1 {
+		t.Errorf("stored %d reviews of restaurant 7, want 1", len(reviews))
+	}
+	if menus, _ := c.Store.DailyMenus(ctx, 8); len(menus) != 1 {
+		t.Errorf("stored %d daily menus of restaurant 8, want 1", len(menus))
+	}
+
+	// Running a complete crawl again makes no calls
+	again, err := c.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.TotalCalls() != report.TotalCalls() {
+		t.Errorf("rerun made %d calls", again.TotalCalls()-report.TotalCalls())
+	}
+}
+
+func TestTiles(t *testing.T) {
+	dir := tempDir(t)
+	c := newCrawler(t, dir, newMock(0, nil))
+	c.Reviews, c.DailyMenus = false, false
+	if _, err := c.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(c.Checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cp struct {
+		Partitions []crawl.Partition
+	}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		t.Fatal(err)
+	}
+	var tiles []zomato.SearchReq
+	for _, p := range cp.Partitions {
+		if p.Kind == "tile" {
+			tiles = append(tiles, p.Req)
+		}
+	}
+	if len(tiles) == 0 {
+		t.Fatal("no tiles")
+	}
+
+	// Every point within the extent is within the radius of a tile
+	for y := -c.Extent; y <= c.Extent; y += 500 {
+		for x := -c.Extent; x <= c.Extent; x += 500 {
+			if math.Hypot(x, y) > c.Extent {
+				continue
+			}
+			lat := cityLat + y/111320
+			lon := cityLon + x/(111320*math.Cos(cityLat*math.Pi/180))
+			covered := false
+			for _, tile := range tiles {
+				if distance(tile.Latitude, tile.Longitude, lat, lon) <= c.TileRadius+1 {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				t.Errorf("point %.0f,%.0f not covered", x, y)
+			}
+		}
+	}
+}
+
+func TestResume(t *testing.T) {
+	full, err := newCrawler(t, tempDir(t), newMock(0, nil)).Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Crawl 50 calls at a time, with a new crawler for each run
+	dir := tempDir(t)
+	m := newMock(0, nil)
+	var report crawl.Report
+	for runs := 1; !report.Done; runs++ {
+		if runs > full.TotalCalls() {
+			t.Fatal("crawl does not progress")
+		}
+		c := newCrawler(t, dir, m)
+		c.MaxCalls = 50
+		report, err = c.Run(ctx)
+		if err != nil && errors.Cause(err) != crawl.ErrBudget {
+			t.Fatal(err)
+		}
+		if report.Done != (err == nil) {
+			t.Fatalf("run %d: done %t, error %v", runs, report.Done, err)
+		}
+	}
+	if !reflect.DeepEqual(report.Calls, full.Calls) {
+		t.Errorf("resumed calls %v, want %v", report.Calls, full.Calls)
+	}
+	if report.Found != full.Found || report.Failed != full.Failed {
+		t.Errorf("resumed report %+v, want %+v", report, full)
+	}
+	if len(m.Calls()) != full.TotalCalls() {
+		t.Errorf("made %d calls, want %d", len(m.Calls()), full.TotalCalls())
+	}
+}
+
+func TestResumeAfterError(t *testing.T) {
+	quota := &zomato.ErrAPI{StatusCode: http.StatusTooManyRequests}
+	dir := tempDir(t)
+
+	report, err := newCrawler(t, dir, newMock(200, quota)).Run(ctx)
+	if errors.Cause(err) != quota || report.Done {
+		t.Fatalf("error = %v, done %t; want quota error", err, report.Done)
+	}
+
+	report, err = newCrawler(t, dir, newMock(0, nil)).Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done || report.Found != restaurants || report.Hydrated != restaurants {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestResumeAfterStoreError(t *testing.T) {
+	dir := tempDir(t)
+	m := newMock(0, nil)
+	c := newCrawler(t, dir, m)
+
+	// The store fails from the third search on
+	search, searches := m.SearchFunc, 0
+	m.SearchFunc = func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+		if searches++; searches == 3 {
+			c.Store.Close()
+		}
+		return search(ctx, req)
+	}
+	if _, err := c.Run(ctx); err == nil {
+		t.Fatal("Run() with a closed store error = nil")
+	}
+
+	// Restaurants checkpointed are stored
+	data, err := ioutil.ReadFile(c.Checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cp struct{ Restaurants map[int64]json.RawMessage }
+	if err := json.Unmarshal(data, &cp); err != nil {
+		t.Fatal(err)
+	}
+	c = newCrawler(t, dir, newMock(0, nil))
+	rs, err := c.Store.Restaurants(ctx, store.RestaurantQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cp.Restaurants) == 0 || len(rs) != len(cp.Restaurants) {
+		t.Errorf("stored %d restaurants, checkpointed %d", len(rs), len(cp.Restaurants))
+	}
+
+	report, err := c.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Done || report.Found != restaurants || report.Hydrated != restaurants {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestCheckpointOfOtherCity(t *testing.T) {
+	dir := tempDir(t)
+	c := newCrawler(t, dir, newMock(0, nil))
+	c.MaxCalls = 10
+	if _, err := c.Run(ctx); errors.Cause(err) != crawl.ErrBudget {
+		t.Fatal(err)
+	}
+
+	c.City = "Mumbai"
+	if _, err := c.Run(ctx); err == nil {
+		t.Error("crawl of another city resumed")
+	}
+}
@@ -0,0 +1,151 @@
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// Partition kinds
+const (
+	cityPartition          = "city"
+	cuisinePartition       = "cuisine"
+	establishmentPartition = "establishment"
+	categoryPartition      = "category"
+	tilePartition          = "tile"
+)
+
+// resolve finds the city to crawl and its centre.
+func (c *Crawler) resolve(ctx context.Context, api zomato.API) (*City, error) {
+	req := zomato.CitiesReq{Query: c.City, Count: 1}
+	if c.CityID != 0 {
+		req = zomato.CitiesReq{CityIDs: []int64{c.CityID}}
+	} else if c.City == "" {
+		return nil, errors.New("no city")
+	}
+	cities, err := api.Cities(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(cities.LocationSuggestions) == 0 {
+		return nil, errors.Errorf("city %q not found", c.City)
+	}
+	city := &City{Query: c.City, ID: cities.LocationSuggestions[0].ID, Name: cities.LocationSuggestions[0].Name}
+
+	locations, err := api.Locations(ctx, zomato.LocationsReq{Query: city.Name})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range locations.LocationSuggestions {
+		if l.EntityType == nil || zomato.EntityType(*l.EntityType) != zomato.CityEntity ||
+			l.EntityID == nil || *l.EntityID != city.ID || l.Latitude == nil || l.Longitude == nil {
+			continue
+		}
+		city.Latitude, city.Longitude = *l.Latitude, *l.Longitude
+		if err := c.Store.PutLocations(ctx, l); err != nil {
+			return nil, err
+		}
+		return city, nil
+	}
+	return nil, errors.Errorf("no location of city %s (%d)", city.Name, city.ID)
+}
+
+// plan returns the search partitions of 'city'.
+func (c *Crawler) plan(ctx context.Context, api zomato.API, city *City) ([]Partition, error) {
+	inCity := zomato.SearchReq{EntityID: city.ID, EntityType: zomato.CityEntity}
+	ps := []Partition{{Kind: cityPartition, Key: strconv.FormatInt(city.ID, 10), Req: inCity}}
+
+	cuisines, err := api.Cuisines(ctx, zomato.CuisinesReq{CityID: city.ID})
+	if err != nil {
+		return nil, err
+	}
+	var cs []zomato.Cuisine
+	for _, cu := range cuisines.Cuisines {
+		if cu.Cuisine == nil {
+			continue
+		}
+		cs = append(cs, *cu.Cuisine)
+		key := strconv.FormatInt(cu.Cuisine.ID, 10)
+		req := inCity
+		req.Cuisines = []string{key}
+		ps = append(ps, Partition{Kind: cuisinePartition, Key: key, Req: req})
+	}
+	if err := c.Store.PutCuisines(ctx, city.ID, cs...); err != nil {
+		return nil, err
+	}
+
+	establishments, err := api.Establishments(ctx, zomato.EstablishmentsReq{CityID: city.ID})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range establishments.Establishments {
+		if e.Establishment == nil {
+			continue
+		}
+		key := strconv.FormatInt(e.Establishment.ID, 10)
+		req := inCity
+		req.Establishment = key
+		ps = append(ps, Partition{Kind: establishmentPartition, Key: key, Req: req})
+	}
+
+	categories, err := api.Categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cat := range categories.Categories {
+		if cat.Categorie == nil {
+			continue
+		}
+		key := strconv.FormatInt(cat.Categorie.ID, 10)
+		req := inCity
+		req.Category = key
+		ps = append(ps, Partition{Kind: categoryPartition, Key: key, Req: req})
+	}
+
+	radius := c.TileRadius
+	if radius <= 0 {
+		radius = DefaultTileRadius
+	}
+	extent := c.Extent
+	if extent <= 0 {
+		extent = DefaultExtent
+	}
+	for _, t := range tiles(city.Latitude, city.Longitude, extent, radius) {
+		ps = append(ps, Partition{
+			Kind: tilePartition,
+			Key:  fmt.Sprintf("%.5f,%.5f", t[0], t[1]),
+			Req:  zomato.SearchReq{Latitude: t[0], Longitude: t[1], Radius: radius},
+		})
+	}
+	return ps, nil
+}
+
+// metersPerDegree is the length of a degree of latitude
+const metersPerDegree = 111320
+
+// tiles returns the centres of circles of 'radius' meters covering the
+// circle of 'extent' meters around 'lat', 'lon'.
+//
+// Centres lie on a square grid whose cells are inscribed in the circles.
+func tiles(lat, lon, extent, radius float64) [][2]float64 {
+	step := radius * math.Sqrt2
+	n := int(math.Ceil(extent / step))
+	cos := math.Cos(lat * math.Pi / 180)
+
+	var ts [][2]float64
+	for i := -n; i <= n; i++ {
+		for j := -n; j <= n; j++ {
+			y, x := float64(i)*step, float64(j)*step
+			// Skip cells entirely outside the extent
+			if math.Hypot(math.Max(math.Abs(y)-step/2, 0), math.Max(math.Abs(x)-step/2, 0)) > extent {
+				continue
+			}
+			ts = append(ts, [2]float64{lat + y/metersPerDegree, lon + x/(metersPerDegree*cos)})
+		}
+	}
+	return ts
+}
@@ -0,0 +1,175 @@
+// Package cuisine normalizes cuisine names and groups them into families,
+// so that restaurants, search filters and reports name cuisines alike.
+//
+//	cuisine.Normalize(" mughalai ") // "Mughlai"
+//	cuisine.Family("Mughlai")      // "Indian"
+//
+// A Taxonomy maps names and families to the cuisine IDs search takes in a
+// city:
+//
+//	t := cuisine.NewTaxonomy(client)
+//	ids, err := t.FamilyIDs(ctx, 1, cuisine.Indian)
+//	req := zomato.SearchReq{EntityID: 1, EntityType: zomato.CityEntity, Cuisines: ids}
+package cuisine
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknown is returned for cuisines not served in a city
+var ErrUnknown = errors.New("cuisine: unknown cuisine")
+
+// names maps keys of cuisine names and their aliases to cuisine names
+var names = make(map[string]string)
+
+func init() {
+	for name := range families {
+		names[key(name)] = name
+	}
+	for alias, name := range aliases {
+		names[alias] = name
+	}
+}
+
+// key lower cases 'name', keeping words of letters and digits only.
+func key(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// lookup returns the known cuisine name of 'name', trying its plural and
+// singular too.
+func lookup(name string) (string, bool) {
+	k := key(name)
+	if k == "" {
+		return "", false
+	}
+	variants := []string{k, k + "s", strings.TrimSuffix(k, "s"), strings.TrimSuffix(k, "es")}
+	if strings.HasSuffix(k, "ies") {
+		variants = append(variants, strings.TrimSuffix(k, "ies")+"y")
+	}
+	for _, variant := range variants {
+		if n, ok := names[variant]; ok {
+			return n, true
+		}
+	}
+	return "", false
+}
+
+// Normalize returns the name the Cuisines API gives cuisine 'name', which
+// may differ in case, punctuation, spelling or plural. Unknown names are
+// returned trimmed.
+func Normalize(name string) string {
+	if n, ok := lookup(name); ok {
+		return n
+	}
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// Family returns the family of cuisine 'name', Other for unknown ones.
+func Family(name string) string {
+	if n, ok := lookup(name); ok {
+		return families[n]
+	}
+	return Other
+}
+
+// Families returns the families of the cuisines of 'r', in order.
+func Families(r zomato.Restaurant) []string {
+	var fs []string
+	seen := make(map[string]bool)
+	for _, c := range r.Cuisines {
+		if f := Family(c); !seen[f] {
+			seen[f] = true
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// Taxonomy maps cuisine names to the IDs of the cuisines of cities, fetched
+// once per city.
+//
+// Taxonomy is safe for use by multiple go routines.
+type Taxonomy struct {
+	API zomato.API
+
+	mu     sync.Mutex
+	cities map[int64][]zomato.Cuisine
+}
+
+// NewTaxonomy returns a taxonomy fetching cuisines from 'api'.
+func NewTaxonomy(api zomato.API) *Taxonomy {
+	return &Taxonomy{API: api}
+}
+
+// Add sets the cuisines of city 'cityID', as from the Cuisines API.
+func (t *Taxonomy) Add(cityID int64, cs ...zomato.Cuisine) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cities == nil {
+		t.cities = make(map[int64][]zomato.Cuisine)
+	}
+	t.cities[cityID] = cs
+}
+
+// Cuisines returns the cuisines of city 'cityID'.
+func (t *Taxonomy) Cuisines(ctx context.Context, cityID int64) ([]zomato.Cuisine, error) {
+	t.mu.Lock()
+	cs, found := t.cities[cityID]
+	t.mu.Unlock()
+	if found {
+		return cs, nil
+	}
+
+	resp, err := t.API.Cuisines(ctx, zomato.CuisinesReq{CityID: cityID})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range resp.Cuisines {
+		if c.Cuisine != nil {
+			cs = append(cs, *c.Cuisine)
+		}
+	}
+	t.Add(cityID, cs...)
+	return cs, nil
+}
+
+// ID returns the ID of cuisine 'name' in city 'cityID', or ErrUnknown.
+func (t *Taxonomy) ID(ctx context.Context, cityID int64, name string) (int64, error) {
+	cs, err := t.Cuisines(ctx, cityID)
+	if err != nil {
+		return 0, err
+	}
+	name = Normalize(name)
+	for _, c := range cs {
+		if strings.EqualFold(Normalize(c.Name), name) {
+			return c.ID, nil
+		}
+	}
+	return 0, ErrUnknown
+}
+
+// FamilyIDs returns the IDs of the cuisines of 'family' in city 'cityID',
+// formatted for SearchReq.Cuisines.
+func (t *Taxonomy) FamilyIDs(ctx context.Context, cityID int64, family string) ([]string, error) {
+	cs, err := t.Cuisines(ctx, cityID)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, c := range cs {
+		if Family(c.Name) == family {
+			ids = append(ids, strconv.FormatInt(c.ID, 10))
+		}
+	}
+	return ids, nil
+}
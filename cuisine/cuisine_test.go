@@ -0,0 +1,156 @@
+package cuisine_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/cuisine"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// cuisines mocks the Cuisines API with testdata/Cuisines.json
+func cuisines(t *testing.T) *zomatotest.Mock {
+	data, err := ioutil.ReadFile(filepath.Join("../testdata", "Cuisines.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp zomato.CuisinesResp
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	return &zomatotest.Mock{
+		CuisinesFunc: func(ctx context.Context, req zomato.CuisinesReq) (zomato.CuisinesResp, error) {
+			return resp, nil
+		},
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name, want, family string
+	}{
+		{"North Indian", "North Indian", cuisine.Indian},
+		{" north-indian ", "North Indian", cuisine.Indian},
+		{"Mughalai", "Mughlai", cuisine.Indian},
+		{"Kebabs", "Kebab", cuisine.Indian},
+		{"biriyani", "Biryani", cuisine.Indian},
+		{"Sandwiches", "Sandwich", cuisine.FastFood},
+		{"Bakeries", "Bakery", cuisine.Desserts},
+		{"dessert", "Desserts", cuisine.Desserts},
+		{"Café", "Cafe", cuisine.Beverages},
+		{"tex mex", "Tex-Mex", cuisine.American},
+		{"Fish & Chips", "Fish and Chips", cuisine.European},
+		{"Chinese", "Chinese", cuisine.Asian},
+		{"  Martian   Fusion ", "Martian Fusion", cuisine.Other},
+		{"", "", cuisine.Other},
+	}
+
+	for _, tt := range tests {
+		if got := cuisine.Normalize(tt.name); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if got := cuisine.Family(tt.name); got != tt.family {
+			t.Errorf("Family(%q) = %q, want %q", tt.name, got, tt.family)
+		}
+	}
+}
+
+func TestKnown(t *testing.T) {
+	cs, err := cuisine.NewTaxonomy(cuisines(t)).Cuisines(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	others := map[string]bool{"Australian": true, "Fusion": true, "Raw Meats": true, "Seafood": true}
+	for _, c := range cs {
+		if got := cuisine.Normalize(c.Name); got != c.Name {
+			t.Errorf("Normalize(%q) = %q, want it unchanged", c.Name, got)
+		}
+		if !others[c.Name] && cuisine.Family(c.Name) == cuisine.Other {
+			t.Errorf("Family(%q) = Other", c.Name)
+		}
+	}
+}
+
+func TestFamilies(t *testing.T) {
+	r := zomato.Restaurant{Cuisines: []string{"North Indian", "Chinese", "Mughlai", "Desserts", "Thai"}}
+	want := []string{cuisine.Indian, cuisine.Asian, cuisine.Desserts}
+	if got := cuisine.Families(r); !reflect.DeepEqual(got, want) {
+		t.Errorf("Families() = %q, want %q", got, want)
+	}
+	if got := cuisine.Families(zomato.Restaurant{}); got != nil {
+		t.Errorf("Families() without cuisines = %q, want nil", got)
+	}
+}
+
+func TestTaxonomy(t *testing.T) {
+	ctx := context.Background()
+	m := cuisines(t)
+	tx := cuisine.NewTaxonomy(m)
+
+	for name, want := range map[string]int64{"North Indian": 50, "mughalai": 75, "Kebabs": 178, "poke": 1019} {
+		id, err := tx.ID(ctx, 1, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != want {
+			t.Errorf("ID(%q) = %d, want %d", name, id, want)
+		}
+	}
+	if _, err := tx.ID(ctx, 1, "Martian"); err != cuisine.ErrUnknown {
+		t.Errorf("ID() of unknown cuisine error = %v, want ErrUnknown", err)
+	}
+
+	ids, err := tx.FamilyIDs(ctx, 1, cuisine.Desserts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"5", "100", "233", "183"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("FamilyIDs() = %q, want %q", ids, want)
+	}
+
+	if calls := len(m.CallsTo("Cuisines")); calls != 1 {
+		t.Errorf("Cuisines called %d times, want once per city", calls)
+	}
+	if _, err := tx.ID(ctx, 2, "North Indian"); err != nil {
+		t.Fatal(err)
+	}
+	if calls := m.CallsTo("Cuisines"); len(calls) != 2 || calls[1].Req.(zomato.CuisinesReq).CityID != 2 {
+		t.Errorf("Cuisines calls = %+v, want a call for city 2", calls)
+	}
+}
+
+func TestTaxonomyAdd(t *testing.T) {
+	tx := cuisine.NewTaxonomy(&zomatotest.Mock{})
+	tx.Add(7, zomato.Cuisine{ID: 1, Name: "American"}, zomato.Cuisine{ID: 168, Name: "Burger"})
+
+	ids, err := tx.FamilyIDs(context.Background(), 7, cuisine.American)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"1", "168"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("FamilyIDs() = %q, want %q", ids, want)
+	}
+}
+
+func TestTaxonomyErrors(t *testing.T) {
+	m := &zomatotest.Mock{
+		CuisinesFunc: func(ctx context.Context, req zomato.CuisinesReq) (zomato.CuisinesResp, error) {
+			return zomato.CuisinesResp{}, &zomato.ErrAPI{StatusCode: http.StatusServiceUnavailable}
+		},
+	}
+	tx := cuisine.NewTaxonomy(m)
+	for i := 0; i < 2; i++ {
+		if _, err := tx.ID(context.Background(), 1, "Chinese"); err == nil {
+			t.Error("ID() error = nil, want the API error")
+		}
+	}
+	if calls := len(m.CallsTo("Cuisines")); calls != 2 {
+		t.Errorf("Cuisines called %d times, want errors not kept", calls)
+	}
+}
@@ -0,0 +1,171 @@
+package cuisine
+
+// Families of cuisines
+const (
+	Indian        = "Indian"
+	Asian         = "Asian"
+	MiddleEastern = "Middle Eastern"
+	African       = "African"
+	European      = "European"
+	American      = "American"
+	LatinAmerican = "Latin American"
+	FastFood      = "Fast Food"
+	Desserts      = "Desserts"
+	Beverages     = "Beverages"
+	Healthy       = "Healthy"
+	Other         = "Other"
+)
+
+// families maps cuisine names, as the Cuisines API names them, to their
+// family
+var families = map[string]string{
+	"Andhra":           Indian,
+	"Assamese":         Indian,
+	"Awadhi":           Indian,
+	"Bengali":          Indian,
+	"Bihari":           Indian,
+	"Biryani":          Indian,
+	"Chettinad":        Indian,
+	"Goan":             Indian,
+	"Gujarati":         Indian,
+	"Hyderabadi":       Indian,
+	"Indian":           Indian,
+	"Kashmiri":         Indian,
+	"Kebab":            Indian,
+	"Kerala":           Indian,
+	"Lucknowi":         Indian,
+	"Maharashtrian":    Indian,
+	"Mangalorean":      Indian,
+	"Mithai":           Indian,
+	"Modern Indian":    Indian,
+	"Mughlai":          Indian,
+	"Naga":             Indian,
+	"North Eastern":    Indian,
+	"North Indian":     Indian,
+	"Oriya":            Indian,
+	"Parsi":            Indian,
+	"Rajasthani":       Indian,
+	"Sindhi":           Indian,
+	"South Indian":     Indian,
+	"Asian":            Asian,
+	"Burmese":          Asian,
+	"Cantonese":        Asian,
+	"Chinese":          Asian,
+	"Dumplings":        Asian,
+	"Indonesian":       Asian,
+	"Japanese":         Asian,
+	"Korean":           Asian,
+	"Malaysian":        Asian,
+	"Momos":            Asian,
+	"Nepalese":         Asian,
+	"Oriental":         Asian,
+	"Pakistani":        Asian,
+	"Pan Asian":        Asian,
+	"Poké":             Asian,
+	"Singaporean":      Asian,
+	"Sri Lankan":       Asian,
+	"Sushi":            Asian,
+	"Thai":             Asian,
+	"Tibetan":          Asian,
+	"Vietnamese":       Asian,
+	"Yum Cha":          Asian,
+	"Afghan":           MiddleEastern,
+	"Afghani":          MiddleEastern,
+	"Arabian":          MiddleEastern,
+	"Armenian":         MiddleEastern,
+	"Iranian":          MiddleEastern,
+	"Lebanese":         MiddleEastern,
+	"Middle Eastern":   MiddleEastern,
+	"Turkish":          MiddleEastern,
+	"African":          African,
+	"Ethiopian":        African,
+	"Moroccan":         African,
+	"Belgian":          European,
+	"British":          European,
+	"Continental":      European,
+	"Crepes":           European,
+	"European":         European,
+	"Fish and Chips":   European,
+	"French":           European,
+	"German":           European,
+	"Greek":            European,
+	"Italian":          European,
+	"Mediterranean":    European,
+	"Pizza":            European,
+	"Portuguese":       European,
+	"Russian":          European,
+	"Spanish":          European,
+	"American":         American,
+	"Bar Food":         American,
+	"BBQ":              American,
+	"Burger":           American,
+	"Charcoal Chicken": American,
+	"Grill":            American,
+	"Roast Chicken":    American,
+	"Steak":            American,
+	"Tex-Mex":          American,
+	"Chili":            LatinAmerican,
+	"Latin American":   LatinAmerican,
+	"Mexican":          LatinAmerican,
+	"Peruvian":         LatinAmerican,
+	"South American":   LatinAmerican,
+	"Fast Food":        FastFood,
+	"Finger Food":      FastFood,
+	"Rolls":            FastFood,
+	"Sandwich":         FastFood,
+	"Street Food":      FastFood,
+	"Wraps":            FastFood,
+	"Bakery":           Desserts,
+	"Desserts":         Desserts,
+	"Ice Cream":        Desserts,
+	"Patisserie":       Desserts,
+	"Beverages":        Beverages,
+	"Bubble Tea":       Beverages,
+	"Cafe":             Beverages,
+	"Cafe Food":        Beverages,
+	"Coffee and Tea":   Beverages,
+	"Juices":           Beverages,
+	"Tea":              Beverages,
+	"Healthy Food":     Healthy,
+	"Salad":            Healthy,
+	"Vegetarian":       Healthy,
+	"Australian":       Other,
+	"Fusion":           Other,
+	"Raw Meats":        Other,
+	"Seafood":          Other,
+}
+
+// aliases maps keys of spelling variants to cuisine names; plurals of
+// names need none
+var aliases = map[string]string{
+	"bar b q":      "BBQ",
+	"barbecue":     "BBQ",
+	"barbeque":     "BBQ",
+	"biriyani":     "Biryani",
+	"briyani":      "Biryani",
+	"café":         "Cafe",
+	"coffee":       "Coffee and Tea",
+	"crêpes":       "Crepes",
+	"desert":       "Desserts",
+	"fastfood":     "Fast Food",
+	"fish chips":   "Fish and Chips",
+	"fish n chips": "Fish and Chips",
+	"healthy":      "Healthy Food",
+	"icecream":     "Ice Cream",
+	"indo chinese": "Chinese",
+	"juice bar":    "Juices",
+	"kabab":        "Kebab",
+	"kabaab":       "Kebab",
+	"kebap":        "Kebab",
+	"middle east":  "Middle Eastern",
+	"moghlai":      "Mughlai",
+	"mughalai":     "Mughlai",
+	"northindian":  "North Indian",
+	"panasian":     "Pan Asian",
+	"poke":         "Poké",
+	"pure veg":     "Vegetarian",
+	"sea food":     "Seafood",
+	"southindian":  "South Indian",
+	"veg":          "Vegetarian",
+	"yumcha":       "Yum Cha",
+}
@@ -0,0 +1,262 @@
+// Package diff detects changes between two snapshots of restaurants.
+//
+// Restaurants are matched by ID. Compare reports new and closed
+// restaurants, rating and cost moves, delivery toggles, and new events and
+// reviews as a typed ChangeSet, which renders as text or as a JSON patch:
+//
+//	changes := diff.Compare(yesterday, today)
+//	err := changes.WriteText(os.Stdout)
+//	patch, err := diff.Patch(yesterday, changes)
+package diff
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+)
+
+// Snapshot holds restaurants and their related records at a point in time
+type Snapshot struct {
+	Restaurants []zomato.Restaurant
+	// Reviews by restaurant ID, besides those in Restaurant.Reviews
+	Reviews map[int64][]zomato.Review
+	// Events by restaurant ID, besides those in Restaurant.ZomatoEvents
+	Events map[int64][]zomato.Event
+}
+
+// FromStore returns a snapshot of the restaurants of 's' matching 'q' with
+// their reviews and events.
+func FromStore(ctx context.Context, s *store.Store, q store.RestaurantQuery) (Snapshot, error) {
+	rs, err := s.Restaurants(ctx, q)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snap := Snapshot{Reviews: map[int64][]zomato.Review{}, Events: map[int64][]zomato.Event{}}
+	for _, r := range rs {
+		id := *r.Restaurant.ID
+		snap.Restaurants = append(snap.Restaurants, r.Restaurant)
+
+		reviews, err := s.Reviews(ctx, id)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		for _, review := range reviews {
+			snap.Reviews[id] = append(snap.Reviews[id], review.Review)
+		}
+
+		events, err := s.Events(ctx, id)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		for _, e := range events {
+			snap.Events[id] = append(snap.Events[id], e.Event)
+		}
+	}
+	return snap, nil
+}
+
+// DeliveryField names a delivery flag of a restaurant
+type DeliveryField string
+
+// Delivery fields, named as in the API
+const (
+	HasOnlineDelivery DeliveryField = "has_online_delivery"
+	IsDeliveringNow   DeliveryField = "is_delivering_now"
+)
+
+// RestaurantChange is a restaurant that appeared or disappeared
+type RestaurantChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+}
+
+// RatingChange is a move of the aggregate rating of a restaurant
+type RatingChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+	Old, New   *float64
+}
+
+// CostChange is a change of the average cost for two of a restaurant
+type CostChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+	Old, New   *int64
+}
+
+// DeliveryChange is a toggle of a delivery flag of a restaurant
+type DeliveryChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+	Field      DeliveryField
+	Old, New   bool
+}
+
+// EventChange is a new event at a restaurant
+type EventChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+	Event      zomato.Event
+}
+
+// ReviewChange is a new review of a restaurant
+type ReviewChange struct {
+	ID         int64
+	Restaurant zomato.Restaurant
+	Review     zomato.Review
+}
+
+// ChangeSet holds the changes between two snapshots, each ordered by
+// restaurant ID. Restaurants hold their new version, except removed ones.
+type ChangeSet struct {
+	Added    []RestaurantChange
+	Removed  []RestaurantChange
+	Ratings  []RatingChange
+	Costs    []CostChange
+	Delivery []DeliveryChange
+	Events   []EventChange
+	Reviews  []ReviewChange
+}
+
+// Len returns the number of changes.
+func (c ChangeSet) Len() int {
+	return len(c.Added) + len(c.Removed) + len(c.Ratings) + len(c.Costs) +
+		len(c.Delivery) + len(c.Events) + len(c.Reviews)
+}
+
+// Compare returns the changes from snapshot 'old' to 'new'. Restaurants
+// without ID are ignored.
+func Compare(old, new Snapshot) ChangeSet {
+	olds, news := index(old), index(new)
+
+	var c ChangeSet
+	for _, id := range ids(olds) {
+		if _, ok := news[id]; !ok {
+			c.Removed = append(c.Removed, RestaurantChange{ID: id, Restaurant: olds[id].restaurant})
+		}
+	}
+
+	for _, id := range ids(news) {
+		n := news[id]
+		r := n.restaurant
+		o, ok := olds[id]
+		if !ok {
+			c.Added = append(c.Added, RestaurantChange{ID: id, Restaurant: r})
+		}
+
+		if oldRating, newRating := rating(o.restaurant), rating(r); ok && !equalFloat(oldRating, newRating) {
+			c.Ratings = append(c.Ratings, RatingChange{ID: id, Restaurant: r, Old: oldRating, New: newRating})
+		}
+		if ok && !equalInt(o.restaurant.AverageCostForTwo, r.AverageCostForTwo) {
+			c.Costs = append(c.Costs, CostChange{ID: id, Restaurant: r, Old: o.restaurant.AverageCostForTwo, New: r.AverageCostForTwo})
+		}
+		for _, d := range []struct {
+			field    DeliveryField
+			old, new *bool
+		}{
+			{HasOnlineDelivery, o.restaurant.HasOnlineDelivery, r.HasOnlineDelivery},
+			{IsDeliveringNow, o.restaurant.IsDeliveringNow, r.IsDeliveringNow},
+		} {
+			// A flag missing from either side is unknown, not false
+			if ok && d.old != nil && d.new != nil && *d.old != *d.new {
+				c.Delivery = append(c.Delivery, DeliveryChange{ID: id, Restaurant: r, Field: d.field, Old: *d.old, New: *d.new})
+			}
+		}
+
+		for _, eid := range eventIDs(n.events) {
+			if _, seen := o.events[eid]; !seen {
+				c.Events = append(c.Events, EventChange{ID: id, Restaurant: r, Event: n.events[eid]})
+			}
+		}
+		for _, rid := range reviewIDs(n.reviews) {
+			if _, seen := o.reviews[rid]; !seen {
+				c.Reviews = append(c.Reviews, ReviewChange{ID: id, Restaurant: r, Review: n.reviews[rid]})
+			}
+		}
+	}
+	return c
+}
+
+// entry is a restaurant of a snapshot with its events and reviews by ID
+type entry struct {
+	restaurant zomato.Restaurant
+	events     map[int64]zomato.Event
+	reviews    map[int64]zomato.Review
+}
+
+// index returns the restaurants of 's' by ID.
+func index(s Snapshot) map[int64]entry {
+	m := make(map[int64]entry, len(s.Restaurants))
+	for _, r := range s.Restaurants {
+		if r.ID == nil {
+			continue
+		}
+		id := *r.ID
+		e := entry{restaurant: r, events: map[int64]zomato.Event{}, reviews: map[int64]zomato.Review{}}
+
+		events := append([]zomato.Event(nil), s.Events[id]...)
+		for _, ze := range r.ZomatoEvents {
+			if ze.Event != nil {
+				events = append(events, *ze.Event)
+			}
+		}
+		for _, ev := range events {
+			if ev.ID != nil {
+				e.events[*ev.ID] = ev
+			}
+		}
+
+		for _, review := range append(append([]zomato.Review(nil), r.Reviews...), s.Reviews[id]...) {
+			if review.ID != nil {
+				e.reviews[*review.ID] = review
+			}
+		}
+		m[id] = e
+	}
+	return m
+}
+
+func ids(m map[int64]entry) []int64 {
+	ids := make([]int64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func eventIDs(m map[int64]zomato.Event) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func reviewIDs(m map[int64]zomato.Review) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func rating(r zomato.Restaurant) *float64 {
+	if r.UserRating == nil {
+		return nil
+	}
+	return r.UserRating.AggregateRating
+}
+
+func equalFloat(a, b *float64) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+func equalInt(a, b *int64) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
@@ -0,0 +1,150 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/diff"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+// snapshots returns two snapshots of the testdata restaurants of Delhi NCR.
+// From the old to the new one, 18498072 opens and 18137099 closes, 310309 is
+// rated 4.5, 307327 costs 80 for two, 18537921 starts delivering, 9166 holds
+// an event and gets review 34508218.
+func snapshots(t *testing.T) (old, new diff.Snapshot) {
+	var search zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &search)
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+
+	old = diff.Snapshot{Reviews: map[int64][]zomato.Review{}}
+	new = diff.Snapshot{Reviews: map[int64][]zomato.Review{}}
+	for _, rr := range reviews.UserReviews {
+		if *rr.Review.ID != 34508218 {
+			old.Reviews[9166] = append(old.Reviews[9166], *rr.Review)
+		}
+		new.Reviews[9166] = append(new.Reviews[9166], *rr.Review)
+	}
+
+	for _, rr := range search.Restaurants {
+		r := *rr.Restaurant
+		if *r.ID != 18498072 {
+			old.Restaurants = append(old.Restaurants, r)
+		}
+
+		switch *r.ID {
+		case 18137099:
+			continue
+		case 310309:
+			rating := *r.UserRating
+			aggregate := 4.5
+			rating.AggregateRating = &aggregate
+			r.UserRating = &rating
+		case 307327:
+			cost := int64(80)
+			r.AverageCostForTwo = &cost
+		case 18537921:
+			delivering := true
+			r.IsDeliveringNow = &delivering
+		case 9166:
+			id, title := int64(7), "Kachori Festival"
+			r.ZomatoEvents = append(r.ZomatoEvents, struct {
+				Event *zomato.Event `json:"event,omitempty"`
+			}{&zomato.Event{ID: &id, Title: &title}})
+		}
+		new.Restaurants = append(new.Restaurants, r)
+	}
+	return old, new
+}
+
+func TestCompare(t *testing.T) {
+	old, new := snapshots(t)
+	c := diff.Compare(old, new)
+
+	restaurantIDs := func(cs []diff.RestaurantChange) (ids []int64) {
+		for _, c := range cs {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+	if got, want := restaurantIDs(c.Added), []int64{18498072}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Added = %v, want %v", got, want)
+	}
+	if got, want := restaurantIDs(c.Removed), []int64{18137099}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Removed = %v, want %v", got, want)
+	}
+
+	if len(c.Ratings) != 1 || c.Ratings[0].ID != 310309 || *c.Ratings[0].Old != 4.3 || *c.Ratings[0].New != 4.5 {
+		t.Errorf("Ratings = %+v, want 310309 from 4.3 to 4.5", c.Ratings)
+	}
+	if len(c.Costs) != 1 || c.Costs[0].ID != 307327 || *c.Costs[0].Old != 50 || *c.Costs[0].New != 80 {
+		t.Errorf("Costs = %+v, want 307327 from 50 to 80", c.Costs)
+	}
+	if got, want := c.Delivery, []diff.DeliveryChange{{
+		ID: 18537921, Restaurant: c.Delivery[0].Restaurant, Field: diff.IsDeliveringNow, Old: false, New: true,
+	}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Delivery = %+v, want %+v", got, want)
+	}
+	if len(c.Events) != 1 || c.Events[0].ID != 9166 || *c.Events[0].Event.ID != 7 {
+		t.Errorf("Events = %+v, want event 7 of 9166", c.Events)
+	}
+	if len(c.Reviews) != 1 || c.Reviews[0].ID != 9166 || *c.Reviews[0].Review.ID != 34508218 {
+		t.Errorf("Reviews = %+v, want review 34508218 of 9166", c.Reviews)
+	}
+	if got, want := c.Len(), 7; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+
+	if c := diff.Compare(new, new); c.Len() != 0 {
+		t.Errorf("Compare(new, new) = %+v, want no changes", c)
+	}
+}
+
+func TestCompareSparse(t *testing.T) {
+	var old, new diff.Snapshot
+	for s, data := range map[*diff.Snapshot]string{
+		&old: `{"id":"1","is_delivering_now":1}`,
+		&new: `{"id":"1","has_online_delivery":0}`,
+	} {
+		var r zomato.Restaurant
+		if err := json.Unmarshal([]byte(data), &r); err != nil {
+			t.Fatal(err)
+		}
+		s.Restaurants = append(s.Restaurants, r)
+	}
+
+	// Flags missing from either snapshot are not toggled
+	if c := diff.Compare(old, new); len(c.Delivery) != 0 {
+		t.Errorf("Delivery = %+v, want none", c.Delivery)
+	}
+	if c := diff.Compare(new, old); len(c.Delivery) != 0 {
+		t.Errorf("Delivery = %+v, want none", c.Delivery)
+	}
+}
+
+func TestCompareAdded(t *testing.T) {
+	_, new := snapshots(t)
+	c := diff.Compare(diff.Snapshot{}, new)
+
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"added", len(c.Added), len(new.Restaurants)},
+		{"removed", len(c.Removed), 0},
+		{"ratings", len(c.Ratings), 0},
+		{"costs", len(c.Costs), 0},
+		{"delivery", len(c.Delivery), 0},
+		{"events", len(c.Events), 1},
+		{"reviews", len(c.Reviews), 5},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+}
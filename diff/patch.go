@@ -0,0 +1,240 @@
+package diff
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// Operation is a JSON patch (RFC 6902) operation
+type Operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// MarshalJSON convert struct to JSON data, with the value of all but remove
+// operations, even if null.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	if o.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+	type Alias Operation
+	return json.Marshal(Alias(o))
+}
+
+// Document returns the JSON document of snapshot 's' that patches apply to:
+//
+//	{
+//	  "restaurants": {"<id>": <restaurant>},
+//	  "reviews": {"<restaurant id>": {"<review id>": <review>}},
+//	  "events": {"<restaurant id>": {"<event id>": <event>}}
+//	}
+//
+// Restaurants hold the API representation without their embedded reviews
+// and events, which are under reviews and events instead.
+func Document(s Snapshot) (map[string]interface{}, error) {
+	doc := map[string]interface{}{
+		"restaurants": map[string]interface{}{},
+		"reviews":     map[string]interface{}{},
+		"events":      map[string]interface{}{},
+	}
+	entries := index(s)
+	for _, id := range ids(entries) {
+		e := entries[id]
+		key := strconv.FormatInt(id, 10)
+
+		r, err := restaurant(e.restaurant)
+		if err != nil {
+			return nil, err
+		}
+		doc["restaurants"].(map[string]interface{})[key] = r
+
+		if len(e.reviews) > 0 {
+			reviews := map[string]interface{}{}
+			for rid, review := range e.reviews {
+				if reviews[strconv.FormatInt(rid, 10)], err = generic(review); err != nil {
+					return nil, err
+				}
+			}
+			doc["reviews"].(map[string]interface{})[key] = reviews
+		}
+		if len(e.events) > 0 {
+			events := map[string]interface{}{}
+			for eid, event := range e.events {
+				if events[strconv.FormatInt(eid, 10)], err = generic(event); err != nil {
+					return nil, err
+				}
+			}
+			doc["events"].(map[string]interface{})[key] = events
+		}
+	}
+	return doc, nil
+}
+
+// Patch returns the JSON patch applying the changes 'c' to the document of
+// snapshot 'old'.
+func Patch(old Snapshot, c ChangeSet) ([]Operation, error) {
+	doc, err := Document(old)
+	if err != nil {
+		return nil, err
+	}
+	p := &patcher{doc: doc}
+
+	for _, r := range c.Removed {
+		for _, tree := range []string{"restaurants", "reviews", "events"} {
+			p.set([]string{tree, strconv.FormatInt(r.ID, 10)}, nil, false)
+		}
+	}
+	for _, a := range c.Added {
+		v, err := restaurant(a.Restaurant)
+		if err != nil {
+			return nil, err
+		}
+		p.set([]string{"restaurants", strconv.FormatInt(a.ID, 10)}, v, true)
+	}
+
+	// field sets the field at 'path' of restaurant 'id' to its new value
+	field := func(id int64, r interface{}, path ...string) error {
+		v, err := generic(r)
+		if err != nil {
+			return err
+		}
+		value, ok := lookup(v, path)
+		p.set(append([]string{"restaurants", strconv.FormatInt(id, 10)}, path...), value, ok)
+		return nil
+	}
+	for _, r := range c.Ratings {
+		if err := field(r.ID, r.Restaurant, "user_rating", "aggregate_rating"); err != nil {
+			return nil, err
+		}
+	}
+	for _, co := range c.Costs {
+		if err := field(co.ID, co.Restaurant, "average_cost_for_two"); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range c.Delivery {
+		if err := field(d.ID, d.Restaurant, string(d.Field)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range c.Events {
+		v, err := generic(e.Event)
+		if err != nil {
+			return nil, err
+		}
+		p.set([]string{"events", strconv.FormatInt(e.ID, 10), strconv.FormatInt(*e.Event.ID, 10)}, v, true)
+	}
+	for _, r := range c.Reviews {
+		v, err := generic(r.Review)
+		if err != nil {
+			return nil, err
+		}
+		p.set([]string{"reviews", strconv.FormatInt(r.ID, 10), strconv.FormatInt(*r.Review.ID, 10)}, v, true)
+	}
+	return p.ops, nil
+}
+
+// patcher records operations while applying them to a document
+type patcher struct {
+	doc map[string]interface{}
+	ops []Operation
+}
+
+// set sets the value at 'path' to 'value', or removes it unless 'ok'.
+// Missing objects along the path are added whole.
+func (p *patcher) set(path []string, value interface{}, ok bool) {
+	node := p.doc
+	for i, seg := range path[:len(path)-1] {
+		child, exists := node[seg].(map[string]interface{})
+		if !exists {
+			if !ok {
+				return
+			}
+			op := "replace"
+			if _, exists := node[seg]; !exists {
+				op = "add"
+			}
+			v := nest(path[i+1:], value)
+			p.ops = append(p.ops, Operation{Op: op, Path: pointer(path[:i+1]), Value: v})
+			node[seg] = v
+			return
+		}
+		node = child
+	}
+
+	last := path[len(path)-1]
+	_, exists := node[last]
+	switch {
+	case !ok && exists:
+		p.ops = append(p.ops, Operation{Op: "remove", Path: pointer(path)})
+		delete(node, last)
+	case ok && exists:
+		p.ops = append(p.ops, Operation{Op: "replace", Path: pointer(path), Value: value})
+		node[last] = value
+	case ok:
+		p.ops = append(p.ops, Operation{Op: "add", Path: pointer(path), Value: value})
+		node[last] = value
+	}
+}
+
+// nest returns 'value' wrapped in objects along 'path'.
+func nest(path []string, value interface{}) interface{} {
+	for i := len(path) - 1; i >= 0; i-- {
+		value = map[string]interface{}{path[i]: value}
+	}
+	return value
+}
+
+// lookup returns the value at 'path' of 'v'.
+func lookup(v interface{}, path []string) (interface{}, bool) {
+	for _, seg := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[seg]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// pointer returns the JSON pointer (RFC 6901) of 'path'.
+func pointer(path []string) string {
+	var b strings.Builder
+	for _, seg := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(seg))
+	}
+	return b.String()
+}
+
+// restaurant returns the API representation of 'r' as generic JSON values,
+// without its embedded reviews and events.
+func restaurant(r zomato.Restaurant) (interface{}, error) {
+	v, err := generic(r)
+	if m, ok := v.(map[string]interface{}); ok {
+		delete(m, "all_reviews")
+		delete(m, "zomato_events")
+	}
+	return v, err
+}
+
+// generic returns the API representation of 'v' as generic JSON values.
+func generic(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode failed")
+	}
+	var g interface{}
+	return g, errors.Wrap(json.Unmarshal(data, &g), "decode failed")
+}
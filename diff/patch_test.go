@@ -0,0 +1,136 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/diff"
+)
+
+// apply applies the patch 'ops' to 'doc', which holds only objects.
+func apply(t *testing.T, doc map[string]interface{}, ops []diff.Operation) {
+	for _, op := range ops {
+		path := strings.Split(op.Path, "/")[1:]
+		node := doc
+		for _, seg := range path[:len(path)-1] {
+			child, ok := node[seg].(map[string]interface{})
+			if !ok {
+				t.Fatalf("%s %s: no object %q", op.Op, op.Path, seg)
+			}
+			node = child
+		}
+
+		last := strings.NewReplacer("~1", "/", "~0", "~").Replace(path[len(path)-1])
+		_, exists := node[last]
+		switch op.Op {
+		case "add":
+			node[last] = op.Value
+		case "replace", "remove":
+			if !exists {
+				t.Fatalf("%s %s: no value", op.Op, op.Path)
+			}
+			if op.Op == "remove" {
+				delete(node, last)
+			} else {
+				node[last] = op.Value
+			}
+		default:
+			t.Fatalf("unknown op %q", op.Op)
+		}
+	}
+}
+
+// checkPatch checks the patch from snapshot 'old' to 'new' has operations
+// 'want' and patches the document of 'old' into that of 'new'.
+func checkPatch(t *testing.T, old, new diff.Snapshot, want []string) {
+	ops, err := diff.Patch(old, diff.Compare(old, new))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, op := range ops {
+		paths = append(paths, op.Op+" "+op.Path)
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Patch() = %q, want %q", paths, want)
+	}
+
+	// Operations must survive encoding
+	data, err := json.Marshal(ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []diff.Operation
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := diff.Document(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply(t, got, decoded)
+	wantDoc, err := diff.Document(new)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(wantDoc)
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("patched document =\n%s\nwant\n%s", gotJSON, wantJSON)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	old, new := snapshots(t)
+	checkPatch(t, old, new, []string{
+		"remove /restaurants/18137099",
+		"add /restaurants/18498072",
+		"replace /restaurants/310309/user_rating/aggregate_rating",
+		"replace /restaurants/307327/average_cost_for_two",
+		"replace /restaurants/18537921/is_delivering_now",
+		"add /events/9166",
+		"add /reviews/9166/34508218",
+	})
+}
+
+func TestPatchRemoved(t *testing.T) {
+	id1, id2, eventID, reviewID := int64(1), int64(2), int64(7), int64(99)
+	r1 := zomato.Restaurant{ID: &id1}
+	r1.ZomatoEvents = append(r1.ZomatoEvents, struct {
+		Event *zomato.Event `json:"event,omitempty"`
+	}{&zomato.Event{ID: &eventID}})
+	old := diff.Snapshot{
+		Restaurants: []zomato.Restaurant{r1, {ID: &id2}},
+		Reviews:     map[int64][]zomato.Review{1: {{ID: &reviewID}}},
+	}
+	new := diff.Snapshot{Restaurants: []zomato.Restaurant{{ID: &id2}}}
+
+	checkPatch(t, old, new, []string{
+		"remove /restaurants/1",
+		"remove /reviews/1",
+		"remove /events/1",
+	})
+}
+
+func TestOperationMarshalJSON(t *testing.T) {
+	tests := []struct {
+		op   diff.Operation
+		want string
+	}{
+		{diff.Operation{Op: "add", Path: "/a", Value: nil}, `{"op":"add","path":"/a","value":null}`},
+		{diff.Operation{Op: "replace", Path: "/a", Value: false}, `{"op":"replace","path":"/a","value":false}`},
+		{diff.Operation{Op: "remove", Path: "/a"}, `{"op":"remove","path":"/a"}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.op)
+		if err != nil || string(data) != tt.want {
+			t.Errorf("Marshal(%+v) = %s, %v, want %s", tt.op, data, err, tt.want)
+		}
+	}
+}
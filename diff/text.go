@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-india/zomato"
+)
+
+// maxReviewText is the length review texts are cut to in text output
+const maxReviewText = 60
+
+// WriteText writes the changes to 'w' as human readable lines, marking new
+// restaurants with '+', closed ones with '-', changed ones with '~' and new
+// events and reviews with '*'.
+func (c ChangeSet) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	line := func(mark string, id int64, r zomato.Restaurant, format string, args ...interface{}) {
+		fmt.Fprintf(bw, "%s %d %s: %s\n", mark, id, name(r), fmt.Sprintf(format, args...))
+	}
+
+	for _, a := range c.Added {
+		line("+", a.ID, a.Restaurant, "new restaurant")
+	}
+	for _, r := range c.Removed {
+		line("-", r.ID, r.Restaurant, "closed")
+	}
+	for _, r := range c.Ratings {
+		line("~", r.ID, r.Restaurant, "rating %s → %s", formatFloat(r.Old), formatFloat(r.New))
+	}
+	for _, co := range c.Costs {
+		currency := ""
+		if co.Restaurant.Currency != nil {
+			currency = " " + *co.Restaurant.Currency
+		}
+		line("~", co.ID, co.Restaurant, "cost for two %s → %s%s", formatInt(co.Old), formatInt(co.New), currency)
+	}
+	for _, d := range c.Delivery {
+		line("~", d.ID, d.Restaurant, "%s %s → %s", strings.Replace(string(d.Field), "_", " ", -1), onOff(d.Old), onOff(d.New))
+	}
+	for _, e := range c.Events {
+		line("*", e.ID, e.Restaurant, "new event %q", str(e.Event.Title))
+	}
+	for _, r := range c.Reviews {
+		by := "anonymous"
+		if r.Review.User != nil && r.Review.User.Name != nil {
+			by = *r.Review.User.Name
+		}
+		text := []rune(strings.Join(strings.Fields(str(r.Review.ReviewText)), " "))
+		if len(text) > maxReviewText {
+			text = append(text[:maxReviewText-1], '…')
+		}
+		line("*", r.ID, r.Restaurant, "new review by %s (%s) %q", by, formatFloat(r.Review.Rating), string(text))
+	}
+	return bw.Flush()
+}
+
+func name(r zomato.Restaurant) string {
+	if r.Name == nil {
+		return "(unnamed)"
+	}
+	return *r.Name
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatFloat(f *float64) string {
+	if f == nil {
+		return "none"
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func formatInt(i *int64) string {
+	if i == nil {
+		return "none"
+	}
+	return strconv.FormatInt(*i, 10)
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
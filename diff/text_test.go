@@ -0,0 +1,34 @@
+package diff_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-india/zomato/diff"
+)
+
+func TestChangeSet_WriteText(t *testing.T) {
+	old, new := snapshots(t)
+
+	var buf bytes.Buffer
+	if err := diff.Compare(old, new).WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `+ 18498072 Shri Saheb Ji Dairy: new restaurant
+- 18137099 Ram Kachori: closed
+~ 310309 Fateh Ki Kachori: rating 4.3 → 4.5
+~ 307327 Sharma Kachoriwala: cost for two 50 → 80 Rs.
+~ 18537921 Samosa's Authentic Indian Food: is delivering now off → on
+* 9166 Jung Bahadur Kachori Wala: new event "Kachori Festival"
+* 9166 Jung Bahadur Kachori Wala: new review by Lustyfood (3) "Today me and my frnd went for a lunch in Kareem's.We have t…"
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteText() =\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	if err := (diff.ChangeSet{}).WriteText(&buf); err != nil || buf.Len() != 0 {
+		t.Errorf("WriteText() of no changes = %q, %v, want nothing", buf.String(), err)
+	}
+}
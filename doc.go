@@ -52,5 +52,12 @@ If you are using concrete Client, then you need to assign client.Auth field to m
   }
 
 This will add API Key to each request made by client methods.
+
+Encoding
+
+Models marshal to JSON in the shape the API sends: flags as 0 or 1, cuisines as
+a comma separated list, times in their API layouts and numbers quoted where the
+API quotes them. Numbers are written in their shortest form, so "4.0" marshals
+as "4", and keys of the API which no model field holds are not kept.
 */
 package zomato
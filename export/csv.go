@@ -0,0 +1,64 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// CSVWriter writes records as CSV with a header row of column names
+type CSVWriter struct {
+	// Null is written for null values; defaults to the empty string
+	Null string
+
+	schema Schema
+	w      *csv.Writer
+	header bool
+}
+
+// NewCSVWriter returns a CSVWriter writing records of schema 's' to 'w'.
+func NewCSVWriter(w io.Writer, s Schema) *CSVWriter {
+	return &CSVWriter{schema: s, w: csv.NewWriter(w)}
+}
+
+// Write writes record 'v'.
+func (cw *CSVWriter) Write(v interface{}) error {
+	row, err := cw.schema.Row(v)
+	if err != nil {
+		return err
+	}
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	fields := make([]string, len(row))
+	for i, value := range row {
+		fields[i] = cw.format(value)
+	}
+	return errors.Wrap(cw.w.Write(fields), "write CSV failed")
+}
+
+// Close writes the header if no records were written and flushes the writer.
+func (cw *CSVWriter) Close() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return errors.Wrap(cw.w.Error(), "write CSV failed")
+}
+
+func (cw *CSVWriter) writeHeader() error {
+	if cw.header {
+		return nil
+	}
+	cw.header = true
+	return errors.Wrap(cw.w.Write(cw.schema.Names()), "write CSV header failed")
+}
+
+func (cw *CSVWriter) format(v interface{}) string {
+	if s, ok := formatValue(v); ok {
+		return s
+	}
+	return cw.Null
+}
@@ -0,0 +1,60 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+func TestCSVWriter(t *testing.T) {
+	var r zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &r)
+
+	s, err := export.RestaurantSchema.Select("id", "name", "cuisines", "user_rating_aggregate_rating",
+		"has_table_booking", "phone_numbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		null    string
+		records []interface{}
+		want    string
+	}{
+		{"", nil, "id,name,cuisines,user_rating_aggregate_rating,has_table_booking,phone_numbers\n"},
+		{"", []interface{}{r, zomato.Restaurant{}},
+			"id,name,cuisines,user_rating_aggregate_rating,has_table_booking,phone_numbers\n" +
+				`463,Karim's,"Mughlai, North Indian, Kebab",3.7,false,` + "\n" +
+				",,,,,\n"},
+		{`\N`, []interface{}{&zomato.Restaurant{}},
+			"id,name,cuisines,user_rating_aggregate_rating,has_table_booking,phone_numbers\n" +
+				`\N,\N,\N,\N,\N,\N` + "\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := export.NewCSVWriter(&buf, s)
+		w.Null = tt.null
+		for _, v := range tt.records {
+			if err := w.Write(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+		}
+	}
+}
+
+func TestCSVWriterError(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewCSVWriter(&buf, export.DishSchema)
+	if err := w.Write(zomato.Restaurant{}); err == nil {
+		t.Error("Write of wrong record type did not fail")
+	}
+}
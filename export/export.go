@@ -0,0 +1,217 @@
+// Package export writes zomato models as flat records to CSV, NDJSON and
+// Parquet, and restaurants and locations as GeoJSON and KML map features.
+//
+// A Schema is an ordered list of columns flattening a model. RestaurantSchema,
+// ReviewSchema and DishSchema are the stable schemas of zomato.Restaurant,
+// zomato.Review and zomato.Dish; use Schema.Select to write a subset of
+// their columns.
+//
+//	w := export.NewCSVWriter(os.Stdout, export.RestaurantSchema)
+//	for _, r := range resp.Restaurants {
+//		if err := w.Write(r.Restaurant); err != nil {
+//			return err
+//		}
+//	}
+//	return w.Close()
+//
+// Nil pointers in the models are written as nulls.
+//
+// GeoJSONWriter and KMLWriter write restaurants and locations as Point
+// features whose properties are the columns of a schema, by default
+// RestaurantProperties and LocationProperties.
+package export
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Type is the type of column values
+type Type int
+
+// Column types
+const (
+	// String values are written as UTF-8 strings
+	String Type = iota
+	// Int values are written as 64-bit integers
+	Int
+	// Float values are written as 64-bit floating point numbers
+	Float
+	// Bool values are written as booleans
+	Bool
+	// Time values are written as RFC 3339 strings in UTC, or timestamps in Parquet
+	Time
+)
+
+func (t Type) String() string {
+	switch t {
+	case String:
+		return "string"
+	case Int:
+		return "int"
+	case Float:
+		return "float"
+	case Bool:
+		return "bool"
+	case Time:
+		return "time"
+	}
+	return "unknown"
+}
+
+// Column is a field of a flat record
+type Column struct {
+	Name string
+	Type Type
+	// Value returns the value of the column in record 'v'. It returns nil
+	// for null, otherwise a string, int64, float64, bool or time.Time
+	// matching the column type.
+	Value func(v interface{}) (interface{}, error)
+}
+
+// Schema is an ordered list of columns
+type Schema []Column
+
+// Names returns the column names of the schema.
+func (s Schema) Names() []string {
+	names := make([]string, len(s))
+	for i, c := range s {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// Select returns the columns named 'names' in the given order.
+func (s Schema) Select(names ...string) (Schema, error) {
+	selected := make(Schema, 0, len(names))
+	for _, name := range names {
+		c, ok := s.column(name)
+		if !ok {
+			return nil, errors.Errorf("unknown column %q", name)
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// Omit returns the schema without the columns named 'names'.
+func (s Schema) Omit(names ...string) (Schema, error) {
+	omit := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := s.column(name); !ok {
+			return nil, errors.Errorf("unknown column %q", name)
+		}
+		omit[name] = true
+	}
+
+	var kept Schema
+	for _, c := range s {
+		if !omit[c.Name] {
+			kept = append(kept, c)
+		}
+	}
+	return kept, nil
+}
+
+func (s Schema) column(name string) (Column, bool) {
+	for _, c := range s {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+// Row returns the values of the columns in record 'v'.
+func (s Schema) Row(v interface{}) ([]interface{}, error) {
+	row := make([]interface{}, len(s))
+	for i, c := range s {
+		value, err := c.Value(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "column %s", c.Name)
+		}
+		if value != nil && !typeOf(c.Type, value) {
+			return nil, errors.Errorf("column %s: %T is not a %s value", c.Name, value, c.Type)
+		}
+		row[i] = value
+	}
+	return row, nil
+}
+
+// Writer writes records of a schema
+type Writer interface {
+	// Write writes record 'v'
+	Write(v interface{}) error
+	// Close flushes buffered records; it does not close the underlying writer
+	Close() error
+}
+
+// value returns the value pointed to by 'p', or nil if 'p' is a nil pointer.
+// Integers are widened to int64.
+func value(p interface{}) interface{} {
+	switch p := p.(type) {
+	case *string:
+		if p != nil {
+			return *p
+		}
+	case *int64:
+		if p != nil {
+			return *p
+		}
+	case *uint8:
+		if p != nil {
+			return int64(*p)
+		}
+	case *float64:
+		if p != nil {
+			return *p
+		}
+	case *bool:
+		if p != nil {
+			return *p
+		}
+	case *time.Time:
+		if p != nil {
+			return *p
+		}
+	case string, int64, float64, bool, time.Time:
+		return p
+	}
+	return nil
+}
+
+// typeOf reports whether 'v' is a value of type 't'.
+func typeOf(t Type, v interface{}) bool {
+	switch v.(type) {
+	case string:
+		return t == String
+	case int64:
+		return t == Int
+	case float64:
+		return t == Float
+	case bool:
+		return t == Bool
+	case time.Time:
+		return t == Time
+	}
+	return false
+}
+
+// formatValue returns the text of column value 'v'; false if 'v' is null.
+func formatValue(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case time.Time:
+		return v.UTC().Format(time.RFC3339), true
+	}
+	return "", false
+}
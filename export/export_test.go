@@ -0,0 +1,161 @@
+package export_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+var (
+	_ export.Writer = (*export.CSVWriter)(nil)
+	_ export.Writer = (*export.NDJSONWriter)(nil)
+	_ export.Writer = (*export.ParquetWriter)(nil)
+)
+
+func TestRestaurantSchema(t *testing.T) {
+	var r zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &r)
+
+	s, err := export.RestaurantSchema.Select("id", "name", "location_latitude", "location_zipcode",
+		"location_postal_code", "cuisines", "price_range", "user_rating_aggregate_rating", "user_rating_votes",
+		"has_online_delivery", "phone_numbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []interface{}{
+		int64(463), "Karim's", 28.6494744083, "0", nil, "Mughlai, North Indian, Kebab", int64(2),
+		3.7, int64(5316), false, nil,
+	}
+
+	for _, v := range []interface{}{r, &r} {
+		row, err := s.Row(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(row, want) {
+			t.Errorf("Row(%T) = %#v, want %#v", v, row, want)
+		}
+	}
+}
+
+func TestSchemaNulls(t *testing.T) {
+	tests := []struct {
+		schema export.Schema
+		record interface{}
+	}{
+		{export.RestaurantSchema, zomato.Restaurant{}},
+		{export.RestaurantSchema, (*zomato.Restaurant)(nil)},
+		{export.ReviewSchema, zomato.Review{}},
+		{export.ReviewSchema, export.RestaurantReview{}},
+		{export.DishSchema, zomato.Dish{}},
+		{export.DishSchema, &export.MenuDish{}},
+	}
+	for _, tt := range tests {
+		row, err := tt.schema.Row(tt.record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, v := range row {
+			if v != nil {
+				t.Errorf("%T: column %s = %#v, want nil", tt.record, tt.schema[i].Name, v)
+			}
+		}
+	}
+}
+
+func TestSchemaTypes(t *testing.T) {
+	var search zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &search)
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	var menu zomato.DailyMenuResp
+	testfixture.Decode(t, "DailyMenu.json", &menu)
+
+	var records []interface{}
+	for _, r := range search.Restaurants {
+		records = append(records, r.Restaurant)
+	}
+	for _, r := range export.Reviews(463, reviews) {
+		records = append(records, r)
+	}
+	for _, d := range export.Dishes(16514301, menu) {
+		records = append(records, d)
+	}
+
+	schemas := map[string]export.Schema{
+		"*zomato.Restaurant":      export.RestaurantSchema,
+		"export.RestaurantReview": export.ReviewSchema,
+		"export.MenuDish":         export.DishSchema,
+	}
+	for _, v := range records {
+		s := schemas[reflect.TypeOf(v).String()]
+		if _, err := s.Row(v); err != nil {
+			t.Errorf("Row(%T) failed: %v", v, err)
+		}
+	}
+}
+
+func TestReviewsAndDishes(t *testing.T) {
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	rs := export.Reviews(463, reviews)
+	if len(rs) != 5 {
+		t.Fatalf("got %d reviews, want 5", len(rs))
+	}
+	row, err := export.ReviewSchema.Row(rs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row[0] != int64(463) {
+		t.Errorf("restaurant_id = %v, want 463", row[0])
+	}
+	if _, ok := row[7].(time.Time); !ok {
+		t.Errorf("timestamp = %#v, want time.Time", row[7])
+	}
+
+	var menu zomato.DailyMenuResp
+	testfixture.Decode(t, "DailyMenu.json", &menu)
+	ds := export.Dishes(16514301, menu)
+	if len(ds) == 0 {
+		t.Fatal("got no dishes")
+	}
+	row, err = export.DishSchema.Row(ds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row[0] != int64(16514301) || row[1] != int64(19610530) {
+		t.Errorf("restaurant_id, daily_menu_id = %v, %v", row[0], row[1])
+	}
+}
+
+func TestSchemaErrors(t *testing.T) {
+	if _, err := export.RestaurantSchema.Select("id", "nope"); err == nil {
+		t.Error("Select unknown column did not fail")
+	}
+	if _, err := export.RestaurantSchema.Omit("nope"); err == nil {
+		t.Error("Omit unknown column did not fail")
+	}
+	if _, err := export.RestaurantSchema.Row(zomato.Review{}); err == nil {
+		t.Error("Row of wrong record type did not fail")
+	}
+
+	bad := export.Schema{{Name: "x", Type: export.Int, Value: func(interface{}) (interface{}, error) {
+		return "x", nil
+	}}}
+	if _, err := bad.Row(nil); err == nil {
+		t.Error("Row of mistyped value did not fail")
+	}
+
+	s, err := export.DishSchema.Omit("restaurant_id", "daily_menu_start_date", "daily_menu_end_date")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"daily_menu_id", "daily_menu_name", "dish_id", "name", "price"}
+	if got := s.Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Omit = %v, want %v", got, want)
+	}
+}
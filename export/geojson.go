@@ -0,0 +1,135 @@
+package export
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// RestaurantProperties are the default feature properties of restaurants
+var RestaurantProperties = mustSelect(RestaurantSchema,
+	"id", "name", "user_rating_aggregate_rating", "average_cost_for_two", "currency", "cuisines", "deeplink")
+
+// LocationProperties are the default feature properties of locations
+var LocationProperties = mustSelect(LocationSchema,
+	"entity_type", "entity_id", "title", "city_name", "country_name")
+
+// GeoJSONWriter writes zomato.Restaurant and zomato.Location records as Point
+// features of a GeoJSON FeatureCollection.
+//
+// The properties of a feature are the columns of its schema. Records without
+// coordinates are skipped.
+type GeoJSONWriter struct {
+	// Skipped is the number of records skipped for missing coordinates
+	Skipped int
+
+	properties Schema
+	w          *bufio.Writer
+	keys       [][]byte
+	features   int
+	line       []byte
+}
+
+// NewGeoJSONWriter returns a GeoJSONWriter writing features with properties
+// 'properties' to 'w'.
+func NewGeoJSONWriter(w io.Writer, properties Schema) *GeoJSONWriter {
+	return &GeoJSONWriter{properties: properties, w: bufio.NewWriter(w), keys: jsonKeys(properties)}
+}
+
+// Write writes record 'v' as a feature.
+func (gw *GeoJSONWriter) Write(v interface{}) error {
+	lat, lon, ok, err := coordinates(v)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		gw.Skipped++
+		return nil
+	}
+	row, err := gw.properties.Row(v)
+	if err != nil {
+		return err
+	}
+
+	line := gw.line[:0]
+	if gw.features == 0 {
+		line = append(line, `{"type":"FeatureCollection","features":[`+"\n"...)
+	} else {
+		line = append(line, ",\n"...)
+	}
+	line = append(line, `{"type":"Feature","geometry":{"type":"Point","coordinates":[`...)
+	line = append(line, lonLat(lat, lon)...)
+	line = append(line, `]},"properties":`...)
+	line, err = appendObject(line, gw.keys, gw.properties, row)
+	if err != nil {
+		return err
+	}
+	line = append(line, '}')
+	gw.line = line
+
+	if _, err := gw.w.Write(line); err != nil {
+		return errors.Wrap(err, "write GeoJSON failed")
+	}
+	gw.features++
+	return nil
+}
+
+// Close ends the FeatureCollection and flushes the writer.
+func (gw *GeoJSONWriter) Close() error {
+	if gw.features == 0 {
+		gw.w.WriteString(`{"type":"FeatureCollection","features":[`)
+	} else {
+		gw.w.WriteString("\n")
+	}
+	gw.w.WriteString("]}\n")
+	return errors.Wrap(gw.w.Flush(), "write GeoJSON failed")
+}
+
+// coordinates returns the coordinates of a restaurant or location record
+// 'v'; false if it has none. Zomato reports unknown coordinates as 0, 0.
+func coordinates(v interface{}) (lat, lon float64, ok bool, err error) {
+	var plat, plon *float64
+	switch v := v.(type) {
+	case zomato.Restaurant:
+		if v.Location != nil {
+			plat, plon = v.Location.Latitude, v.Location.Longitude
+		}
+	case *zomato.Restaurant:
+		if v != nil && v.Location != nil {
+			plat, plon = v.Location.Latitude, v.Location.Longitude
+		}
+	case zomato.Location:
+		plat, plon = v.Latitude, v.Longitude
+	case *zomato.Location:
+		if v != nil {
+			plat, plon = v.Latitude, v.Longitude
+		}
+	default:
+		return 0, 0, false, errors.Errorf("record %T is not a zomato.Restaurant or zomato.Location", v)
+	}
+
+	if plat == nil || plon == nil || (*plat == 0 && *plon == 0) {
+		return 0, 0, false, nil
+	}
+	return *plat, *plon, true, nil
+}
+
+// lonLat returns coordinates as "longitude,latitude", the order used by both
+// GeoJSON and KML.
+func lonLat(lat, lon float64) string {
+	slat, _ := formatValue(lat)
+	slon, _ := formatValue(lon)
+	return slon + "," + slat
+}
+
+// mustSelect returns the columns 'names' of schema 's', panicking if a column
+// does not exist.
+func mustSelect(s Schema, names ...string) Schema {
+	selected, err := s.Select(names...)
+	if err != nil {
+		panic(err)
+	}
+	return selected
+}
@@ -0,0 +1,115 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+// featureCollection is a decoded GeoJSON FeatureCollection
+type featureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Type     string `json:"type"`
+		Geometry struct {
+			Type        string    `json:"type"`
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+func TestGeoJSONWriter(t *testing.T) {
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+
+	var buf bytes.Buffer
+	w := export.NewGeoJSONWriter(&buf, export.RestaurantProperties)
+	for _, r := range geocode.NearbyRestaurants {
+		if err := w.Write(r.Restaurant); err != nil {
+			t.Fatal(err)
+		}
+	}
+	zero := 0.0
+	for _, r := range []zomato.Restaurant{{}, {Location: &zomato.RestaurantLocation{Latitude: &zero, Longitude: &zero}}} {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if w.Skipped != 2 {
+		t.Errorf("skipped %d records, want 2", w.Skipped)
+	}
+
+	var fc featureCollection
+	if err := json.Unmarshal(buf.Bytes(), &fc); err != nil {
+		t.Fatalf("invalid GeoJSON: %v\n%s", err, buf.String())
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != len(geocode.NearbyRestaurants) {
+		t.Fatalf("got %s of %d features, want %d", fc.Type, len(fc.Features), len(geocode.NearbyRestaurants))
+	}
+
+	f := fc.Features[0]
+	want := []float64{77.1134994552, 28.7242869449}
+	if f.Type != "Feature" || f.Geometry.Type != "Point" || !reflect.DeepEqual(f.Geometry.Coordinates, want) {
+		t.Errorf("feature %s %s %v, want Feature Point %v", f.Type, f.Geometry.Type, f.Geometry.Coordinates, want)
+	}
+	var keys []string
+	for k := range f.Properties {
+		keys = append(keys, k)
+	}
+	if len(keys) != len(export.RestaurantProperties) || f.Properties["name"] != "Berco's" {
+		t.Errorf("properties = %v", f.Properties)
+	}
+}
+
+func TestGeoJSONWriterLocation(t *testing.T) {
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+
+	s, err := export.LocationProperties.Select("title", "entity_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := export.NewGeoJSONWriter(&buf, s)
+	if err := w.Write(geocode.Location); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"type":"FeatureCollection","features":[` + "\n" +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[77.110278,28.722629]},"properties":{"title":"Rohini","entity_id":289}}` + "\n" +
+		"]}\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGeoJSONWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewGeoJSONWriter(&buf, export.LocationProperties)
+	if err := w.Write(zomato.Review{}); err == nil {
+		t.Error("Write of wrong record type did not fail")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var fc featureCollection
+	if err := json.Unmarshal(buf.Bytes(), &fc); err != nil {
+		t.Fatalf("invalid GeoJSON: %v\n%s", err, buf.String())
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 0 {
+		t.Errorf("got %s of %d features", fc.Type, len(fc.Features))
+	}
+}
@@ -0,0 +1,140 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// kmlNamespace is the KML 2.2 XML namespace
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// KMLWriter writes zomato.Restaurant and zomato.Location records as
+// placemarks of a KML document.
+//
+// A placemark is named after the restaurant or location and holds the
+// columns of its schema as extended data. Records without coordinates are
+// skipped.
+type KMLWriter struct {
+	// Name is the name of the document
+	Name string
+	// Skipped is the number of records skipped for missing coordinates
+	Skipped int
+
+	properties Schema
+	w          io.Writer
+	enc        *xml.Encoder
+	started    bool
+}
+
+// kmlPlacemark is a KML Placemark element
+type kmlPlacemark struct {
+	XMLName     xml.Name  `xml:"Placemark"`
+	Name        string    `xml:"name,omitempty"`
+	Data        []kmlData `xml:"ExtendedData>Data,omitempty"`
+	Coordinates string    `xml:"Point>coordinates"`
+}
+
+// kmlData is a KML Data element
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// NewKMLWriter returns a KMLWriter writing placemarks with extended data
+// 'properties' to 'w'.
+func NewKMLWriter(w io.Writer, properties Schema) *KMLWriter {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return &KMLWriter{properties: properties, w: w, enc: enc}
+}
+
+// Write writes record 'v' as a placemark.
+func (kw *KMLWriter) Write(v interface{}) error {
+	lat, lon, ok, err := coordinates(v)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		kw.Skipped++
+		return nil
+	}
+	row, err := kw.properties.Row(v)
+	if err != nil {
+		return err
+	}
+	if err := kw.start(); err != nil {
+		return err
+	}
+
+	p := kmlPlacemark{Name: placemarkName(v)}
+	for i, value := range row {
+		if s, ok := formatValue(value); ok {
+			p.Data = append(p.Data, kmlData{Name: kw.properties[i].Name, Value: s})
+		}
+	}
+	p.Coordinates = lonLat(lat, lon)
+	return errors.Wrap(kw.enc.Encode(p), "write KML failed")
+}
+
+// Close ends the document and flushes the writer.
+func (kw *KMLWriter) Close() error {
+	if err := kw.start(); err != nil {
+		return err
+	}
+	err := kw.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "Document"}})
+	if err == nil {
+		err = kw.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "kml"}})
+	}
+	if err == nil {
+		err = kw.enc.Flush()
+	}
+	if err == nil {
+		_, err = io.WriteString(kw.w, "\n")
+	}
+	return errors.Wrap(err, "write KML failed")
+}
+
+// start writes the XML header and opens the document.
+func (kw *KMLWriter) start() error {
+	if kw.started {
+		return nil
+	}
+	kw.started = true
+
+	if _, err := io.WriteString(kw.w, xml.Header); err != nil {
+		return errors.Wrap(err, "write KML failed")
+	}
+	err := kw.enc.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "kml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: kmlNamespace}},
+	})
+	if err == nil {
+		err = kw.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: "Document"}})
+	}
+	if err == nil && kw.Name != "" {
+		err = kw.enc.EncodeElement(kw.Name, xml.StartElement{Name: xml.Name{Local: "name"}})
+	}
+	return errors.Wrap(err, "write KML failed")
+}
+
+// placemarkName returns the name of restaurant or location record 'v'.
+func placemarkName(v interface{}) string {
+	var name *string
+	switch v := v.(type) {
+	case zomato.Restaurant:
+		name = v.Name
+	case *zomato.Restaurant:
+		name = v.Name
+	case zomato.Location:
+		name = v.Title
+	case *zomato.Location:
+		name = v.Title
+	}
+	if name == nil {
+		return ""
+	}
+	return *name
+}
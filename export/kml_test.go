@@ -0,0 +1,105 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+func TestKMLWriter(t *testing.T) {
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+
+	var buf bytes.Buffer
+	w := export.NewKMLWriter(&buf, export.RestaurantProperties)
+	w.Name = "Nearby"
+	for _, r := range geocode.NearbyRestaurants {
+		if err := w.Write(*r.Restaurant); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Write(zomato.Restaurant{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if w.Skipped != 1 {
+		t.Errorf("skipped %d records, want 1", w.Skipped)
+	}
+
+	var doc struct {
+		XMLName    xml.Name
+		Name       string `xml:"Document>name"`
+		Placemarks []struct {
+			Name        string `xml:"name"`
+			Coordinates string `xml:"Point>coordinates"`
+			Data        []struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value"`
+			} `xml:"ExtendedData>Data"`
+		} `xml:"Document>Placemark"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid KML: %v\n%s", err, buf.String())
+	}
+	if doc.XMLName.Space != "http://www.opengis.net/kml/2.2" || doc.XMLName.Local != "kml" || doc.Name != "Nearby" {
+		t.Errorf("document %v named %q", doc.XMLName, doc.Name)
+	}
+	if len(doc.Placemarks) != len(geocode.NearbyRestaurants) {
+		t.Fatalf("got %d placemarks, want %d", len(doc.Placemarks), len(geocode.NearbyRestaurants))
+	}
+	p := doc.Placemarks[0]
+	if p.Name != "Berco's" || p.Coordinates != "77.1134994552,28.7242869449" {
+		t.Errorf("placemark %q at %q", p.Name, p.Coordinates)
+	}
+	if len(p.Data) == 0 || p.Data[0].Name != "id" {
+		t.Errorf("extended data = %+v", p.Data)
+	}
+}
+
+func TestKMLWriterLocation(t *testing.T) {
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+
+	s, err := export.LocationProperties.Select("entity_type", "entity_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := export.NewKMLWriter(&buf, s)
+	if err := w.Write(geocode.Location); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <Placemark>
+      <name>Rohini</name>
+      <ExtendedData>
+        <Data name="entity_type">
+          <value>subzone</value>
+        </Data>
+        <Data name="entity_id">
+          <value>289</value>
+        </Data>
+      </ExtendedData>
+      <Point>
+        <coordinates>77.110278,28.722629</coordinates>
+      </Point>
+    </Placemark>
+  </Document>
+</kml>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NDJSONWriter writes records as newline delimited JSON objects with keys in
+// column order
+type NDJSONWriter struct {
+	schema Schema
+	w      *bufio.Writer
+	keys   [][]byte
+	line   []byte
+}
+
+// NewNDJSONWriter returns a NDJSONWriter writing records of schema 's' to 'w'.
+func NewNDJSONWriter(w io.Writer, s Schema) *NDJSONWriter {
+	return &NDJSONWriter{schema: s, w: bufio.NewWriter(w), keys: jsonKeys(s)}
+}
+
+// Write writes record 'v'.
+func (nw *NDJSONWriter) Write(v interface{}) error {
+	row, err := nw.schema.Row(v)
+	if err != nil {
+		return err
+	}
+
+	line, err := appendObject(nw.line[:0], nw.keys, nw.schema, row)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	nw.line = line
+
+	_, err = nw.w.Write(line)
+	return errors.Wrap(err, "write NDJSON failed")
+}
+
+// Close flushes the writer.
+func (nw *NDJSONWriter) Close() error {
+	return errors.Wrap(nw.w.Flush(), "write NDJSON failed")
+}
+
+// jsonKeys returns the JSON encoded column names of schema 's'.
+func jsonKeys(s Schema) [][]byte {
+	keys := make([][]byte, len(s))
+	for i, c := range s {
+		keys[i], _ = json.Marshal(c.Name)
+	}
+	return keys
+}
+
+// appendObject appends 'row' of schema 's' to 'b' as a JSON object with
+// JSON encoded keys 'keys'.
+func appendObject(b []byte, keys [][]byte, s Schema, row []interface{}) ([]byte, error) {
+	b = append(b, '{')
+	for i, value := range row {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, keys[i]...)
+		b = append(b, ':')
+
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC().Format(time.RFC3339)
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encode column %s failed", s[i].Name)
+		}
+		b = append(b, data...)
+	}
+	return append(b, '}'), nil
+}
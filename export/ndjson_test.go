@@ -0,0 +1,38 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+func TestNDJSONWriter(t *testing.T) {
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	rs := export.Reviews(463, reviews)
+
+	s, err := export.ReviewSchema.Select("restaurant_id", "id", "rating", "timestamp", "user_name", "likes")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	w := export.NewNDJSONWriter(&buf, s)
+	for _, v := range []interface{}{rs[0], zomato.Review{}} {
+		if err := w.Write(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"restaurant_id":463,"id":34508218,"rating":3,"timestamp":"2018-04-08T17:11:18Z","user_name":"Lustyfood","likes":1}` + "\n" +
+		`{"restaurant_id":null,"id":null,"rating":null,"timestamp":null,"user_name":null,"likes":null}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
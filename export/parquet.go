@@ -0,0 +1,305 @@
+package export
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultRowGroupSize is the default number of rows in a Parquet row group
+const DefaultRowGroupSize = 10000
+
+// parquetMagic starts and ends Parquet files
+const parquetMagic = "PAR1"
+
+// Parquet physical types
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+)
+
+// Parquet converted types
+const (
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+)
+
+// Parquet encodings
+const (
+	parquetPlain = 0
+	parquetRLE   = 3
+)
+
+// ParquetWriter writes records as a Parquet file.
+//
+// Every column is optional and written uncompressed with plain encoding, one
+// data page per column chunk. Rows are buffered in memory until RowGroupSize
+// rows are written or the writer is closed.
+type ParquetWriter struct {
+	// RowGroupSize is the number of rows in a row group; defaults to
+	// DefaultRowGroupSize
+	RowGroupSize int
+
+	schema  Schema
+	w       io.Writer
+	offset  int64
+	columns []parquetColumn
+	rows    int
+	groups  []parquetRowGroup
+	total   int64
+	thrift  thriftWriter
+	closed  bool
+}
+
+// parquetColumn buffers values of a column in the current row group
+type parquetColumn struct {
+	// defined holds whether each row has a value
+	defined []bool
+	// values holds plain encoded values; bools are packed on flush
+	values []byte
+	bools  []bool
+}
+
+// parquetRowGroup holds metadata of a written row group
+type parquetRowGroup struct {
+	rows   int64
+	size   int64
+	chunks []parquetChunk
+}
+
+// parquetChunk holds metadata of a written column chunk
+type parquetChunk struct {
+	offset int64
+	size   int64
+}
+
+// NewParquetWriter returns a ParquetWriter writing records of schema 's' to 'w'.
+func NewParquetWriter(w io.Writer, s Schema) *ParquetWriter {
+	return &ParquetWriter{schema: s, w: w, columns: make([]parquetColumn, len(s))}
+}
+
+// Write writes record 'v'.
+func (pw *ParquetWriter) Write(v interface{}) error {
+	if pw.closed {
+		return errors.New("write to closed ParquetWriter")
+	}
+	row, err := pw.schema.Row(v)
+	if err != nil {
+		return err
+	}
+	for i, value := range row {
+		c := &pw.columns[i]
+		c.defined = append(c.defined, value != nil)
+		switch value := value.(type) {
+		case string:
+			c.values = binary.LittleEndian.AppendUint32(c.values, uint32(len(value)))
+			c.values = append(c.values, value...)
+		case int64:
+			c.values = binary.LittleEndian.AppendUint64(c.values, uint64(value))
+		case float64:
+			c.values = binary.LittleEndian.AppendUint64(c.values, math.Float64bits(value))
+		case bool:
+			c.bools = append(c.bools, value)
+		case time.Time:
+			c.values = binary.LittleEndian.AppendUint64(c.values, uint64(value.UnixMilli()))
+		}
+	}
+	pw.rows++
+
+	size := pw.RowGroupSize
+	if size <= 0 {
+		size = DefaultRowGroupSize
+	}
+	if pw.rows >= size {
+		return pw.flush()
+	}
+	return nil
+}
+
+// Close writes buffered rows and the file footer.
+func (pw *ParquetWriter) Close() error {
+	if pw.closed {
+		return nil
+	}
+	pw.closed = true
+
+	if err := pw.flush(); err != nil {
+		return err
+	}
+	if err := pw.start(); err != nil {
+		return err
+	}
+
+	t := &pw.thrift
+	t.reset()
+	pw.fileMetaData()
+	footer := binary.LittleEndian.AppendUint32(t.buf, uint32(len(t.buf)))
+	footer = append(footer, parquetMagic...)
+	return pw.write(footer)
+}
+
+// start writes the leading magic number.
+func (pw *ParquetWriter) start() error {
+	if pw.offset > 0 {
+		return nil
+	}
+	return pw.write([]byte(parquetMagic))
+}
+
+func (pw *ParquetWriter) write(b []byte) error {
+	n, err := pw.w.Write(b)
+	pw.offset += int64(n)
+	return errors.Wrap(err, "write Parquet failed")
+}
+
+// flush writes buffered rows as a row group.
+func (pw *ParquetWriter) flush() error {
+	if pw.rows == 0 {
+		return nil
+	}
+	if err := pw.start(); err != nil {
+		return err
+	}
+
+	group := parquetRowGroup{rows: int64(pw.rows)}
+	for i := range pw.columns {
+		c := &pw.columns[i]
+		page := definitionLevels(c.defined)
+		page = append(page, c.values...)
+		page = append(page, packBools(c.bools)...)
+
+		t := &pw.thrift
+		t.reset()
+		t.structBegin()
+		t.i32Field(1, 0) // DATA_PAGE
+		t.i32Field(2, int32(len(page)))
+		t.i32Field(3, int32(len(page)))
+		t.structField(5, func() {
+			t.i32Field(1, int32(pw.rows))
+			t.i32Field(2, parquetPlain)
+			t.i32Field(3, parquetRLE)
+			t.i32Field(4, parquetRLE)
+		})
+		t.structEnd()
+
+		chunk := parquetChunk{offset: pw.offset, size: int64(len(t.buf) + len(page))}
+		if err := pw.write(t.buf); err != nil {
+			return err
+		}
+		if err := pw.write(page); err != nil {
+			return err
+		}
+		group.chunks = append(group.chunks, chunk)
+		group.size += chunk.size
+
+		*c = parquetColumn{defined: c.defined[:0], values: c.values[:0], bools: c.bools[:0]}
+	}
+
+	pw.groups = append(pw.groups, group)
+	pw.total += group.rows
+	pw.rows = 0
+	return nil
+}
+
+// fileMetaData encodes the file footer.
+func (pw *ParquetWriter) fileMetaData() {
+	t := &pw.thrift
+	t.structBegin()
+	t.i32Field(1, 1)
+	t.structListField(2, len(pw.schema)+1, func(i int) {
+		if i == 0 {
+			t.stringField(4, "schema")
+			t.i32Field(5, int32(len(pw.schema)))
+			return
+		}
+		c := pw.schema[i-1]
+		physical, converted := parquetType(c.Type)
+		t.i32Field(1, physical)
+		t.i32Field(3, 1) // OPTIONAL
+		t.stringField(4, c.Name)
+		if converted >= 0 {
+			t.i32Field(6, converted)
+		}
+	})
+	t.i64Field(3, pw.total)
+	t.structListField(4, len(pw.groups), func(i int) {
+		g := pw.groups[i]
+		t.structListField(1, len(g.chunks), func(j int) {
+			chunk := g.chunks[j]
+			physical, _ := parquetType(pw.schema[j].Type)
+			t.i64Field(2, chunk.offset)
+			t.structField(3, func() {
+				t.i32Field(1, physical)
+				t.field(2, thriftList)
+				t.listBegin(thriftI32, 2)
+				t.zigzag(parquetPlain)
+				t.zigzag(parquetRLE)
+				t.field(3, thriftList)
+				t.listBegin(thriftBinary, 1)
+				t.binary([]byte(pw.schema[j].Name))
+				t.i32Field(4, 0) // UNCOMPRESSED
+				t.i64Field(5, g.rows)
+				t.i64Field(6, chunk.size)
+				t.i64Field(7, chunk.size)
+				t.i64Field(9, chunk.offset)
+			})
+		})
+		t.i64Field(2, g.size)
+		t.i64Field(3, g.rows)
+	})
+	t.stringField(6, "github.com/go-india/zomato/export")
+	t.structEnd()
+}
+
+// parquetType returns the physical and converted type of columns of type
+// 't'; the converted type is -1 if there is none.
+func parquetType(t Type) (int32, int32) {
+	switch t {
+	case Int:
+		return parquetInt64, -1
+	case Float:
+		return parquetDouble, -1
+	case Bool:
+		return parquetBoolean, -1
+	case Time:
+		return parquetInt64, parquetTimestampMillis
+	}
+	return parquetByteArray, parquetUTF8
+}
+
+// definitionLevels encodes 'defined' as length prefixed RLE runs of 1 bit
+// definition levels.
+func definitionLevels(defined []bool) []byte {
+	b := make([]byte, 4)
+	for i := 0; i < len(defined); {
+		j := i + 1
+		for j < len(defined) && defined[j] == defined[i] {
+			j++
+		}
+		b = binary.AppendUvarint(b, uint64(j-i)<<1)
+		if defined[i] {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+		i = j
+	}
+	binary.LittleEndian.PutUint32(b, uint32(len(b)-4))
+	return b
+}
+
+// packBools plain encodes booleans as bits, least significant first.
+func packBools(bools []bool) []byte {
+	b := make([]byte, (len(bools)+7)/8)
+	for i, v := range bools {
+		if v {
+			b[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return b
+}
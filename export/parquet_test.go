@@ -0,0 +1,298 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/export"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+func TestParquetWriter(t *testing.T) {
+	var search zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &search)
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	var menu zomato.DailyMenuResp
+	testfixture.Decode(t, "DailyMenu.json", &menu)
+
+	var restaurants, reviewRecords, dishes []interface{}
+	for _, r := range search.Restaurants {
+		restaurants = append(restaurants, r.Restaurant)
+	}
+	restaurants = append(restaurants, zomato.Restaurant{})
+	for _, r := range export.Reviews(463, reviews) {
+		reviewRecords = append(reviewRecords, r)
+	}
+	reviewRecords = append(reviewRecords, zomato.Review{})
+	for _, d := range export.Dishes(16514301, menu) {
+		dishes = append(dishes, d)
+	}
+
+	tests := []struct {
+		schema       export.Schema
+		records      []interface{}
+		rowGroupSize int
+	}{
+		{export.RestaurantSchema, restaurants, 0},
+		{export.RestaurantSchema, restaurants, 7},
+		{export.ReviewSchema, reviewRecords, 2},
+		{export.DishSchema, dishes, 0},
+		{export.DishSchema, nil, 0},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := export.NewParquetWriter(&buf, tt.schema)
+		w.RowGroupSize = tt.rowGroupSize
+		var want [][]interface{}
+		for _, v := range tt.records {
+			if err := w.Write(v); err != nil {
+				t.Fatal(err)
+			}
+			row, err := tt.schema.Row(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, v := range row {
+				if tm, ok := v.(time.Time); ok {
+					row[i] = tm.UTC()
+				}
+			}
+			want = append(want, row)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		names, groups, got, err := readParquet(buf.Bytes())
+		if err != nil {
+			t.Fatalf("read Parquet failed: %v", err)
+		}
+		if !reflect.DeepEqual(names, tt.schema.Names()) {
+			t.Errorf("columns = %v, want %v", names, tt.schema.Names())
+		}
+		wantGroups := 0
+		if size := tt.rowGroupSize; len(tt.records) > 0 {
+			if size == 0 {
+				size = export.DefaultRowGroupSize
+			}
+			wantGroups = (len(tt.records) + size - 1) / size
+		}
+		if groups != wantGroups {
+			t.Errorf("got %d row groups, want %d", groups, wantGroups)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("rows differ:\ngot  %v\nwant %v", got, want)
+		}
+	}
+}
+
+// readParquet returns the column names, number of row groups and rows of the
+// Parquet file 'data' written by ParquetWriter.
+func readParquet(data []byte) ([]string, int, [][]interface{}, error) {
+	if len(data) < 12 || string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		return nil, 0, nil, fmt.Errorf("missing magic")
+	}
+	n := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	r := &thriftReader{b: data[len(data)-8-n : len(data)-8]}
+	meta := r.readStruct()
+	if r.err != nil {
+		return nil, 0, nil, r.err
+	}
+
+	var names []string
+	var types, converted []int64
+	for _, e := range meta[2].([]interface{})[1:] {
+		se := e.(thriftStruct)
+		names = append(names, string(se[4].([]byte)))
+		types = append(types, se[1].(int64))
+		c, ok := se[6].(int64)
+		if !ok {
+			c = -1
+		}
+		converted = append(converted, c)
+	}
+
+	var rows [][]interface{}
+	groups := meta[4].([]interface{})
+	for _, g := range groups {
+		rg := g.(thriftStruct)
+		numRows := int(rg[3].(int64))
+		group := make([][]interface{}, numRows)
+		for i := range group {
+			group[i] = make([]interface{}, len(names))
+		}
+
+		for col, c := range rg[1].([]interface{}) {
+			md := c.(thriftStruct)[3].(thriftStruct)
+			offset := md[9].(int64)
+			r := &thriftReader{b: data[offset:]}
+			header := r.readStruct()
+			if r.err != nil {
+				return nil, 0, nil, r.err
+			}
+			size := int(header[3].(int64))
+			page := r.b[r.pos : r.pos+size]
+
+			n := int(binary.LittleEndian.Uint32(page))
+			defined, err := decodeLevels(page[4:4+n], numRows)
+			if err != nil {
+				return nil, 0, nil, err
+			}
+			values := page[4+n:]
+
+			bit := 0
+			for i, d := range defined {
+				if !d {
+					continue
+				}
+				var v interface{}
+				switch types[col] {
+				case 0:
+					v = values[bit/8]&(1<<uint(bit%8)) != 0
+					bit++
+				case 2:
+					i64 := int64(binary.LittleEndian.Uint64(values))
+					values = values[8:]
+					v = i64
+					if converted[col] == 9 {
+						v = time.UnixMilli(i64).UTC()
+					}
+				case 5:
+					v = math.Float64frombits(binary.LittleEndian.Uint64(values))
+					values = values[8:]
+				case 6:
+					l := int(binary.LittleEndian.Uint32(values))
+					v = string(values[4 : 4+l])
+					values = values[4+l:]
+				}
+				group[i][col] = v
+			}
+		}
+		rows = append(rows, group...)
+	}
+
+	if total := meta[3].(int64); int(total) != len(rows) {
+		return nil, 0, nil, fmt.Errorf("num_rows = %d, read %d rows", total, len(rows))
+	}
+	return names, len(groups), rows, nil
+}
+
+// decodeLevels decodes 'n' RLE encoded 1 bit definition levels.
+func decodeLevels(b []byte, n int) ([]bool, error) {
+	var levels []bool
+	for len(b) > 0 {
+		header, k := binary.Uvarint(b)
+		b = b[k:]
+		if header&1 == 1 {
+			return nil, fmt.Errorf("unexpected bit-packed run")
+		}
+		for i := uint64(0); i < header>>1; i++ {
+			levels = append(levels, b[0] == 1)
+		}
+		b = b[1:]
+	}
+	if len(levels) != n {
+		return nil, fmt.Errorf("got %d definition levels, want %d", len(levels), n)
+	}
+	return levels, nil
+}
+
+// thriftStruct holds decoded struct fields by ID
+type thriftStruct map[int16]interface{}
+
+// thriftReader decodes the Thrift compact protocol
+type thriftReader struct {
+	b   []byte
+	pos int
+	err error
+}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.b) {
+		r.err = fmt.Errorf("unexpected end of data")
+		return 0
+	}
+	r.pos++
+	return r.b[r.pos-1]
+}
+
+func (r *thriftReader) varint() uint64 {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		r.err = fmt.Errorf("bad varint")
+		return 0
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) zigzag() int64 {
+	v := r.varint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) readStruct() thriftStruct {
+	s := thriftStruct{}
+	var id int16
+	for r.err == nil {
+		h := r.byte()
+		if h == 0 {
+			break
+		}
+		if delta := int16(h >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(r.zigzag())
+		}
+		s[id] = r.readValue(h & 0x0f)
+	}
+	return s
+}
+
+func (r *thriftReader) readValue(typ byte) interface{} {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case 3:
+		return int64(r.byte())
+	case 4, 5, 6:
+		return r.zigzag()
+	case 7:
+		v := math.Float64frombits(binary.LittleEndian.Uint64(r.b[r.pos:]))
+		r.pos += 8
+		return v
+	case 8:
+		n := int(r.varint())
+		v := r.b[r.pos : r.pos+n]
+		r.pos += n
+		return v
+	case 9, 10:
+		h := r.byte()
+		size := int(h >> 4)
+		if size == 15 {
+			size = int(r.varint())
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			if et := h & 0x0f; et == 1 || et == 2 {
+				list[i] = r.byte() == 1
+			} else {
+				list[i] = r.readValue(et)
+			}
+		}
+		return list
+	case 12:
+		return r.readStruct()
+	}
+	r.err = fmt.Errorf("unsupported type %d", typ)
+	return nil
+}
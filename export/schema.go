@@ -0,0 +1,272 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// RestaurantSchema flattens zomato.Restaurant. Records are zomato.Restaurant
+// or *zomato.Restaurant values.
+var RestaurantSchema = Schema{
+	restaurantColumn("id", Int, func(r *zomato.Restaurant) interface{} { return r.ID }),
+	restaurantColumn("name", String, func(r *zomato.Restaurant) interface{} { return r.Name }),
+	restaurantColumn("url", String, func(r *zomato.Restaurant) interface{} { return r.URL }),
+	restaurantLocationColumn("location_address", String, func(l *zomato.RestaurantLocation) interface{} { return l.Address }),
+	restaurantLocationColumn("location_locality", String, func(l *zomato.RestaurantLocation) interface{} { return l.Locality }),
+	restaurantLocationColumn("location_locality_verbose", String, func(l *zomato.RestaurantLocation) interface{} { return l.LocalityVerbose }),
+	restaurantLocationColumn("location_city", String, func(l *zomato.RestaurantLocation) interface{} { return l.City }),
+	restaurantLocationColumn("location_city_id", Int, func(l *zomato.RestaurantLocation) interface{} { return l.CityID }),
+	restaurantLocationColumn("location_latitude", Float, func(l *zomato.RestaurantLocation) interface{} { return l.Latitude }),
+	restaurantLocationColumn("location_longitude", Float, func(l *zomato.RestaurantLocation) interface{} { return l.Longitude }),
+	restaurantLocationColumn("location_zipcode", String, func(l *zomato.RestaurantLocation) interface{} {
+		if l.Zipcode == nil {
+			return nil
+		}
+		return string(*l.Zipcode)
+	}),
+	restaurantLocationColumn("location_postal_code", String, func(l *zomato.RestaurantLocation) interface{} {
+		if code, ok := l.PostalCode(); ok {
+			return code
+		}
+		return nil
+	}),
+	restaurantLocationColumn("location_country_id", Int, func(l *zomato.RestaurantLocation) interface{} { return l.CountryID }),
+	restaurantColumn("cuisines", String, func(r *zomato.Restaurant) interface{} {
+		if len(r.Cuisines) == 0 {
+			return nil
+		}
+		return strings.Join(r.Cuisines, ", ")
+	}),
+	restaurantColumn("average_cost_for_two", Int, func(r *zomato.Restaurant) interface{} { return r.AverageCostForTwo }),
+	restaurantColumn("price_range", Int, func(r *zomato.Restaurant) interface{} { return r.PriceRange }),
+	restaurantColumn("currency", String, func(r *zomato.Restaurant) interface{} { return r.Currency }),
+	ratingColumn("user_rating_aggregate_rating", Float, func(u *zomato.UserRating) interface{} { return u.AggregateRating }),
+	ratingColumn("user_rating_rating_text", String, func(u *zomato.UserRating) interface{} { return u.RatingText }),
+	ratingColumn("user_rating_rating_color", String, func(u *zomato.UserRating) interface{} { return u.RatingColor }),
+	ratingColumn("user_rating_votes", Int, func(u *zomato.UserRating) interface{} { return u.Votes }),
+	restaurantColumn("has_online_delivery", Bool, func(r *zomato.Restaurant) interface{} { return r.HasOnlineDelivery }),
+	restaurantColumn("is_delivering_now", Bool, func(r *zomato.Restaurant) interface{} { return r.IsDeliveringNow }),
+	restaurantColumn("has_table_booking", Bool, func(r *zomato.Restaurant) interface{} { return r.HasTableBooking }),
+	restaurantColumn("thumb", String, func(r *zomato.Restaurant) interface{} { return r.ThumbnailURL }),
+	restaurantColumn("featured_image", String, func(r *zomato.Restaurant) interface{} { return r.FeaturedImageURL }),
+	restaurantColumn("photos_url", String, func(r *zomato.Restaurant) interface{} { return r.PhotosURL }),
+	restaurantColumn("menu_url", String, func(r *zomato.Restaurant) interface{} { return r.MenuURL }),
+	restaurantColumn("events_url", String, func(r *zomato.Restaurant) interface{} { return r.EventsURL }),
+	restaurantColumn("deeplink", String, func(r *zomato.Restaurant) interface{} { return r.DeeplinkURL }),
+	restaurantColumn("all_reviews_count", Int, func(r *zomato.Restaurant) interface{} { return r.ReviewsCount }),
+	restaurantColumn("photo_count", Int, func(r *zomato.Restaurant) interface{} { return r.PhotoCount }),
+	restaurantColumn("phone_numbers", String, func(r *zomato.Restaurant) interface{} { return r.PhoneNumbers }),
+}
+
+// LocationSchema flattens zomato.Location, e.g. the location of GeoCode and
+// LocationDetails responses. Records are zomato.Location or *zomato.Location
+// values.
+var LocationSchema = Schema{
+	locationColumn("entity_type", String, func(l *zomato.Location) interface{} { return l.EntityType }),
+	locationColumn("entity_id", Int, func(l *zomato.Location) interface{} { return l.EntityID }),
+	locationColumn("title", String, func(l *zomato.Location) interface{} { return l.Title }),
+	locationColumn("latitude", Float, func(l *zomato.Location) interface{} { return l.Latitude }),
+	locationColumn("longitude", Float, func(l *zomato.Location) interface{} { return l.Longitude }),
+	locationColumn("city_id", Int, func(l *zomato.Location) interface{} { return l.CityID }),
+	locationColumn("city_name", String, func(l *zomato.Location) interface{} { return l.CityName }),
+	locationColumn("country_id", Int, func(l *zomato.Location) interface{} { return l.CountryID }),
+	locationColumn("country_name", String, func(l *zomato.Location) interface{} { return l.CountryName }),
+}
+
+// RestaurantReview is a review of a restaurant
+type RestaurantReview struct {
+	RestaurantID int64
+	Review       zomato.Review
+}
+
+// Reviews returns the reviews in 'resp' of restaurant 'restaurantID'.
+func Reviews(restaurantID int64, resp zomato.ReviewsResp) []RestaurantReview {
+	var reviews []RestaurantReview
+	for _, r := range resp.UserReviews {
+		if r.Review != nil {
+			reviews = append(reviews, RestaurantReview{RestaurantID: restaurantID, Review: *r.Review})
+		}
+	}
+	return reviews
+}
+
+// ReviewSchema flattens zomato.Review. Records are RestaurantReview,
+// zomato.Review or pointers to them; restaurant_id is null for
+// zomato.Review records.
+var ReviewSchema = Schema{
+	reviewColumn("restaurant_id", Int, func(r *RestaurantReview) interface{} {
+		if r.RestaurantID == 0 {
+			return nil
+		}
+		return r.RestaurantID
+	}),
+	reviewColumn("id", Int, func(r *RestaurantReview) interface{} { return r.Review.ID }),
+	reviewColumn("rating", Float, func(r *RestaurantReview) interface{} { return r.Review.Rating }),
+	reviewColumn("rating_text", String, func(r *RestaurantReview) interface{} { return r.Review.RatingText }),
+	reviewColumn("rating_color", String, func(r *RestaurantReview) interface{} { return r.Review.RatingColor }),
+	reviewColumn("review_text", String, func(r *RestaurantReview) interface{} { return r.Review.ReviewText }),
+	reviewColumn("review_time_friendly", String, func(r *RestaurantReview) interface{} { return r.Review.ReviewTimeFriendly }),
+	reviewColumn("timestamp", Time, func(r *RestaurantReview) interface{} { return r.Review.Timestamp }),
+	reviewColumn("likes", Int, func(r *RestaurantReview) interface{} { return r.Review.Likes }),
+	reviewColumn("comments_count", Int, func(r *RestaurantReview) interface{} { return r.Review.CommentsCount }),
+	userColumn("user_name", String, func(u *zomato.User) interface{} { return u.Name }),
+	userColumn("user_zomato_handle", String, func(u *zomato.User) interface{} { return u.ZomatoHandle }),
+	userColumn("user_foodie_level", String, func(u *zomato.User) interface{} { return u.FoodieLevel }),
+	userColumn("user_foodie_level_num", Int, func(u *zomato.User) interface{} { return u.FoodieLevelNumber }),
+	userColumn("user_profile_url", String, func(u *zomato.User) interface{} { return u.ProfileURL }),
+}
+
+// MenuDish is a dish of a daily menu of a restaurant
+type MenuDish struct {
+	RestaurantID int64
+	Menu         *zomato.DailyMenu
+	Dish         zomato.Dish
+}
+
+// Dishes returns the dishes of the daily menus in 'resp' of restaurant
+// 'restaurantID'.
+func Dishes(restaurantID int64, resp zomato.DailyMenuResp) []MenuDish {
+	var dishes []MenuDish
+	for _, m := range resp.DailyMenus {
+		if m.DailyMenu == nil {
+			continue
+		}
+		for _, d := range m.DailyMenu.Dishes {
+			if d.Dish != nil {
+				dishes = append(dishes, MenuDish{RestaurantID: restaurantID, Menu: m.DailyMenu, Dish: *d.Dish})
+			}
+		}
+	}
+	return dishes
+}
+
+// DishSchema flattens zomato.Dish. Records are MenuDish, zomato.Dish or
+// pointers to them; restaurant and menu columns are null for zomato.Dish
+// records.
+var DishSchema = Schema{
+	dishColumn("restaurant_id", Int, func(d *MenuDish) interface{} {
+		if d.RestaurantID == 0 {
+			return nil
+		}
+		return d.RestaurantID
+	}),
+	menuColumn("daily_menu_id", Int, func(m *zomato.DailyMenu) interface{} { return m.ID }),
+	menuColumn("daily_menu_name", String, func(m *zomato.DailyMenu) interface{} { return m.Name }),
+	menuColumn("daily_menu_start_date", Time, func(m *zomato.DailyMenu) interface{} { return m.StartDate }),
+	menuColumn("daily_menu_end_date", Time, func(m *zomato.DailyMenu) interface{} { return m.EndDate }),
+	dishColumn("dish_id", Int, func(d *MenuDish) interface{} { return d.Dish.ID }),
+	dishColumn("name", String, func(d *MenuDish) interface{} { return d.Dish.Name }),
+	dishColumn("price", String, func(d *MenuDish) interface{} { return d.Dish.Price }),
+}
+
+func restaurantColumn(name string, t Type, f func(r *zomato.Restaurant) interface{}) Column {
+	return Column{Name: name, Type: t, Value: func(v interface{}) (interface{}, error) {
+		switch r := v.(type) {
+		case zomato.Restaurant:
+			return value(f(&r)), nil
+		case *zomato.Restaurant:
+			if r == nil {
+				return nil, nil
+			}
+			return value(f(r)), nil
+		}
+		return nil, errors.Errorf("record %T is not a zomato.Restaurant", v)
+	}}
+}
+
+func restaurantLocationColumn(name string, t Type, f func(l *zomato.RestaurantLocation) interface{}) Column {
+	return restaurantColumn(name, t, func(r *zomato.Restaurant) interface{} {
+		if r.Location == nil {
+			return nil
+		}
+		return f(r.Location)
+	})
+}
+
+func ratingColumn(name string, t Type, f func(u *zomato.UserRating) interface{}) Column {
+	return restaurantColumn(name, t, func(r *zomato.Restaurant) interface{} {
+		if r.UserRating == nil {
+			return nil
+		}
+		return f(r.UserRating)
+	})
+}
+
+func locationColumn(name string, t Type, f func(l *zomato.Location) interface{}) Column {
+	return Column{Name: name, Type: t, Value: func(v interface{}) (interface{}, error) {
+		switch l := v.(type) {
+		case zomato.Location:
+			return value(f(&l)), nil
+		case *zomato.Location:
+			if l == nil {
+				return nil, nil
+			}
+			return value(f(l)), nil
+		}
+		return nil, errors.Errorf("record %T is not a zomato.Location", v)
+	}}
+}
+
+func reviewColumn(name string, t Type, f func(r *RestaurantReview) interface{}) Column {
+	return Column{Name: name, Type: t, Value: func(v interface{}) (interface{}, error) {
+		switch r := v.(type) {
+		case RestaurantReview:
+			return value(f(&r)), nil
+		case *RestaurantReview:
+			if r == nil {
+				return nil, nil
+			}
+			return value(f(r)), nil
+		case zomato.Review:
+			return value(f(&RestaurantReview{Review: r})), nil
+		case *zomato.Review:
+			if r == nil {
+				return nil, nil
+			}
+			return value(f(&RestaurantReview{Review: *r})), nil
+		}
+		return nil, errors.Errorf("record %T is not a zomato.Review", v)
+	}}
+}
+
+func userColumn(name string, t Type, f func(u *zomato.User) interface{}) Column {
+	return reviewColumn(name, t, func(r *RestaurantReview) interface{} {
+		if r.Review.User == nil {
+			return nil
+		}
+		return f(r.Review.User)
+	})
+}
+
+func dishColumn(name string, t Type, f func(d *MenuDish) interface{}) Column {
+	return Column{Name: name, Type: t, Value: func(v interface{}) (interface{}, error) {
+		switch d := v.(type) {
+		case MenuDish:
+			return value(f(&d)), nil
+		case *MenuDish:
+			if d == nil {
+				return nil, nil
+			}
+			return value(f(d)), nil
+		case zomato.Dish:
+			return value(f(&MenuDish{Dish: d})), nil
+		case *zomato.Dish:
+			if d == nil {
+				return nil, nil
+			}
+			return value(f(&MenuDish{Dish: *d})), nil
+		}
+		return nil, errors.Errorf("record %T is not a zomato.Dish", v)
+	}}
+}
+
+func menuColumn(name string, t Type, f func(m *zomato.DailyMenu) interface{}) Column {
+	return dishColumn(name, t, func(d *MenuDish) interface{} {
+		if d.Menu == nil {
+			return nil
+		}
+		return f(d.Menu)
+	})
+}
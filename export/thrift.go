@@ -0,0 +1,100 @@
+package export
+
+import "encoding/binary"
+
+// Thrift compact protocol types
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs in the Thrift compact protocol, which Parquet
+// uses for page headers and file metadata.
+type thriftWriter struct {
+	buf []byte
+	// last holds the last field ID of each open struct
+	last []int16
+}
+
+func (t *thriftWriter) reset() {
+	t.buf, t.last = t.buf[:0], t.last[:0]
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	t.buf = binary.AppendUvarint(t.buf, v)
+}
+
+func (t *thriftWriter) zigzag(v int64) {
+	t.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (t *thriftWriter) structBegin() {
+	t.last = append(t.last, 0)
+}
+
+func (t *thriftWriter) structEnd() {
+	t.buf = append(t.buf, 0)
+	t.last = t.last[:len(t.last)-1]
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	last := &t.last[len(t.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.zigzag(int64(id))
+	}
+	*last = id
+}
+
+func (t *thriftWriter) listBegin(elemType byte, size int) {
+	if size < 15 {
+		t.buf = append(t.buf, byte(size)<<4|elemType)
+		return
+	}
+	t.buf = append(t.buf, 0xf0|elemType)
+	t.varint(uint64(size))
+}
+
+func (t *thriftWriter) binary(b []byte) {
+	t.varint(uint64(len(b)))
+	t.buf = append(t.buf, b...)
+}
+
+func (t *thriftWriter) i32Field(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.zigzag(int64(v))
+}
+
+func (t *thriftWriter) i64Field(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.zigzag(v)
+}
+
+func (t *thriftWriter) stringField(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.binary([]byte(s))
+}
+
+func (t *thriftWriter) structField(id int16, fields func()) {
+	t.field(id, thriftStruct)
+	t.structBegin()
+	fields()
+	t.structEnd()
+}
+
+// structListField writes a list of 'n' structs, calling 'fields' with the
+// index of each.
+func (t *thriftWriter) structListField(id int16, n int, fields func(i int)) {
+	t.field(id, thriftList)
+	t.listBegin(thriftStruct, n)
+	for i := 0; i < n; i++ {
+		t.structBegin()
+		fields(i)
+		t.structEnd()
+	}
+}
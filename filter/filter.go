@@ -0,0 +1,169 @@
+// Package filter filters and ranks restaurants beyond what search supports.
+//
+// Predicates compose with All, Any and Not:
+//
+//	match := filter.All(
+//		filter.MinRating(4),
+//		filter.MinVotes(100),
+//		filter.CostForTwo(0, 800),
+//		filter.OnlineDelivery(),
+//		filter.Not(filter.Cuisines("Fast Food")),
+//	)
+//	rs, err := filter.Find(ctx, zomato.NewSearchPaginator(client, req), match, 10)
+//
+// Rank then orders them by a weighted score, breaking ties in turn.
+package filter
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/cuisine"
+)
+
+// Predicate reports whether a restaurant matches
+type Predicate func(r zomato.Restaurant) bool
+
+// Filter returns the restaurants of 'rs' matching 'p', in order.
+func (p Predicate) Filter(rs []zomato.Restaurant) []zomato.Restaurant {
+	var matches []zomato.Restaurant
+	for _, r := range rs {
+		if p(r) {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+// All returns a predicate matching restaurants matching each of 'ps'.
+func All(ps ...Predicate) Predicate {
+	return func(r zomato.Restaurant) bool {
+		for _, p := range ps {
+			if !p(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Any returns a predicate matching restaurants matching one of 'ps'.
+func Any(ps ...Predicate) Predicate {
+	return func(r zomato.Restaurant) bool {
+		for _, p := range ps {
+			if p(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a predicate matching restaurants not matching 'p'.
+func Not(p Predicate) Predicate {
+	return func(r zomato.Restaurant) bool { return !p(r) }
+}
+
+// MinRating matches restaurants rated 'rating' or more.
+func MinRating(rating float64) Predicate {
+	return func(r zomato.Restaurant) bool {
+		return r.UserRating != nil && r.UserRating.AggregateRating != nil &&
+			*r.UserRating.AggregateRating >= rating
+	}
+}
+
+// MinVotes matches restaurants rated by 'votes' or more.
+func MinVotes(votes int64) Predicate {
+	return func(r zomato.Restaurant) bool {
+		return r.UserRating != nil && r.UserRating.Votes != nil && *r.UserRating.Votes >= votes
+	}
+}
+
+// PriceRange matches restaurants with price range 'min' to 'max'.
+func PriceRange(min, max uint8) Predicate {
+	return func(r zomato.Restaurant) bool {
+		return r.PriceRange != nil && *r.PriceRange >= min && *r.PriceRange <= max
+	}
+}
+
+// CostForTwo matches restaurants whose average cost for two is 'min' to
+// 'max'; 0 'max' is unbounded.
+func CostForTwo(min, max int64) Predicate {
+	return func(r zomato.Restaurant) bool {
+		if r.AverageCostForTwo == nil {
+			return false
+		}
+		cost := *r.AverageCostForTwo
+		return cost >= min && (max == 0 || cost <= max)
+	}
+}
+
+// OnlineDelivery matches restaurants delivering online.
+func OnlineDelivery() Predicate {
+	return func(r zomato.Restaurant) bool {
+		return r.HasOnlineDelivery != nil && *r.HasOnlineDelivery
+	}
+}
+
+// TableBooking matches restaurants taking table bookings.
+func TableBooking() Predicate {
+	return func(r zomato.Restaurant) bool {
+		return r.HasTableBooking != nil && *r.HasTableBooking
+	}
+}
+
+// Cuisines matches restaurants serving one of 'cuisines', ignoring case and
+// spelling variants; use Not(Cuisines(...)) to exclude them.
+func Cuisines(cuisines ...string) Predicate {
+	return func(r zomato.Restaurant) bool {
+		for _, c := range r.Cuisines {
+			for _, want := range cuisines {
+				if strings.EqualFold(cuisine.Normalize(c), cuisine.Normalize(want)) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// CuisineFamilies matches restaurants serving a cuisine of one of
+// 'families', like cuisine.Indian.
+func CuisineFamilies(families ...string) Predicate {
+	return func(r zomato.Restaurant) bool {
+		for _, f := range cuisine.Families(r) {
+			for _, want := range families {
+				if f == want {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// OpenAt matches restaurants open at 't', by their timings in the time zone
+// of their city; use OpenAt(time.Now()) for those open now. Restaurants of
+// unknown or unparsable timings don't match.
+func OpenAt(t time.Time) Predicate {
+	return func(r zomato.Restaurant) bool { return r.IsOpenAt(t) }
+}
+
+// Find returns the first 'n' restaurants from 'p' matching 'match',
+// fetching pages until they are found or the results run out.
+func Find(ctx context.Context, p *zomato.SearchPaginator, match Predicate, n int) ([]zomato.Restaurant, error) {
+	var found []zomato.Restaurant
+	for len(found) < n && p.Next(ctx) {
+		for _, r := range p.Restaurants() {
+			if match(r) {
+				found = append(found, r)
+				if len(found) == n {
+					break
+				}
+			}
+		}
+	}
+	return found, p.Err()
+}
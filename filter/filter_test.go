@@ -0,0 +1,183 @@
+package filter_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/cuisine"
+	"github.com/go-india/zomato/filter"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// restaurants returns the restaurants of the testdata search
+func restaurants(t *testing.T) []zomato.Restaurant {
+	data, err := ioutil.ReadFile(filepath.Join("../testdata", "Search.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp zomato.SearchResp
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	var rs []zomato.Restaurant
+	for _, r := range resp.Restaurants {
+		rs = append(rs, *r.Restaurant)
+	}
+	return rs
+}
+
+func ids(rs []zomato.Restaurant) []int64 {
+	var ids []int64
+	for _, r := range rs {
+		ids = append(ids, *r.ID)
+	}
+	return ids
+}
+
+func TestPredicate(t *testing.T) {
+	tests := []struct {
+		name string
+		p    filter.Predicate
+		want []int64
+	}{
+		{"min rating", filter.MinRating(4), []int64{9166, 310309}},
+		{"min votes", filter.MinVotes(200), []int64{9166, 310309}},
+		{"online delivery", filter.OnlineDelivery(), []int64{18537921}},
+		{"table booking", filter.TableBooking(), nil},
+		{"cuisines", filter.Cuisines("mithai", "Bakery"), []int64{18137099, 8530}},
+		{"cuisine spellings", filter.Cuisines("north-indian", "BAKERIES"), []int64{8530, 18312486, 9271, 303363}},
+		{"cuisine families", filter.CuisineFamilies(cuisine.Desserts, cuisine.Indian), []int64{18137099, 8530, 18312486, 9271, 303363}},
+		{"exclude cuisines", filter.Not(filter.Cuisines("Street Food")), []int64{18312486, 311560, 18492057, 9271, 303363}},
+		{"price range", filter.PriceRange(2, 4), nil},
+		{"cost for two", filter.CostForTwo(60, 0), nil},
+		{"all", filter.All(filter.PriceRange(1, 1), filter.CostForTwo(0, 50), filter.MinRating(4.1)), []int64{310309}},
+		{"any", filter.Any(filter.MinRating(4.2), filter.OnlineDelivery()), []int64{310309, 18537921}},
+		{"all cuisines and votes", filter.All(filter.Cuisines("North Indian"), filter.MinVotes(1)), []int64{18312486, 9271}},
+	}
+
+	rs := restaurants(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.p.Filter(rs)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredicateNil(t *testing.T) {
+	var r zomato.Restaurant
+	for name, p := range map[string]filter.Predicate{
+		"MinRating":       filter.MinRating(0),
+		"MinVotes":        filter.MinVotes(0),
+		"PriceRange":      filter.PriceRange(0, 4),
+		"CostForTwo":      filter.CostForTwo(0, 0),
+		"OnlineDelivery":  filter.OnlineDelivery(),
+		"TableBooking":    filter.TableBooking(),
+		"Cuisines":        filter.Cuisines(""),
+		"CuisineFamilies": filter.CuisineFamilies(cuisine.Other),
+		"OpenAt":          filter.OpenAt(time.Now()),
+	} {
+		if p(r) {
+			t.Errorf("%s matches a restaurant without fields", name)
+		}
+	}
+}
+
+func TestOpenAt(t *testing.T) {
+	var rs []zomato.Restaurant
+	for _, data := range []string{
+		`{"id":"1","timings":"12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)","location":{"city_id":1,"country_id":1}}`,
+		`{"id":"2","timings":"7 PM to 2 AM (Tue-Sun), Closed (Mon)","location":{"city_id":1,"country_id":1}}`,
+		`{"id":"3","timings":"24 Hours (Mon-Sun)"}`,
+		`{"id":"4","timings":"sometimes"}`,
+		`{"id":"5"}`,
+	} {
+		var r zomato.Restaurant
+		if err := json.Unmarshal([]byte(data), &r); err != nil {
+			t.Fatal(err)
+		}
+		rs = append(rs, r)
+	}
+
+	tests := []struct {
+		at   time.Time
+		want []int64
+	}{
+		// 20:30 on Monday 9 April 2018 in Delhi
+		{time.Date(2018, 4, 9, 15, 0, 0, 0, time.UTC), []int64{1, 3}},
+		// 01:30 on Sunday 8 April in Delhi, after Saturday night
+		{time.Date(2018, 4, 7, 20, 0, 0, 0, time.UTC), []int64{2, 3}},
+		// 01:30 on Tuesday, after closed Monday
+		{time.Date(2018, 4, 9, 20, 0, 0, 0, time.UTC), []int64{3}},
+	}
+	for _, tt := range tests {
+		if got := ids(filter.OpenAt(tt.at).Filter(rs)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("OpenAt(%s) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name  string
+		match filter.Predicate
+		n     int
+		want  []int64
+		calls int
+	}{
+		{"first page", filter.MinRating(3.5), 3, []int64{9166, 310309, 307327}, 1},
+		{"later pages", filter.Cuisines("North Indian"), 2, []int64{18312486, 9271}, 4},
+		{"run out", filter.Cuisines("North Indian"), 5, []int64{18312486, 9271, 303363}, 4},
+		{"none", filter.TableBooking(), 1, nil, 4},
+	}
+
+	ctx := context.Background()
+	rs := restaurants(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &zomatotest.Mock{
+				SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+					resp := zomato.SearchResp{ResultsFound: int64(len(rs)), ResultsStart: int64(req.Start)}
+					for i := req.Start; i < uint64(len(rs)) && i < req.Start+req.Count; i++ {
+						resp.Restaurants = append(resp.Restaurants, struct {
+							Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+						}{&rs[i]})
+					}
+					return resp, nil
+				},
+			}
+			p := zomato.NewSearchPaginator(m, zomato.SearchReq{EntityID: 1, EntityType: zomato.CityEntity, Count: 5})
+
+			found, err := filter.Find(ctx, p, tt.match, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(found); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Find() = %v, want %v", got, tt.want)
+			}
+			if calls := len(m.CallsTo("Search")); calls != tt.calls {
+				t.Errorf("Search called %d times, want %d", calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestFindErr(t *testing.T) {
+	m := &zomatotest.Mock{
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			return zomato.SearchResp{}, &zomato.ErrAPI{StatusCode: http.StatusForbidden}
+		},
+	}
+	p := zomato.NewSearchPaginator(m, zomato.SearchReq{})
+	if _, err := filter.Find(context.Background(), p, filter.MinRating(4), 1); err == nil {
+		t.Error("Find() error = nil, want the search error")
+	}
+}
@@ -0,0 +1,152 @@
+package filter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-india/zomato"
+)
+
+// Score rates a restaurant; higher ranks first
+type Score func(r zomato.Restaurant) float64
+
+// Weight is a score and its weight in a Weighted score
+type Weight struct {
+	Score  Score
+	Weight float64
+}
+
+// Weighted returns the sum of 'ws' scores times their weights.
+//
+//	score := filter.Weighted(
+//		filter.Weight{Score: filter.Rating, Weight: 2},
+//		filter.Weight{Score: filter.Votes(500), Weight: 1},
+//		filter.Weight{Score: filter.Matches(filter.OnlineDelivery()), Weight: 0.5},
+//	)
+func Weighted(ws ...Weight) Score {
+	return func(r zomato.Restaurant) float64 {
+		var sum float64
+		for _, w := range ws {
+			sum += w.Weight * w.Score(r)
+		}
+		return sum
+	}
+}
+
+// Rating scores restaurants by their aggregate rating, from 0 to 1; 0 if
+// not rated.
+func Rating(r zomato.Restaurant) float64 {
+	if r.UserRating == nil || r.UserRating.AggregateRating == nil {
+		return 0
+	}
+	return *r.UserRating.AggregateRating / 5
+}
+
+// Votes returns a score of restaurants by votes, from 0 to 1, that is 0.5
+// at 'half' votes.
+func Votes(half int64) Score {
+	return func(r zomato.Restaurant) float64 {
+		if r.UserRating == nil || r.UserRating.Votes == nil || *r.UserRating.Votes <= 0 {
+			return 0
+		}
+		v := float64(*r.UserRating.Votes)
+		return v / (v + float64(half))
+	}
+}
+
+// Cheap returns a score of restaurants by average cost for two, from 1 if
+// free to 0 at 'max' or more; 0 if unknown.
+func Cheap(max int64) Score {
+	return func(r zomato.Restaurant) float64 {
+		if r.AverageCostForTwo == nil || *r.AverageCostForTwo >= max {
+			return 0
+		}
+		return 1 - float64(*r.AverageCostForTwo)/float64(max)
+	}
+}
+
+// Matches returns a score of 1 for restaurants matching 'p', 0 otherwise.
+func Matches(p Predicate) Score {
+	return func(r zomato.Restaurant) float64 {
+		if p(r) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// Less reports whether 'a' ranks before 'b'
+type Less func(a, b zomato.Restaurant) bool
+
+// ByRating ranks higher rated restaurants first.
+func ByRating(a, b zomato.Restaurant) bool { return Rating(a) > Rating(b) }
+
+// ByVotes ranks restaurants with more votes first.
+func ByVotes(a, b zomato.Restaurant) bool { return votes(a) > votes(b) }
+
+// ByCost ranks cheaper restaurants first, unknown costs last.
+func ByCost(a, b zomato.Restaurant) bool {
+	if a.AverageCostForTwo == nil || b.AverageCostForTwo == nil {
+		return a.AverageCostForTwo != nil && b.AverageCostForTwo == nil
+	}
+	return *a.AverageCostForTwo < *b.AverageCostForTwo
+}
+
+// ByName ranks restaurants by name, ignoring case.
+func ByName(a, b zomato.Restaurant) bool {
+	return strings.ToLower(str(a.Name)) < strings.ToLower(str(b.Name))
+}
+
+// Rank sorts 'rs' by 'score', highest first, breaking ties with
+// 'tieBreak' in turn and then by ID.
+func Rank(rs []zomato.Restaurant, score Score, tieBreak ...Less) {
+	scores := make([]float64, len(rs))
+	idx := make([]int, len(rs))
+	for i, r := range rs {
+		idx[i] = i
+		scores[i] = score(r)
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := idx[i], idx[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		for _, less := range tieBreak {
+			if less(rs[a], rs[b]) {
+				return true
+			}
+			if less(rs[b], rs[a]) {
+				return false
+			}
+		}
+		return id(rs[a]) < id(rs[b])
+	})
+
+	sorted := make([]zomato.Restaurant, len(rs))
+	for i, j := range idx {
+		sorted[i] = rs[j]
+	}
+	copy(rs, sorted)
+}
+
+func votes(r zomato.Restaurant) int64 {
+	if r.UserRating == nil || r.UserRating.Votes == nil {
+		return 0
+	}
+	return *r.UserRating.Votes
+}
+
+func id(r zomato.Restaurant) int64 {
+	if r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,85 @@
+package filter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/filter"
+)
+
+func TestScore(t *testing.T) {
+	rating, votes, cost := 4.0, int64(100), int64(50)
+	r := zomato.Restaurant{
+		UserRating:        &zomato.UserRating{AggregateRating: &rating, Votes: &votes},
+		AverageCostForTwo: &cost,
+	}
+
+	tests := []struct {
+		name  string
+		score filter.Score
+		want  float64
+	}{
+		{"rating", filter.Rating, 0.8},
+		{"votes", filter.Votes(100), 0.5},
+		{"cheap", filter.Cheap(200), 0.75},
+		{"too costly", filter.Cheap(50), 0},
+		{"matches", filter.Matches(filter.MinRating(4)), 1},
+		{"no match", filter.Matches(filter.OnlineDelivery()), 0},
+		{"weighted", filter.Weighted(
+			filter.Weight{Score: filter.Rating, Weight: 2},
+			filter.Weight{Score: filter.Votes(100), Weight: 1},
+			filter.Weight{Score: filter.Matches(filter.OnlineDelivery()), Weight: 5},
+		), 2.1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.score(r); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s score = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.score(zomato.Restaurant{}); got != 0 {
+			t.Errorf("%s score of a restaurant without fields = %v, want 0", tt.name, got)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    filter.Score
+		tieBreak []filter.Less
+		want     []int64 // first ids ranked
+	}{
+		{"rating", filter.Rating, nil, []int64{310309, 9166, 307327, 18537921, 311560, 18137099}},
+		{"rating by name", filter.Rating, []filter.Less{filter.ByName}, []int64{310309, 9166, 307327, 18537921, 18137099, 311560}},
+		{"delivery then rating", filter.Weighted(
+			filter.Weight{Score: filter.Matches(filter.OnlineDelivery()), Weight: 10},
+			filter.Weight{Score: filter.Rating, Weight: 1},
+		), nil, []int64{18537921, 310309, 9166}},
+		{"cost by votes", filter.Cheap(100), []filter.Less{filter.ByVotes, filter.ByRating}, []int64{9166, 310309, 307327, 18537921}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := restaurants(t)
+			filter.Rank(rs, tt.score, tt.tieBreak...)
+			if got := ids(rs)[:len(tt.want)]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rank() = %v, want %v first", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByCost(t *testing.T) {
+	id := func(i int64) *int64 { return &i }
+	rs := []zomato.Restaurant{
+		{ID: id(1)},
+		{ID: id(2), AverageCostForTwo: id(800)},
+		{ID: id(3), AverageCostForTwo: id(300)},
+	}
+	filter.Rank(rs, func(zomato.Restaurant) float64 { return 0 }, filter.ByCost)
+	if got, want := ids(rs), []int64{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rank() by cost = %v, want %v", got, want)
+	}
+}
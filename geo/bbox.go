@@ -0,0 +1,91 @@
+package geo
+
+import "math"
+
+// BBox is a bounding box between south west corner Min and north east
+// corner Max. Boxes crossing the antimeridian have Min.Lon > Max.Lon.
+type BBox struct {
+	Min, Max Coordinates
+}
+
+// Around returns the smallest box holding the circle of 'radius' around
+// 'c'. Boxes of circles holding a pole span every longitude.
+func Around(c Coordinates, radius float64) BBox {
+	d := deg(radius / EarthRadius)
+	b := BBox{
+		Min: Coordinates{c.Lat - d, -180},
+		Max: Coordinates{c.Lat + d, 180},
+	}
+	if b.Min.Lat <= -90 || b.Max.Lat >= 90 {
+		b.Min.Lat, b.Max.Lat = math.Max(b.Min.Lat, -90), math.Min(b.Max.Lat, 90)
+		return b
+	}
+
+	// Longitudes of the meridians tangent to the circle
+	dLon := deg(math.Asin(math.Sin(rad(d)) / math.Cos(rad(c.Lat))))
+	b.Min.Lon, b.Max.Lon = wrap(c.Lon-dLon), wrap(c.Lon+dLon)
+	return b
+}
+
+// Bounds returns the smallest box holding 'cs', not crossing the
+// antimeridian.
+func Bounds(cs ...Coordinates) BBox {
+	if len(cs) == 0 {
+		return BBox{}
+	}
+	b := BBox{Min: cs[0], Max: cs[0]}
+	for _, c := range cs[1:] {
+		b.Min.Lat, b.Min.Lon = math.Min(b.Min.Lat, c.Lat), math.Min(b.Min.Lon, c.Lon)
+		b.Max.Lat, b.Max.Lon = math.Max(b.Max.Lat, c.Lat), math.Max(b.Max.Lon, c.Lon)
+	}
+	return b
+}
+
+// Contains reports whether 'c' lies in the box.
+func (b BBox) Contains(c Coordinates) bool {
+	if c.Lat < b.Min.Lat || c.Lat > b.Max.Lat {
+		return false
+	}
+	if b.Min.Lon <= b.Max.Lon {
+		return c.Lon >= b.Min.Lon && c.Lon <= b.Max.Lon
+	}
+	return c.Lon >= b.Min.Lon || c.Lon <= b.Max.Lon
+}
+
+// Center returns the centre of the box.
+func (b BBox) Center() Coordinates {
+	lon := (b.Min.Lon + b.Max.Lon) / 2
+	if b.Min.Lon > b.Max.Lon {
+		lon = wrap(lon + 180)
+	}
+	return Coordinates{(b.Min.Lat + b.Max.Lat) / 2, lon}
+}
+
+// Polygon is a closed ring of coordinates; the last vertex joins the
+// first. Edges are straight in latitude and longitude, which is close
+// enough for neighbourhoods and delivery zones.
+type Polygon []Coordinates
+
+// Contains reports whether 'c' lies inside the polygon, by counting the
+// edges a ray from 'c' crosses.
+func (p Polygon) Contains(c Coordinates) bool {
+	in := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Lat > c.Lat) != (b.Lat > c.Lat) &&
+			c.Lon < (b.Lon-a.Lon)*(c.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			in = !in
+		}
+	}
+	return in
+}
+
+// Bounds returns the bounding box of the polygon.
+func (p Polygon) Bounds() BBox {
+	return Bounds(p...)
+}
+
+// wrap returns longitude 'lon' in [-180, 180)
+func wrap(lon float64) float64 {
+	return math.Mod(math.Mod(lon+180, 360)+360, 360) - 180
+}
@@ -0,0 +1,105 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-india/zomato/geo"
+)
+
+func TestAround(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       geo.Coordinates
+		radius  float64
+		in, out []geo.Coordinates
+	}{
+		{"delhi", delhi, 10e3,
+			[]geo.Coordinates{delhi, {Lat: 28.70, Lon: 77.21}, {Lat: 28.61, Lon: 77.31}},
+			[]geo.Coordinates{{Lat: 28.71, Lon: 77.21}, {Lat: 28.61, Lon: 77.32}, mumbai}},
+		{"antimeridian", geo.Coordinates{Lat: 0, Lon: 179.9}, 50e3,
+			[]geo.Coordinates{{Lon: 179.5}, {Lon: -179.8}, {Lon: 180}},
+			[]geo.Coordinates{{Lon: 179}, {Lon: -179}, {}}},
+		{"pole", geo.Coordinates{Lat: 89.9, Lon: 0}, 50e3,
+			[]geo.Coordinates{{Lat: 90}, {Lat: 89.8, Lon: 180}, {Lat: 89.8, Lon: -90}},
+			[]geo.Coordinates{{Lat: 89, Lon: 0}}},
+	}
+
+	for _, tt := range tests {
+		b := geo.Around(tt.c, tt.radius)
+		for _, c := range tt.in {
+			if !b.Contains(c) {
+				t.Errorf("%s: %+v does not contain %+v", tt.name, b, c)
+			}
+		}
+		for _, c := range tt.out {
+			if b.Contains(c) {
+				t.Errorf("%s: %+v contains %+v", tt.name, b, c)
+			}
+		}
+	}
+}
+
+func TestAroundTight(t *testing.T) {
+	// The box touches the circle on each side
+	b := geo.Around(delhi, 5e3)
+	for _, edge := range []geo.Coordinates{
+		{Lat: b.Min.Lat, Lon: delhi.Lon},
+		{Lat: b.Max.Lat, Lon: delhi.Lon},
+	} {
+		if d := geo.Haversine(delhi, edge); math.Abs(d-5e3) > 1 {
+			t.Errorf("edge %+v at %.1fm, want 5000m", edge, d)
+		}
+	}
+	if c := b.Center(); math.Abs(c.Lat-delhi.Lat) > 1e-9 || math.Abs(c.Lon-delhi.Lon) > 1e-9 {
+		t.Errorf("Center() = %+v, want %+v", c, delhi)
+	}
+}
+
+func TestCenter(t *testing.T) {
+	b := geo.BBox{Min: geo.Coordinates{Lat: -1, Lon: 179}, Max: geo.Coordinates{Lat: 1, Lon: -177}}
+	if got, want := b.Center(), (geo.Coordinates{Lat: 0, Lon: -179}); got != want {
+		t.Errorf("Center() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	b := geo.Bounds(delhi, mumbai, geo.Coordinates{Lat: 22.57, Lon: 88.36})
+	want := geo.BBox{Min: geo.Coordinates{Lat: 19.0760, Lon: 72.8777}, Max: geo.Coordinates{Lat: 28.6139, Lon: 88.36}}
+	if b != want {
+		t.Errorf("Bounds() = %+v, want %+v", b, want)
+	}
+	if (geo.Bounds() != geo.BBox{}) {
+		t.Errorf("Bounds() of nothing = %+v, want zero", geo.Bounds())
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// A concave "C" around Connaught Place
+	p := geo.Polygon{
+		{Lat: 28.60, Lon: 77.20}, {Lat: 28.64, Lon: 77.20}, {Lat: 28.64, Lon: 77.24},
+		{Lat: 28.63, Lon: 77.24}, {Lat: 28.63, Lon: 77.21}, {Lat: 28.61, Lon: 77.21},
+		{Lat: 28.61, Lon: 77.24}, {Lat: 28.60, Lon: 77.24},
+	}
+
+	tests := []struct {
+		c    geo.Coordinates
+		want bool
+	}{
+		{geo.Coordinates{Lat: 28.62, Lon: 77.205}, true},
+		{geo.Coordinates{Lat: 28.635, Lon: 77.23}, true},
+		{geo.Coordinates{Lat: 28.605, Lon: 77.23}, true},
+		{geo.Coordinates{Lat: 28.62, Lon: 77.23}, false}, // in the mouth of the C
+		{geo.Coordinates{Lat: 28.65, Lon: 77.22}, false},
+		{geo.Coordinates{Lat: 28.62, Lon: 77.19}, false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Contains(tt.c); got != tt.want {
+			t.Errorf("Contains(%+v) = %v, want %v", tt.c, got, tt.want)
+		}
+	}
+	if b := p.Bounds(); b.Min != p[0] || b.Max != p[2] {
+		t.Errorf("Bounds() = %+v, want %+v to %+v", b, p[0], p[2])
+	}
+}
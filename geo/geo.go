@@ -0,0 +1,139 @@
+// Package geo measures distances between coordinates and finds the
+// restaurants near a point.
+//
+//	from := geo.Coordinates{Lat: 28.6139, Lon: 77.2090}
+//	ix := geo.IndexSearch(resp)
+//	for _, n := range ix.Nearest(from, 5) {
+//		fmt.Printf("%s %.0fm\n", *n.Restaurant.Name, n.Distance)
+//	}
+//
+// Distances are in meters.
+package geo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// EarthRadius is the mean radius of the earth in meters
+const EarthRadius = 6371e3
+
+// WGS-84 ellipsoid used by Vincenty
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// ErrNoConvergence is returned by Vincenty for nearly antipodal points
+var ErrNoConvergence = errors.New("geo: vincenty formula failed to converge")
+
+// Coordinates are a latitude and longitude in degrees
+type Coordinates struct {
+	Lat, Lon float64
+}
+
+// Of returns the coordinates of restaurant 'r', and whether it has them.
+func Of(r zomato.Restaurant) (Coordinates, bool) {
+	l := r.Location
+	if l == nil || l.Latitude == nil || l.Longitude == nil {
+		return Coordinates{}, false
+	}
+	return Coordinates{*l.Latitude, *l.Longitude}, true
+}
+
+// OfLocation returns the coordinates of the centre of location 'l', and
+// whether it has them.
+func OfLocation(l zomato.Location) (Coordinates, bool) {
+	if l.Latitude == nil || l.Longitude == nil {
+		return Coordinates{}, false
+	}
+	return Coordinates{*l.Latitude, *l.Longitude}, true
+}
+
+// Haversine returns the great circle distance between 'a' and 'b' on a
+// sphere of EarthRadius.
+func Haversine(a, b Coordinates) float64 {
+	dLat, dLon := rad(b.Lat-a.Lat), rad(b.Lon-a.Lon)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(rad(a.Lat))*math.Cos(rad(b.Lat))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Vincenty returns the distance between 'a' and 'b' on the WGS-84
+// ellipsoid, accurate to within a millimetre. It returns ErrNoConvergence
+// for nearly antipodal points; use Haversine for them.
+func Vincenty(a, b Coordinates) (float64, error) {
+	L := rad(b.Lon - a.Lon)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(rad(a.Lat)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(rad(b.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	for i := 0; ; i++ {
+		if i == 200 {
+			return 0, ErrNoConvergence
+		}
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0, nil // coincident points
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 { // not on the equator
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		prev := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*
+			(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			break
+		}
+	}
+
+	uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return wgs84B * A * (sigma - deltaSigma), nil
+}
+
+// SortByDistance sorts 'rs' by distance from 'from', nearest first and
+// restaurants without coordinates last.
+func SortByDistance(rs []zomato.Restaurant, from Coordinates) {
+	dist := make([]float64, len(rs))
+	for i, r := range rs {
+		dist[i] = math.Inf(1)
+		if c, ok := Of(r); ok {
+			dist[i] = Haversine(from, c)
+		}
+	}
+	sort.Stable(byDistance{rs, dist})
+}
+
+// byDistance sorts restaurants with their distances
+type byDistance struct {
+	rs   []zomato.Restaurant
+	dist []float64
+}
+
+func (s byDistance) Len() int           { return len(s.rs) }
+func (s byDistance) Less(i, j int) bool { return s.dist[i] < s.dist[j] }
+func (s byDistance) Swap(i, j int) {
+	s.rs[i], s.rs[j] = s.rs[j], s.rs[i]
+	s.dist[i], s.dist[j] = s.dist[j], s.dist[i]
+}
+
+func rad(deg float64) float64 { return deg * math.Pi / 180 }
+func deg(rad float64) float64 { return rad * 180 / math.Pi }
@@ -0,0 +1,93 @@
+package geo_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+)
+
+var (
+	delhi   = geo.Coordinates{Lat: 28.6139, Lon: 77.2090}
+	mumbai  = geo.Coordinates{Lat: 19.0760, Lon: 72.8777}
+	london  = geo.Coordinates{Lat: 51.5074, Lon: -0.1278}
+	newYork = geo.Coordinates{Lat: 40.7128, Lon: -74.0060}
+)
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b geo.Coordinates
+		want float64 // meters
+	}{
+		{"same point", delhi, delhi, 0},
+		{"delhi mumbai", delhi, mumbai, 1148.1e3},
+		{"london new york", london, newYork, 5570.2e3},
+		{"antimeridian", geo.Coordinates{Lon: 179.5}, geo.Coordinates{Lon: -179.5}, 111.2e3},
+		{"antipodal", geo.Coordinates{Lat: 90}, geo.Coordinates{Lat: -90}, math.Pi * geo.EarthRadius},
+	}
+
+	for _, tt := range tests {
+		got := geo.Haversine(tt.a, tt.b)
+		if math.Abs(got-tt.want) > 100 {
+			t.Errorf("%s: Haversine() = %.0f, want %.0f", tt.name, got, tt.want)
+		}
+		if back := geo.Haversine(tt.b, tt.a); math.Abs(back-got) > 1e-6 {
+			t.Errorf("%s: Haversine() not symmetric: %f, %f", tt.name, got, back)
+		}
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b geo.Coordinates
+		want float64 // meters
+		err  error
+	}{
+		{"same point", delhi, delhi, 0, nil},
+		// Flinders Peak to Buninyong, from Vincenty's paper
+		{"vincenty", geo.Coordinates{Lat: -37.95103342, Lon: 144.42486789},
+			geo.Coordinates{Lat: -37.65282114, Lon: 143.92649554}, 54972.271, nil},
+		{"meridian", geo.Coordinates{}, geo.Coordinates{Lat: 1}, 110574.389, nil},
+		{"equator", geo.Coordinates{}, geo.Coordinates{Lon: 1}, 111319.491, nil},
+		{"antipodal", geo.Coordinates{}, geo.Coordinates{Lat: 0.5, Lon: 179.7}, 0, geo.ErrNoConvergence},
+	}
+
+	for _, tt := range tests {
+		got, err := geo.Vincenty(tt.a, tt.b)
+		if err != tt.err {
+			t.Errorf("%s: Vincenty() error = %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if math.Abs(got-tt.want) > 1e-3 {
+			t.Errorf("%s: Vincenty() = %.4f, want %.4f", tt.name, got, tt.want)
+		}
+	}
+}
+
+// restaurant returns a restaurant 'id' at 'c'
+func restaurant(id int64, c geo.Coordinates) zomato.Restaurant {
+	return zomato.Restaurant{ID: &id, Location: &zomato.RestaurantLocation{Latitude: &c.Lat, Longitude: &c.Lon}}
+}
+
+func TestSortByDistance(t *testing.T) {
+	id := int64(4)
+	rs := []zomato.Restaurant{
+		restaurant(1, london),
+		{ID: &id},
+		restaurant(2, mumbai),
+		restaurant(3, delhi),
+	}
+	geo.SortByDistance(rs, geo.Coordinates{Lat: 28.7, Lon: 77.1})
+
+	var ids []int64
+	for _, r := range rs {
+		ids = append(ids, *r.ID)
+	}
+	if want := []int64{3, 2, 1, 4}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("SortByDistance() = %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,178 @@
+package geo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-india/zomato"
+)
+
+// DefaultPrecision is the default geohash length of GeoCodeCache
+const DefaultPrecision = 7
+
+// GeoCodeCache implements zomato.API, answering GeoCode calls for points
+// near ones looked up before. Other calls go to the embedded API.
+//
+// Points are snapped to the centre of their geohash cell, which is looked
+// up instead, so lookups answer every point of their cell. With Tolerance,
+// they also answer points of neighbouring cells within Tolerance of their
+// centre.
+//
+//	api := geo.NewGeoCodeCache(client, 7, 200, time.Hour)
+//	resp, err := api.GeoCode(ctx, 28.7041, 77.1025)
+//	log.Printf("geocode calls saved: %d", api.Stats().Hits)
+//
+// GeoCodeCache is safe for use by multiple go routines. Cached responses
+// are shared by callers and must not be modified.
+type GeoCodeCache struct {
+	zomato.API
+	// Precision is the geohash length points are snapped to; defaults to
+	// DefaultPrecision
+	Precision int
+	// Tolerance is the distance in meters from a looked up centre within
+	// which points of neighbouring cells are answered; 0 answers points of
+	// the same cell only
+	Tolerance float64
+	// TTL is how long lookups are kept before they are refreshed; 0 keeps
+	// them forever
+	TTL time.Duration
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]geoCodeEntry
+	stats   GeoCodeStats
+}
+
+var _ zomato.API = &GeoCodeCache{}
+
+type geoCodeEntry struct {
+	center  Coordinates
+	resp    zomato.GeoCodeResp
+	expires time.Time
+}
+
+// GeoCodeStats counts the GeoCode calls of a GeoCodeCache
+type GeoCodeStats struct {
+	// Lookups is the number of GeoCode calls
+	Lookups int64
+	// Hits is the number of calls answered from the cache, that is the
+	// API calls saved
+	Hits int64
+	// Refreshes is the number of API calls made for expired lookups
+	Refreshes int64
+	// Entries is the number of cells looked up
+	Entries int
+}
+
+// NewGeoCodeCache returns a cache of the GeoCode calls of 'api'.
+func NewGeoCodeCache(api zomato.API, precision int, tolerance float64, ttl time.Duration) *GeoCodeCache {
+	return &GeoCodeCache{API: api, Precision: precision, Tolerance: tolerance, TTL: ttl, Now: time.Now}
+}
+
+// GeoCode implements zomato.API, answering from the lookup of the cell of
+// 'lat', 'long' or of a neighbouring cell within Tolerance.
+func (c *GeoCodeCache) GeoCode(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+	p := Coordinates{lat, long}
+	hash := Geohash(p, c.precision())
+	now := c.now()
+
+	c.mu.Lock()
+	c.stats.Lookups++
+	e, found := c.lookup(hash, p, now)
+	if found {
+		c.stats.Hits++
+		c.mu.Unlock()
+		return e.resp, nil
+	}
+	_, stale := c.entries[hash]
+	c.mu.Unlock()
+
+	cell, err := GeohashBox(hash)
+	if err != nil {
+		return zomato.GeoCodeResp{}, err
+	}
+	center := cell.Center()
+	resp, err := c.API.GeoCode(ctx, center.Lat, center.Lon)
+	if err != nil {
+		return resp, err
+	}
+
+	e = geoCodeEntry{center: center, resp: resp}
+	if c.TTL > 0 {
+		e.expires = now.Add(c.TTL)
+	}
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]geoCodeEntry)
+	}
+	c.entries[hash] = e
+	if stale {
+		c.stats.Refreshes++
+	}
+	c.mu.Unlock()
+	return resp, nil
+}
+
+// lookup returns the fresh entry of cell 'hash', or else the nearest fresh
+// entry of its neighbours within Tolerance of 'p'.
+func (c *GeoCodeCache) lookup(hash string, p Coordinates, now time.Time) (geoCodeEntry, bool) {
+	fresh := func(e geoCodeEntry) bool { return e.expires.IsZero() || now.Before(e.expires) }
+
+	if e, ok := c.entries[hash]; ok && fresh(e) {
+		return e, true
+	}
+	if c.Tolerance <= 0 {
+		return geoCodeEntry{}, false
+	}
+
+	neighbours, _ := GeohashNeighbours(hash)
+	var best geoCodeEntry
+	found, bestDist := false, c.Tolerance
+	for _, n := range neighbours {
+		e, ok := c.entries[n]
+		if !ok || !fresh(e) {
+			continue
+		}
+		if d := Haversine(p, e.center); d <= bestDist {
+			best, found, bestDist = e, true, d
+		}
+	}
+	return best, found
+}
+
+// Stats returns the counts of GeoCode calls so far.
+func (c *GeoCodeCache) Stats() GeoCodeStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Entries = len(c.entries)
+	return s
+}
+
+// Purge removes expired lookups, or all if 'all'.
+func (c *GeoCodeCache) Purge(all bool) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if all || !e.expires.IsZero() && !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func (c *GeoCodeCache) precision() int {
+	if c.Precision <= 0 {
+		return DefaultPrecision
+	}
+	return c.Precision
+}
+
+func (c *GeoCodeCache) now() time.Time {
+	if c.Now == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
@@ -0,0 +1,140 @@
+package geo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// geoCoder mocks GeoCode, naming the location by the coordinates asked for
+func geoCoder() *zomatotest.Mock {
+	return &zomatotest.Mock{
+		GeoCodeFunc: func(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+			return zomato.GeoCodeResp{Location: &zomato.Location{Latitude: &lat, Longitude: &long}}, nil
+		},
+	}
+}
+
+func TestGeoCodeCache(t *testing.T) {
+	ctx := context.Background()
+	// Cell "ttnfucj" is about 150m by 150m
+	cell, err := geo.GeohashBox("ttnfucj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	center := cell.Center()
+	inCell := geo.Coordinates{Lat: cell.Min.Lat + 1e-5, Lon: cell.Max.Lon - 1e-5}
+	// Just north of the cell, about 70m from its centre
+	north := geo.Coordinates{Lat: cell.Max.Lat + 1e-5, Lon: center.Lon}
+	far := geo.Coordinates{Lat: center.Lat + 0.01, Lon: center.Lon}
+
+	tests := []struct {
+		name      string
+		tolerance float64
+		points    []geo.Coordinates
+		calls     int
+	}{
+		{"same cell", 0, []geo.Coordinates{center, inCell, center}, 1},
+		{"neighbour without tolerance", 0, []geo.Coordinates{center, north}, 2},
+		{"neighbour within tolerance", 100, []geo.Coordinates{center, north}, 1},
+		{"neighbour beyond tolerance", 50, []geo.Coordinates{center, north}, 2},
+		{"far", 1000, []geo.Coordinates{center, far}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := geoCoder()
+			c := geo.NewGeoCodeCache(m, 7, tt.tolerance, 0)
+			for _, p := range tt.points {
+				resp, err := c.GeoCode(ctx, p.Lat, p.Lon)
+				if err != nil {
+					t.Fatal(err)
+				}
+				at, _ := geo.OfLocation(*resp.Location)
+				if d := geo.Haversine(p, at); d > 150 {
+					t.Errorf("GeoCode(%+v) answered for %+v, %.0fm away", p, at, d)
+				}
+			}
+
+			if calls := len(m.CallsTo("GeoCode")); calls != tt.calls {
+				t.Errorf("GeoCode called %d times, want %d", calls, tt.calls)
+			}
+			stats := c.Stats()
+			if want := int64(len(tt.points) - tt.calls); stats.Hits != want || stats.Lookups != int64(len(tt.points)) {
+				t.Errorf("Stats() = %+v, want %d hits of %d lookups", stats, want, len(tt.points))
+			}
+		})
+	}
+}
+
+func TestGeoCodeCacheSnaps(t *testing.T) {
+	m := geoCoder()
+	c := geo.NewGeoCodeCache(m, 5, 0, 0)
+	if _, err := c.GeoCode(context.Background(), 42.6, -5.6); err != nil {
+		t.Fatal(err)
+	}
+
+	cell, _ := geo.GeohashBox("ezs42")
+	req := m.CallsTo("GeoCode")[0].Req.(zomato.GeoCodeReq)
+	if got := (geo.Coordinates{Lat: req.Latitude, Lon: req.Longitude}); got != cell.Center() {
+		t.Errorf("GeoCode called for %+v, want cell centre %+v", got, cell.Center())
+	}
+}
+
+func TestGeoCodeCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	m := geoCoder()
+	c := geo.NewGeoCodeCache(m, 7, 0, time.Hour)
+	c.Now = func() time.Time { return now }
+
+	for _, step := range []struct {
+		after time.Duration
+		calls int
+	}{
+		{0, 1},
+		{59 * time.Minute, 1},
+		{time.Minute, 2},
+		{30 * time.Minute, 2},
+	} {
+		now = now.Add(step.after)
+		if _, err := c.GeoCode(ctx, delhi.Lat, delhi.Lon); err != nil {
+			t.Fatal(err)
+		}
+		if calls := len(m.CallsTo("GeoCode")); calls != step.calls {
+			t.Errorf("after %s: GeoCode called %d times, want %d", step.after, calls, step.calls)
+		}
+	}
+	if s := c.Stats(); s.Refreshes != 1 || s.Hits != 2 || s.Entries != 1 {
+		t.Errorf("Stats() = %+v, want 1 refresh, 2 hits and 1 entry", s)
+	}
+
+	now = now.Add(time.Hour)
+	c.Purge(false)
+	if s := c.Stats(); s.Entries != 0 {
+		t.Errorf("Stats() after Purge = %+v, want no entries", s)
+	}
+}
+
+func TestGeoCodeCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	m := &zomatotest.Mock{
+		GeoCodeFunc: func(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+			return zomato.GeoCodeResp{}, &zomato.ErrAPI{StatusCode: http.StatusServiceUnavailable}
+		},
+	}
+	c := geo.NewGeoCodeCache(m, 7, 0, 0)
+	for i := 0; i < 2; i++ {
+		if _, err := c.GeoCode(ctx, delhi.Lat, delhi.Lon); err == nil {
+			t.Error("GeoCode() error = nil, want the API error")
+		}
+	}
+	if calls := len(m.CallsTo("GeoCode")); calls != 2 {
+		t.Errorf("GeoCode called %d times, want errors not cached", calls)
+	}
+}
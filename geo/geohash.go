@@ -0,0 +1,99 @@
+package geo
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// base32 is the geohash alphabet
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashPrecision is the longest geohash encoded
+const MaxGeohashPrecision = 12
+
+// Geohash returns the geohash of 'c' with 'precision' characters, from 1
+// to MaxGeohashPrecision. Cells of 7 characters are about 150m across.
+func Geohash(c Coordinates, precision int) string {
+	if precision < 1 {
+		precision = 1
+	}
+	if precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+
+	lat, lon := [2]float64{-90, 90}, [2]float64{-180, 180}
+	var b strings.Builder
+	bits, ch, even := 0, 0, true
+	for b.Len() < precision {
+		r, v := &lat, c.Lat
+		if even {
+			r, v = &lon, c.Lon
+		}
+		ch <<= 1
+		if mid := (r[0] + r[1]) / 2; v >= mid {
+			ch |= 1
+			r[0] = mid
+		} else {
+			r[1] = mid
+		}
+		even = !even
+
+		if bits++; bits == 5 {
+			b.WriteByte(base32[ch])
+			bits, ch = 0, 0
+		}
+	}
+	return b.String()
+}
+
+// GeohashBox returns the cell of geohash 'hash'.
+func GeohashBox(hash string) (BBox, error) {
+	if hash == "" {
+		return BBox{}, errors.New("geo: empty geohash")
+	}
+	lat, lon := [2]float64{-90, 90}, [2]float64{-180, 180}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := strings.IndexByte(base32, hash[i])
+		if ch < 0 {
+			return BBox{}, errors.Errorf("geo: invalid geohash %q", hash)
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &lat
+			if even {
+				r = &lon
+			}
+			if mid := (r[0] + r[1]) / 2; ch&(1<<uint(bit)) != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return BBox{Min: Coordinates{lat[0], lon[0]}, Max: Coordinates{lat[1], lon[1]}}, nil
+}
+
+// GeohashNeighbours returns the geohashes of the up to 8 cells around
+// 'hash', fewer next to the poles.
+func GeohashNeighbours(hash string) ([]string, error) {
+	b, err := GeohashBox(hash)
+	if err != nil {
+		return nil, err
+	}
+	c := b.Center()
+	dLat, dLon := b.Max.Lat-b.Min.Lat, b.Max.Lon-b.Min.Lon
+
+	var ns []string
+	for _, i := range []float64{1, 0, -1} {
+		for _, j := range []float64{-1, 0, 1} {
+			lat := c.Lat + i*dLat
+			if i == 0 && j == 0 || lat < -90 || lat > 90 {
+				continue
+			}
+			ns = append(ns, Geohash(Coordinates{lat, wrap(c.Lon + j*dLon)}, len(hash)))
+		}
+	}
+	return ns, nil
+}
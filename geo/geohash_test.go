@@ -0,0 +1,73 @@
+package geo_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-india/zomato/geo"
+)
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		c         geo.Coordinates
+		precision int
+		want      string
+	}{
+		{geo.Coordinates{Lat: 57.64911, Lon: 10.40744}, 11, "u4pruydqqvj"},
+		{geo.Coordinates{Lat: 42.6, Lon: -5.6}, 5, "ezs42"},
+		{delhi, 7, "ttnfucj"},
+		{delhi, 0, "t"},
+		{delhi, 20, "ttnfucjbh1e4"},
+		{geo.Coordinates{Lat: -90, Lon: -180}, 3, "000"},
+		{geo.Coordinates{Lat: 90, Lon: 180}, 3, "zzz"},
+	}
+
+	for _, tt := range tests {
+		got := geo.Geohash(tt.c, tt.precision)
+		if got != tt.want {
+			t.Errorf("Geohash(%+v, %d) = %q, want %q", tt.c, tt.precision, got, tt.want)
+		}
+		b, err := geo.GeohashBox(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !b.Contains(tt.c) {
+			t.Errorf("GeohashBox(%q) = %+v, not containing %+v", got, b, tt.c)
+		}
+	}
+}
+
+func TestGeohashBoxErrors(t *testing.T) {
+	for _, hash := range []string{"", "ttna", "TTN"} {
+		if _, err := geo.GeohashBox(hash); err == nil {
+			t.Errorf("GeohashBox(%q) error = nil", hash)
+		}
+	}
+}
+
+func TestGeohashNeighbours(t *testing.T) {
+	tests := []struct {
+		hash string
+		want []string
+	}{
+		// The cells around "ezs42"
+		{"ezs42", []string{"ezs40", "ezs41", "ezs43", "ezs48", "ezs49", "ezefp", "ezefr", "ezefx"}},
+		// Across the antimeridian
+		{"8", []string{"2", "3", "9", "b", "c", "r", "x", "z"}},
+		// Next to the pole
+		{"b", []string{"8", "9", "c", "x", "z"}},
+	}
+
+	for _, tt := range tests {
+		got, err := geo.GeohashNeighbours(tt.hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(got)
+		sort.Strings(tt.want)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GeohashNeighbours(%q) = %q, want %q", tt.hash, got, tt.want)
+		}
+	}
+}
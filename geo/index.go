@@ -0,0 +1,186 @@
+package geo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-india/zomato"
+)
+
+// Neighbour is a restaurant found near a point
+type Neighbour struct {
+	Restaurant zomato.Restaurant
+	// Distance from the point
+	Distance float64
+}
+
+// Index is a k-d tree of restaurants answering radius and nearest
+// neighbour queries. Restaurants are placed on the unit sphere, so queries
+// near the poles and across the antimeridian are exact.
+//
+// An Index is immutable and safe for use by multiple go routines.
+type Index struct {
+	// points form an implicit tree: the median of each range, by the
+	// axis of its depth, is the root of the range
+	points []point
+}
+
+// point is an indexed restaurant
+type point struct {
+	xyz [3]float64
+	c   Coordinates
+	r   zomato.Restaurant
+}
+
+// NewIndex returns an index of 'rs', skipping restaurants without
+// coordinates.
+func NewIndex(rs []zomato.Restaurant) *Index {
+	ix := &Index{}
+	for _, r := range rs {
+		if c, ok := Of(r); ok {
+			ix.points = append(ix.points, point{xyz: unit(c), c: c, r: r})
+		}
+	}
+	build(ix.points, 0)
+	return ix
+}
+
+// IndexSearch returns an index of the restaurants of a search.
+func IndexSearch(resp zomato.SearchResp) *Index {
+	var rs []zomato.Restaurant
+	for _, r := range resp.Restaurants {
+		if r.Restaurant != nil {
+			rs = append(rs, *r.Restaurant)
+		}
+	}
+	return NewIndex(rs)
+}
+
+// IndexGeoCode returns an index of the nearby restaurants of a geocode.
+func IndexGeoCode(resp zomato.GeoCodeResp) *Index {
+	var rs []zomato.Restaurant
+	for _, r := range resp.NearbyRestaurants {
+		if r.Restaurant != nil {
+			rs = append(rs, *r.Restaurant)
+		}
+	}
+	return NewIndex(rs)
+}
+
+// Len returns the number of restaurants indexed.
+func (ix *Index) Len() int {
+	return len(ix.points)
+}
+
+// Within returns the restaurants within 'radius' of 'c', nearest first.
+func (ix *Index) Within(c Coordinates, radius float64) []Neighbour {
+	q := unit(c)
+	// Chord length of the radius on the unit sphere
+	chord := 2 * math.Sin(math.Min(radius/EarthRadius, math.Pi)/2)
+
+	var ns []Neighbour
+	var visit func(pts []point, depth int)
+	visit = func(pts []point, depth int) {
+		if len(pts) == 0 {
+			return
+		}
+		mid, axis := len(pts)/2, depth%3
+		p := pts[mid]
+		if dist(q, p.xyz) <= chord {
+			ns = append(ns, Neighbour{p.r, Haversine(c, p.c)})
+		}
+		d := q[axis] - p.xyz[axis]
+		if d <= chord {
+			visit(pts[:mid], depth+1)
+		}
+		if d >= -chord {
+			visit(pts[mid+1:], depth+1)
+		}
+	}
+	visit(ix.points, 0)
+
+	sort.SliceStable(ns, func(i, j int) bool { return ns[i].Distance < ns[j].Distance })
+	return ns
+}
+
+// Nearest returns the 'k' restaurants nearest to 'c', nearest first.
+func (ix *Index) Nearest(c Coordinates, k int) []Neighbour {
+	if k <= 0 {
+		return nil
+	}
+	q := unit(c)
+
+	// best holds the nearest points found, by chord distance
+	type candidate struct {
+		p    *point
+		dist float64
+	}
+	var best []candidate
+	worst := func() float64 {
+		if len(best) < k {
+			return math.Inf(1)
+		}
+		return best[len(best)-1].dist
+	}
+
+	var visit func(pts []point, depth int)
+	visit = func(pts []point, depth int) {
+		if len(pts) == 0 {
+			return
+		}
+		mid, axis := len(pts)/2, depth%3
+		p := &pts[mid]
+		if d := dist(q, p.xyz); d < worst() {
+			i := sort.Search(len(best), func(i int) bool { return best[i].dist > d })
+			best = append(best, candidate{})
+			copy(best[i+1:], best[i:])
+			best[i] = candidate{p, d}
+			if len(best) > k {
+				best = best[:k]
+			}
+		}
+
+		near, far := pts[:mid], pts[mid+1:]
+		d := q[axis] - p.xyz[axis]
+		if d > 0 {
+			near, far = far, near
+		}
+		visit(near, depth+1)
+		if math.Abs(d) < worst() {
+			visit(far, depth+1)
+		}
+	}
+	visit(ix.points, 0)
+
+	ns := make([]Neighbour, len(best))
+	for i, b := range best {
+		ns[i] = Neighbour{b.p.r, Haversine(c, b.p.c)}
+	}
+	return ns
+}
+
+// build arranges 'pts' as a tree rooted at their median by the axis of
+// 'depth'.
+func build(pts []point, depth int) {
+	if len(pts) <= 1 {
+		return
+	}
+	axis := depth % 3
+	sort.Slice(pts, func(i, j int) bool { return pts[i].xyz[axis] < pts[j].xyz[axis] })
+	mid := len(pts) / 2
+	build(pts[:mid], depth+1)
+	build(pts[mid+1:], depth+1)
+}
+
+// unit returns the point of 'c' on the unit sphere
+func unit(c Coordinates) [3]float64 {
+	sinLat, cosLat := math.Sincos(rad(c.Lat))
+	sinLon, cosLon := math.Sincos(rad(c.Lon))
+	return [3]float64{cosLat * cosLon, cosLat * sinLon, sinLat}
+}
+
+// dist returns the straight line distance between 'a' and 'b'
+func dist(a, b [3]float64) float64 {
+	x, y, z := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return math.Sqrt(x*x + y*y + z*z)
+}
@@ -0,0 +1,133 @@
+package geo_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+)
+
+// scattered returns 'n' restaurants scattered around 'c' and the globe
+func scattered(n int, c geo.Coordinates) []zomato.Restaurant {
+	rnd := rand.New(rand.NewSource(1))
+	var rs []zomato.Restaurant
+	for i := 0; i < n; i++ {
+		p := geo.Coordinates{Lat: c.Lat + rnd.NormFloat64()*0.2, Lon: c.Lon + rnd.NormFloat64()*0.2}
+		if i%5 == 0 {
+			p = geo.Coordinates{Lat: rnd.Float64()*180 - 90, Lon: rnd.Float64()*360 - 180}
+		}
+		if p.Lon >= 180 {
+			p.Lon -= 360
+		}
+		rs = append(rs, restaurant(int64(i), p))
+	}
+	return rs
+}
+
+// byDistance returns the ids of 'rs' nearest 'c' first, with distances
+func byDistance(rs []zomato.Restaurant, c geo.Coordinates) ([]int64, []float64) {
+	type near struct {
+		id int64
+		d  float64
+	}
+	var ns []near
+	for _, r := range rs {
+		rc, _ := geo.Of(r)
+		ns = append(ns, near{*r.ID, geo.Haversine(c, rc)})
+	}
+	sort.SliceStable(ns, func(i, j int) bool { return ns[i].d < ns[j].d })
+	ids, ds := make([]int64, len(ns)), make([]float64, len(ns))
+	for i, n := range ns {
+		ids[i], ds[i] = n.id, n.d
+	}
+	return ids, ds
+}
+
+func neighbourIDs(ns []geo.Neighbour) []int64 {
+	ids := []int64{}
+	for _, n := range ns {
+		ids = append(ids, *n.Restaurant.ID)
+	}
+	return ids
+}
+
+func TestIndex(t *testing.T) {
+	queries := []geo.Coordinates{
+		delhi,
+		{Lat: 28.8, Lon: 77.0},
+		{Lat: 0, Lon: 179.99},
+		{Lat: -89.9, Lon: 10},
+		{Lat: 60, Lon: -100},
+	}
+
+	for _, center := range []geo.Coordinates{delhi, {Lat: 0, Lon: 179.9}} {
+		rs := scattered(600, center)
+		ix := geo.NewIndex(append(rs, zomato.Restaurant{}))
+		if ix.Len() != len(rs) {
+			t.Errorf("Len() = %d, want %d", ix.Len(), len(rs))
+		}
+
+		for _, q := range append(queries, center) {
+			ids, ds := byDistance(rs, q)
+
+			for _, k := range []int{1, 7, 50} {
+				if got := neighbourIDs(ix.Nearest(q, k)); !reflect.DeepEqual(got, ids[:k]) {
+					t.Errorf("Nearest(%+v, %d) = %v, want %v", q, k, got, ids[:k])
+				}
+			}
+
+			for _, radius := range []float64{500, 10e3, 50e3, 2000e3} {
+				n := sort.SearchFloat64s(ds, radius+1e-6)
+				if got := neighbourIDs(ix.Within(q, radius)); !reflect.DeepEqual(got, ids[:n]) {
+					t.Errorf("Within(%+v, %.0f) = %v, want %v", q, radius, got, ids[:n])
+				}
+			}
+		}
+	}
+}
+
+func TestIndexEmpty(t *testing.T) {
+	ix := geo.NewIndex(nil)
+	if ns := ix.Nearest(delhi, 3); len(ns) != 0 {
+		t.Errorf("Nearest() of an empty index = %v", ns)
+	}
+	if ns := ix.Within(delhi, 1e3); len(ns) != 0 {
+		t.Errorf("Within() of an empty index = %v", ns)
+	}
+	if ns := geo.NewIndex(scattered(10, delhi)).Nearest(delhi, 0); ns != nil {
+		t.Errorf("Nearest(0) = %v, want nil", ns)
+	}
+}
+
+func TestIndexGeoCode(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("../testdata", "GeoCode.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp zomato.GeoCodeResp
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	ix := geo.IndexGeoCode(resp)
+	if ix.Len() != len(resp.NearbyRestaurants) {
+		t.Errorf("Len() = %d, want %d", ix.Len(), len(resp.NearbyRestaurants))
+	}
+	at, ok := geo.OfLocation(*resp.Location)
+	if !ok {
+		t.Fatal("geocode location without coordinates")
+	}
+	ns := ix.Nearest(at, 3)
+	if len(ns) != 3 || ns[0].Distance > ns[1].Distance || ns[1].Distance > ns[2].Distance {
+		t.Errorf("Nearest() = %v, want 3 nearest first", ns)
+	}
+	if ix := geo.IndexSearch(zomato.SearchResp{}); ix.Len() != 0 {
+		t.Errorf("IndexSearch() of no results Len() = %d", ix.Len())
+	}
+}
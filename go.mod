@@ -0,0 +1,15 @@
+module github.com/go-india/zomato
+
+go 1.18
+
+require (
+	github.com/google/go-querystring v1.1.0
+	github.com/pkg/errors v0.9.1
+	gopkg.in/go-playground/validator.v9 v9.31.0
+)
+
+require (
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/leodido/go-urn v1.2.4 // indirect
+)
@@ -0,0 +1,19 @@
+module github.com/go-india/zomato/gql
+
+go 1.25.0
+
+require (
+	github.com/go-india/zomato v0.0.0
+	github.com/graph-gophers/graphql-go v1.10.3
+	github.com/pkg/errors v0.9.1
+)
+
+require (
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/leodido/go-urn v1.2.4 // indirect
+	gopkg.in/go-playground/validator.v9 v9.31.0 // indirect
+)
+
+replace github.com/go-india/zomato => ..
@@ -0,0 +1,94 @@
+// Package gql serves the Zomato API as GraphQL.
+//
+// The schema mirrors Restaurant, Review, DailyMenu, Event, Location and
+// Collection, so a restaurant, its reviews, daily menu and nearby places
+// come in one round trip:
+//
+//	{
+//	  geocode(lat: 28.7, lon: 77.1) {
+//	    location { title }
+//	    nearbyRestaurants { name rating { aggregateRating } reviews(count: 3) { text } }
+//	  }
+//	}
+//
+// Resolvers call the API through a loader made for each GraphQL request.
+// It dedupes identical calls, in flight or done, and runs the calls of
+// nested fields in parallel batches, so a restaurant found by several
+// fields has its reviews fetched once.
+//
+//	http.Handle("/graphql", gql.New(client))
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-india/zomato"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// DefaultMaxCalls is the default number of parallel API calls of a request
+const DefaultMaxCalls = 4
+
+// Server is an http.Handler serving GraphQL queries over an API
+type Server struct {
+	// MaxCalls limits the parallel API calls of a request; defaults to
+	// DefaultMaxCalls
+	MaxCalls int
+
+	api    zomato.API
+	schema *graphql.Schema
+}
+
+// New returns a server resolving queries with 'api'.
+func New(api zomato.API) *Server {
+	s := &Server{api: api}
+	s.schema = graphql.MustParseSchema(Schema, &query{}, graphql.UseStringDescriptions())
+	return s
+}
+
+// Exec runs a GraphQL query with 'variables'; 'operationName' selects the
+// operation of documents with several.
+func (s *Server) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Response {
+	l := newLoader(s.MaxCalls)
+	ctx = context.WithValue(ctx, apiKey{}, zomato.Intercept(s.api, l.Intercept))
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
+
+// ServeHTTP implements http.Handler, answering GraphQL POST requests with
+// a JSON body holding "query", "operationName" and "variables".
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := s.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// apiKey is the context key of the API of a request
+type apiKey struct{}
+
+// apiFrom returns the API of the request of 'ctx'.
+func apiFrom(ctx context.Context) zomato.API {
+	return ctx.Value(apiKey{}).(zomato.API)
+}
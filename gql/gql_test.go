@@ -0,0 +1,194 @@
+package gql_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/gql"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+var ctx = context.Background()
+
+// mock serves the testdata
+func mock(t *testing.T) *zomatotest.Mock {
+	var (
+		restaurant zomato.Restaurant
+		reviews    zomato.ReviewsResp
+		menus      zomato.DailyMenuResp
+		geocode    zomato.GeoCodeResp
+		search     zomato.SearchResp
+	)
+	testfixture.Decode(t, "Restaurant.json", &restaurant)
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	testfixture.Decode(t, "DailyMenu.json", &menus)
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+	testfixture.Decode(t, "Search.json", &search)
+
+	return &zomatotest.Mock{
+		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+			return restaurant, nil
+		},
+		ReviewsFunc: func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+			return reviews, nil
+		},
+		DailyMenuFunc: func(ctx context.Context, restaurantID int64) (zomato.DailyMenuResp, error) {
+			if restaurantID != 463 {
+				return zomato.DailyMenuResp{}, &zomato.ErrAPI{StatusCode: http.StatusBadRequest}
+			}
+			return menus, nil
+		},
+		GeoCodeFunc: func(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+			return geocode, nil
+		},
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			return search, nil
+		},
+	}
+}
+
+// exec runs 'query' and returns its data as JSON
+func exec(t *testing.T, s *gql.Server, query string) string {
+	resp := s.Exec(ctx, query, "", nil)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Exec(%q) errors: %v", query, resp.Errors)
+	}
+	return string(resp.Data)
+}
+
+func TestExec(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+		calls map[string]int
+	}{
+		{
+			name: "restaurant",
+			query: `{ restaurant(id: "463") {
+				name cuisines rating { aggregateRating }
+				reviews(count: 5) { id user { name } }
+				dailyMenus { id dishes { name } }
+			} }`,
+			want: []string{
+				`"name":"Karim's"`, `"aggregateRating":3.7`,
+				`{"id":"34508218","user":{"name":"Lustyfood"}}`, `"id":"19610530"`,
+			},
+			calls: map[string]int{"Restaurant": 1, "Reviews": 1, "DailyMenu": 1},
+		},
+		{
+			name: "duplicate fields",
+			query: `{
+				a: restaurant(id: "463") { reviews(count: 5) { id } dailyMenus { id } }
+				b: restaurant(id: "463") { reviews(count: 5) { text } dailyMenus { name } }
+			}`,
+			want:  []string{`"a":{"reviews":[{"id":"34508218"}`},
+			calls: map[string]int{"Restaurant": 1, "Reviews": 1, "DailyMenu": 1},
+		},
+		{
+			name:  "geocode",
+			query: `{ geocode(lat: 28.7, lon: 77.1) { location { title entityId } nearbyRestaurants { id } } }`,
+			want:  []string{`"location":{"title":"Rohini","entityId":289}`, `{"id":"1806"}`},
+			calls: map[string]int{"GeoCode": 1},
+		},
+		{
+			name:  "no daily menu",
+			query: `{ search(entityId: 1, entityType: "city", count: 20) { resultsShown restaurants { id dailyMenus { id } } } }`,
+			want:  []string{`{"id":"9166","dailyMenus":[]}`},
+			calls: map[string]int{"Search": 1, "DailyMenu": 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock(t)
+			got := exec(t, gql.New(m), tt.query)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("data = %s, want %s", got, want)
+				}
+			}
+			for method, n := range tt.calls {
+				if calls := m.CallsTo(method); len(calls) != n {
+					t.Errorf("%s called %d times, want %d", method, len(calls), n)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxCalls(t *testing.T) {
+	const maxCalls = 2
+	var (
+		mu            sync.Mutex
+		running, peak int
+		m             = mock(t)
+		reviews       = m.ReviewsFunc
+	)
+	m.ReviewsFunc = func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		return reviews(ctx, req)
+	}
+	server := gql.New(m)
+	server.MaxCalls = maxCalls
+
+	exec(t, server, `{ search(count: 20) { restaurants { reviews { id } } } }`)
+	if n := len(m.CallsTo("Reviews")); n != 20 {
+		t.Errorf("Reviews called %d times, want 20", n)
+	}
+	if peak > maxCalls {
+		t.Errorf("%d parallel calls, want at most %d", peak, maxCalls)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	srv := httptest.NewServer(gql.New(mock(t)))
+	defer srv.Close()
+
+	tests := []struct {
+		method string
+		body   string
+		status int
+		want   string
+	}{
+		{http.MethodPost, `{"query":"query R($id: ID!) { restaurant(id: $id) { name } }","variables":{"id":"463"}}`,
+			http.StatusOK, `{"data":{"restaurant":{"name":"Karim's"}}}`},
+		{http.MethodPost, `{"query":"{ restaurant(id: \"x\") { name } }"}`,
+			http.StatusOK, `invalid restaurant id`},
+		{http.MethodPost, `{`, http.StatusBadRequest, "invalid request body"},
+		{http.MethodGet, "", http.StatusMethodNotAllowed, "method not allowed"},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, srv.URL, strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status || !strings.Contains(string(body), tt.want) {
+			t.Errorf("%s %s = %d %s, want %d %s", tt.method, tt.body, resp.StatusCode, body, tt.status, tt.want)
+		}
+	}
+}
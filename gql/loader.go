@@ -0,0 +1,72 @@
+package gql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-india/zomato"
+)
+
+// loader dedupes the API calls of a GraphQL request and runs them in
+// parallel batches of at most cap(sem) calls.
+//
+// Results are kept for the request, so the same data is fetched once
+// however many fields need it.
+type loader struct {
+	sem chan struct{}
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// call is an API call made for a request
+type call struct {
+	done chan struct{}
+	resp interface{}
+	err  error
+}
+
+func newLoader(maxCalls int) *loader {
+	if maxCalls <= 0 {
+		maxCalls = DefaultMaxCalls
+	}
+	return &loader{sem: make(chan struct{}, maxCalls), calls: make(map[string]*call)}
+}
+
+// Intercept implements zomato.Interceptor, answering calls from the
+// results of identical calls made before or in flight.
+func (l *loader) Intercept(ctx context.Context, method string, req zomato.Requester,
+	invoke func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	r, err := req.Request()
+	if err != nil {
+		return invoke(ctx)
+	}
+	key := method + " " + r.URL.String()
+
+	l.mu.Lock()
+	c, ok := l.calls[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		l.calls[key] = c
+	}
+	l.mu.Unlock()
+
+	if !ok {
+		select {
+		case l.sem <- struct{}{}:
+			c.resp, c.err = invoke(ctx)
+			<-l.sem
+		case <-ctx.Done():
+			c.err = ctx.Err()
+		}
+		close(c.done)
+		return c.resp, c.err
+	}
+
+	select {
+	case <-c.done:
+		return c.resp, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
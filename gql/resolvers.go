@@ -0,0 +1,411 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-india/zomato"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+)
+
+// search resolves the Search type
+type search struct{ resp zomato.SearchResp }
+
+func (s *search) ResultsFound() int32 { return int32(s.resp.ResultsFound) }
+func (s *search) ResultsStart() int32 { return int32(s.resp.ResultsStart) }
+func (s *search) ResultsShown() int32 { return int32(s.resp.ResultsShown) }
+
+func (s *search) Restaurants() []*restaurant {
+	rs := []*restaurant{}
+	for _, r := range s.resp.Restaurants {
+		if r.Restaurant != nil {
+			rs = append(rs, newRestaurant(*r.Restaurant))
+		}
+	}
+	return rs
+}
+
+// geoCode resolves the GeoCode type
+type geoCode struct{ resp zomato.GeoCodeResp }
+
+func (g *geoCode) Location() *location {
+	if g.resp.Location == nil {
+		return nil
+	}
+	return &location{*g.resp.Location}
+}
+
+func (g *geoCode) Popularity() *popularity {
+	if g.resp.Popularity == nil {
+		return nil
+	}
+	return &popularity{*g.resp.Popularity}
+}
+
+func (g *geoCode) Link() *string { return g.resp.LinkURL }
+
+func (g *geoCode) NearbyRestaurants() []*restaurant {
+	rs := []*restaurant{}
+	for _, r := range g.resp.NearbyRestaurants {
+		if r.Restaurant != nil {
+			rs = append(rs, newRestaurant(*r.Restaurant))
+		}
+	}
+	return rs
+}
+
+// popularity resolves the Popularity type
+type popularity struct{ p zomato.Popularity }
+
+func (p *popularity) Popularity() *float64     { return p.p.Popularity }
+func (p *popularity) NightlifeIndex() *float64 { return p.p.NightlifeIndex }
+func (p *popularity) TopCuisines() []string    { return nonNil(p.p.TopCuisines) }
+func (p *popularity) Subzone() *string         { return p.p.Subzone }
+func (p *popularity) City() *string            { return p.p.City }
+
+// restaurant resolves the Restaurant type
+type restaurant struct{ r zomato.Restaurant }
+
+func newRestaurant(r zomato.Restaurant) *restaurant { return &restaurant{r} }
+
+func (r *restaurant) ID() graphql.ID {
+	if r.r.ID == nil {
+		return ""
+	}
+	return graphql.ID(strconv.FormatInt(*r.r.ID, 10))
+}
+
+func (r *restaurant) Name() *string             { return r.r.Name }
+func (r *restaurant) URL() *string              { return r.r.URL }
+func (r *restaurant) Cuisines() []string        { return nonNil(r.r.Cuisines) }
+func (r *restaurant) AverageCostForTwo() *int32 { return int32p(r.r.AverageCostForTwo) }
+func (r *restaurant) Currency() *string         { return r.r.Currency }
+func (r *restaurant) Thumb() *string            { return r.r.ThumbnailURL }
+func (r *restaurant) FeaturedImage() *string    { return r.r.FeaturedImageURL }
+func (r *restaurant) MenuURL() *string          { return r.r.MenuURL }
+func (r *restaurant) PhotosURL() *string        { return r.r.PhotosURL }
+func (r *restaurant) Deeplink() *string         { return r.r.DeeplinkURL }
+func (r *restaurant) HasOnlineDelivery() bool {
+	return r.r.HasOnlineDelivery != nil && *r.r.HasOnlineDelivery
+}
+func (r *restaurant) IsDeliveringNow() bool {
+	return r.r.IsDeliveringNow != nil && *r.r.IsDeliveringNow
+}
+func (r *restaurant) HasTableBooking() bool {
+	return r.r.HasTableBooking != nil && *r.r.HasTableBooking
+}
+
+func (r *restaurant) PriceRange() *int32 {
+	if r.r.PriceRange == nil {
+		return nil
+	}
+	p := int32(*r.r.PriceRange)
+	return &p
+}
+
+func (r *restaurant) Location() *restaurantLocation {
+	if r.r.Location == nil {
+		return nil
+	}
+	return &restaurantLocation{*r.r.Location}
+}
+
+func (r *restaurant) Rating() *userRating {
+	if r.r.UserRating == nil {
+		return nil
+	}
+	return &userRating{*r.r.UserRating}
+}
+
+func (r *restaurant) Events() []*event {
+	es := []*event{}
+	for _, e := range r.r.ZomatoEvents {
+		if e.Event != nil {
+			es = append(es, &event{*e.Event})
+		}
+	}
+	return es
+}
+
+func (r *restaurant) Reviews(ctx context.Context, args struct{ Start, Count *int32 }) ([]*review, error) {
+	if r.r.ID == nil {
+		return nil, errors.New("restaurant without id")
+	}
+	resp, err := apiFrom(ctx).Reviews(ctx, zomato.ReviewsReq{
+		RestaurantID: *r.r.ID, Start: uint64(i32(args.Start)), Count: uint64(i32(args.Count)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rs := []*review{}
+	for _, rv := range resp.UserReviews {
+		if rv.Review != nil {
+			rs = append(rs, &review{*rv.Review})
+		}
+	}
+	return rs, nil
+}
+
+func (r *restaurant) DailyMenus(ctx context.Context) ([]*dailyMenu, error) {
+	if r.r.ID == nil {
+		return nil, errors.New("restaurant without id")
+	}
+	resp, err := apiFrom(ctx).DailyMenu(ctx, *r.r.ID)
+	if apiErr, ok := errors.Cause(err).(*zomato.ErrAPI); ok && apiErr.StatusCode == http.StatusBadRequest {
+		// The API rejects restaurants without daily menu
+		return []*dailyMenu{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	loc, err := r.r.TimeZone()
+	ms := []*dailyMenu{}
+	for _, m := range resp.DailyMenus {
+		if m.DailyMenu != nil {
+			d := &dailyMenu{*m.DailyMenu}
+			if err == nil {
+				d.m.In(loc)
+			}
+			ms = append(ms, d)
+		}
+	}
+	return ms, nil
+}
+
+// restaurantLocation resolves the RestaurantLocation type
+type restaurantLocation struct{ l zomato.RestaurantLocation }
+
+func (l *restaurantLocation) Address() *string         { return l.l.Address }
+func (l *restaurantLocation) Locality() *string        { return l.l.Locality }
+func (l *restaurantLocation) LocalityVerbose() *string { return l.l.LocalityVerbose }
+func (l *restaurantLocation) City() *string            { return l.l.City }
+func (l *restaurantLocation) CityID() *int32           { return int32p(l.l.CityID) }
+func (l *restaurantLocation) Latitude() *float64       { return l.l.Latitude }
+func (l *restaurantLocation) Longitude() *float64      { return l.l.Longitude }
+
+func (l *restaurantLocation) Zipcode() *string {
+	if l.l.Zipcode == nil {
+		return nil
+	}
+	z := string(*l.l.Zipcode)
+	return &z
+}
+
+func (l *restaurantLocation) PostalCode() *string {
+	code, ok := l.l.PostalCode()
+	if !ok {
+		return nil
+	}
+	return &code
+}
+
+// userRating resolves the UserRating type
+type userRating struct{ r zomato.UserRating }
+
+func (r *userRating) AggregateRating() *float64 { return r.r.AggregateRating }
+func (r *userRating) RatingText() *string       { return r.r.RatingText }
+func (r *userRating) RatingColor() *string      { return r.r.RatingColor }
+func (r *userRating) Votes() *int32             { return int32p(r.r.Votes) }
+
+// review resolves the Review type
+type review struct{ r zomato.Review }
+
+func (r *review) ID() graphql.ID {
+	if r.r.ID == nil {
+		return ""
+	}
+	return graphql.ID(strconv.FormatInt(*r.r.ID, 10))
+}
+
+func (r *review) Rating() *float64      { return r.r.Rating }
+func (r *review) Text() *string         { return r.r.ReviewText }
+func (r *review) RatingText() *string   { return r.r.RatingText }
+func (r *review) Time() *string         { return rfc3339(r.r.Timestamp) }
+func (r *review) FriendlyTime() *string { return r.r.ReviewTimeFriendly }
+func (r *review) Likes() *int32         { return int32p(r.r.Likes) }
+func (r *review) CommentsCount() *int32 { return int32p(r.r.CommentsCount) }
+
+func (r *review) User() *user {
+	if r.r.User == nil {
+		return nil
+	}
+	return &user{*r.r.User}
+}
+
+// user resolves the User type
+type user struct{ u zomato.User }
+
+func (u *user) Name() *string         { return u.u.Name }
+func (u *user) Handle() *string       { return u.u.ZomatoHandle }
+func (u *user) FoodieLevel() *string  { return u.u.FoodieLevel }
+func (u *user) ProfileURL() *string   { return u.u.ProfileURL }
+func (u *user) ProfileImage() *string { return u.u.ProfileImageURL }
+
+// dailyMenu resolves the DailyMenu type
+type dailyMenu struct{ m zomato.DailyMenu }
+
+func (m *dailyMenu) ID() graphql.ID {
+	if m.m.ID == nil {
+		return ""
+	}
+	return graphql.ID(strconv.FormatInt(*m.m.ID, 10))
+}
+
+func (m *dailyMenu) Name() *string      { return m.m.Name }
+func (m *dailyMenu) StartDate() *string { return rfc3339(m.m.StartDate) }
+func (m *dailyMenu) EndDate() *string   { return rfc3339(m.m.EndDate) }
+
+func (m *dailyMenu) Dishes() []*dish {
+	ds := []*dish{}
+	for _, d := range m.m.Dishes {
+		if d.Dish != nil {
+			ds = append(ds, &dish{*d.Dish})
+		}
+	}
+	return ds
+}
+
+// dish resolves the Dish type
+type dish struct{ d zomato.Dish }
+
+func (d *dish) ID() *graphql.ID {
+	if d.d.ID == nil {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(*d.d.ID, 10))
+	return &id
+}
+
+func (d *dish) Name() *string  { return d.d.Name }
+func (d *dish) Price() *string { return d.d.Price }
+
+// event resolves the Event type
+type event struct{ e zomato.Event }
+
+func (e *event) ID() graphql.ID {
+	if e.e.ID == nil {
+		return ""
+	}
+	return graphql.ID(strconv.FormatInt(*e.e.ID, 10))
+}
+
+func (e *event) Title() *string       { return e.e.Title }
+func (e *event) Description() *string { return e.e.Description }
+func (e *event) StartDate() *string   { return rfc3339(e.e.StartDate) }
+func (e *event) EndDate() *string     { return rfc3339(e.e.EndDate) }
+func (e *event) DisplayDate() *string { return e.e.DisplayDate }
+func (e *event) DisplayTime() *string { return e.e.DisplayTime }
+func (e *event) ShareURL() *string    { return e.e.ShareURL }
+
+// location resolves the Location type
+type location struct{ l zomato.Location }
+
+func (l *location) EntityType() *string  { return l.l.EntityType }
+func (l *location) EntityID() *int32     { return int32p(l.l.EntityID) }
+func (l *location) Title() *string       { return l.l.Title }
+func (l *location) Latitude() *float64   { return l.l.Latitude }
+func (l *location) Longitude() *float64  { return l.l.Longitude }
+func (l *location) CityID() *int32       { return int32p(l.l.CityID) }
+func (l *location) CityName() *string    { return l.l.CityName }
+func (l *location) CountryID() *int32    { return int32p(l.l.CountryID) }
+func (l *location) CountryName() *string { return l.l.CountryName }
+
+func (l *location) Restaurants(ctx context.Context, args struct{ Count *int32 }) ([]*restaurant, error) {
+	if l.l.EntityID == nil || l.l.EntityType == nil {
+		return []*restaurant{}, nil
+	}
+	resp, err := apiFrom(ctx).Search(ctx, zomato.SearchReq{
+		EntityID: *l.l.EntityID, EntityType: zomato.EntityType(*l.l.EntityType), Count: uint64(i32(args.Count)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return (&search{resp}).Restaurants(), nil
+}
+
+// collection resolves the Collection type
+type collection struct {
+	c zomato.Collection
+	// req is the request listing the collection
+	req zomato.CollectionsReq
+}
+
+func (c *collection) ID() graphql.ID {
+	if c.c.ID == nil {
+		return ""
+	}
+	return graphql.ID(strconv.FormatInt(*c.c.ID, 10))
+}
+
+func (c *collection) Title() *string           { return c.c.Title }
+func (c *collection) Description() *string     { return c.c.Description }
+func (c *collection) URL() *string             { return c.c.URL }
+func (c *collection) ImageURL() *string        { return c.c.ImageURL }
+func (c *collection) ShareURL() *string        { return c.c.ShareURL }
+func (c *collection) RestaurantsCount() *int32 { return int32p(c.c.RestaurantsCount) }
+
+func (c *collection) Restaurants(ctx context.Context, args struct{ Count *int32 }) ([]*restaurant, error) {
+	if c.c.ID == nil {
+		return []*restaurant{}, nil
+	}
+	req := zomato.SearchReq{
+		Collection: strconv.FormatInt(*c.c.ID, 10),
+		Latitude:   c.req.Latitude, Longitude: c.req.Longitude,
+		Count: uint64(i32(args.Count)),
+	}
+	if c.req.CityID != 0 {
+		req.EntityID, req.EntityType = c.req.CityID, zomato.CityEntity
+	}
+	resp, err := apiFrom(ctx).Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return (&search{resp}).Restaurants(), nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func int32p(i *int64) *int32 {
+	if i == nil {
+		return nil
+	}
+	v := int32(*i)
+	return &v
+}
+
+func rfc3339(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func i32(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func f64(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
@@ -0,0 +1,277 @@
+package gql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-india/zomato"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+)
+
+// Schema is the GraphQL schema served
+const Schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	"Restaurant details"
+	restaurant(id: ID!): Restaurant
+	"Restaurants matching a search"
+	search(
+		query: String, entityId: Int, entityType: String,
+		lat: Float, lon: Float, radius: Float,
+		cuisines: [String!], establishment: String, collection: String, category: String,
+		sort: String, order: String, start: Int, count: Int
+	): Search!
+	"Location and nearby restaurants of a point"
+	geocode(lat: Float!, lon: Float!): GeoCode!
+	"Locations matching a name"
+	locations(query: String!, lat: Float, lon: Float, count: Int): [Location!]!
+	"Collections of a city"
+	collections(cityId: Int, lat: Float, lon: Float, count: Int): [Collection!]!
+}
+
+type Search {
+	resultsFound: Int!
+	resultsStart: Int!
+	resultsShown: Int!
+	restaurants: [Restaurant!]!
+}
+
+type GeoCode {
+	location: Location
+	popularity: Popularity
+	link: String
+	nearbyRestaurants: [Restaurant!]!
+}
+
+type Popularity {
+	popularity: Float
+	nightlifeIndex: Float
+	topCuisines: [String!]!
+	subzone: String
+	city: String
+}
+
+type Restaurant {
+	id: ID!
+	name: String
+	url: String
+	location: RestaurantLocation
+	cuisines: [String!]!
+	averageCostForTwo: Int
+	priceRange: Int
+	currency: String
+	rating: UserRating
+	thumb: String
+	featuredImage: String
+	menuUrl: String
+	photosUrl: String
+	deeplink: String
+	hasOnlineDelivery: Boolean!
+	isDeliveringNow: Boolean!
+	hasTableBooking: Boolean!
+	events: [Event!]!
+	reviews(start: Int, count: Int): [Review!]!
+	dailyMenus: [DailyMenu!]!
+}
+
+type RestaurantLocation {
+	address: String
+	locality: String
+	localityVerbose: String
+	city: String
+	cityId: Int
+	latitude: Float
+	longitude: Float
+	zipcode: String
+	postalCode: String
+}
+
+type UserRating {
+	aggregateRating: Float
+	ratingText: String
+	ratingColor: String
+	votes: Int
+}
+
+type Review {
+	id: ID!
+	rating: Float
+	text: String
+	ratingText: String
+	"RFC 3339 time of the review"
+	time: String
+	friendlyTime: String
+	likes: Int
+	commentsCount: Int
+	user: User
+}
+
+type User {
+	name: String
+	handle: String
+	foodieLevel: String
+	profileUrl: String
+	profileImage: String
+}
+
+type DailyMenu {
+	id: ID!
+	name: String
+	"RFC 3339 start and end times"
+	startDate: String
+	endDate: String
+	dishes: [Dish!]!
+}
+
+type Dish {
+	id: ID
+	name: String
+	price: String
+}
+
+type Event {
+	id: ID!
+	title: String
+	description: String
+	"RFC 3339 start and end dates"
+	startDate: String
+	endDate: String
+	displayDate: String
+	displayTime: String
+	shareUrl: String
+}
+
+type Location {
+	entityType: String
+	entityId: Int
+	title: String
+	latitude: Float
+	longitude: Float
+	cityId: Int
+	cityName: String
+	countryId: Int
+	countryName: String
+	"Restaurants of the location"
+	restaurants(count: Int): [Restaurant!]!
+}
+
+type Collection {
+	id: ID!
+	title: String
+	description: String
+	url: String
+	imageUrl: String
+	shareUrl: String
+	restaurantsCount: Int
+	"Restaurants of the collection"
+	restaurants(count: Int): [Restaurant!]!
+}
+`
+
+// query resolves the Query type
+type query struct{}
+
+func (query) Restaurant(ctx context.Context, args struct{ ID graphql.ID }) (*restaurant, error) {
+	id, err := strconv.ParseInt(string(args.ID), 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("invalid restaurant id %q", args.ID)
+	}
+	r, err := apiFrom(ctx).Restaurant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newRestaurant(r), nil
+}
+
+// searchArgs are the arguments of Query.search
+type searchArgs struct {
+	Query         *string
+	EntityID      *int32
+	EntityType    *string
+	Lat, Lon      *float64
+	Radius        *float64
+	Cuisines      *[]string
+	Establishment *string
+	Collection    *string
+	Category      *string
+	Sort, Order   *string
+	Start, Count  *int32
+}
+
+func (query) Search(ctx context.Context, args searchArgs) (*search, error) {
+	req := zomato.SearchReq{
+		Query:         str(args.Query),
+		EntityID:      int64(i32(args.EntityID)),
+		EntityType:    zomato.EntityType(str(args.EntityType)),
+		Latitude:      f64(args.Lat),
+		Longitude:     f64(args.Lon),
+		Radius:        f64(args.Radius),
+		Establishment: str(args.Establishment),
+		Collection:    str(args.Collection),
+		Category:      str(args.Category),
+		Sort:          zomato.Sort(str(args.Sort)),
+		Order:         zomato.Order(str(args.Order)),
+		Start:         uint64(i32(args.Start)),
+		Count:         uint64(i32(args.Count)),
+	}
+	if args.Cuisines != nil {
+		req.Cuisines = *args.Cuisines
+	}
+	resp, err := apiFrom(ctx).Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &search{resp}, nil
+}
+
+func (query) Geocode(ctx context.Context, args struct{ Lat, Lon float64 }) (*geoCode, error) {
+	resp, err := apiFrom(ctx).GeoCode(ctx, args.Lat, args.Lon)
+	if err != nil {
+		return nil, err
+	}
+	return &geoCode{resp}, nil
+}
+
+func (query) Locations(ctx context.Context, args struct {
+	Query    string
+	Lat, Lon *float64
+	Count    *int32
+}) ([]*location, error) {
+	resp, err := apiFrom(ctx).Locations(ctx, zomato.LocationsReq{
+		Query: args.Query, Latitude: f64(args.Lat), Longitude: f64(args.Lon), Count: uint64(i32(args.Count)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ls := []*location{}
+	for _, l := range resp.LocationSuggestions {
+		l := l
+		ls = append(ls, &location{l})
+	}
+	return ls, nil
+}
+
+func (query) Collections(ctx context.Context, args struct {
+	CityID   *int32
+	Lat, Lon *float64
+	Count    *int32
+}) ([]*collection, error) {
+	req := zomato.CollectionsReq{
+		CityID: int64(i32(args.CityID)), Latitude: f64(args.Lat), Longitude: f64(args.Lon), Count: uint64(i32(args.Count)),
+	}
+	resp, err := apiFrom(ctx).Collections(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cs := []*collection{}
+	for _, c := range resp.Collections {
+		if c.Collection != nil {
+			cs = append(cs, &collection{c: *c.Collection, req: req})
+		}
+	}
+	return cs, nil
+}
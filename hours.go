@@ -0,0 +1,404 @@
+package zomato
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoTimings is returned for restaurants without timings
+var ErrNoTimings = errors.New("zomato: no timings")
+
+// minutesPerDay is the number of minutes of a day
+const minutesPerDay = 24 * 60
+
+// OpeningHours is the weekly schedule of a restaurant, parsed from timings
+// such as "12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)".
+type OpeningHours struct {
+	// Days are the opening hours of each day, by time.Weekday, in order;
+	// closed days have none
+	Days [7][]TimeRange
+}
+
+// TimeRange is a range of opening hours, from Open to Close minutes after
+// the midnight starting the day. Close is after Open and at most a day
+// later; ranges closing after minutesPerDay end the next day.
+type TimeRange struct {
+	Open, Close int
+}
+
+// weekdays are the days of the week, as they are listed, from Monday
+var weekdays = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+var (
+	// rangeSep separates the opening and closing times of a range
+	rangeSep = regexp.MustCompile(`\s+to\s+|\s*[-–—]\s*`)
+	// clockTime matches times such as "7 PM", "3:30 pm", "12 Noon" or
+	// "19:00"
+	clockTime = regexp.MustCompile(`^(?:(\d{1,2})(?:[:.](\d{2}))?\s*(am|pm|a\.m\.|p\.m\.|noon|midnight)?|noon|midnight)$`)
+	// daySep separates the first and last days of a range of days
+	daySep = regexp.MustCompile(`\s+to\s+|\s*[-–—]\s*`)
+)
+
+// ParseOpeningHours parses timings 's' as the API sends them. They are
+// comma separated ranges of times or "Closed" followed by the days they
+// apply to in parentheses, every day if left out, as in
+// "11 AM to 11 PM (Mon-Thu, Sun), 11 AM to 1 AM (Fri-Sat)" or
+// "24 Hours (Tue-Sun), Closed (Mon)". Closed days have no opening hours.
+func ParseOpeningHours(s string) (OpeningHours, error) {
+	var h OpeningHours
+	var closed [7]bool
+	rest := strings.TrimSpace(s)
+	if rest == "" {
+		return h, errors.Errorf("invalid timings %q: empty", s)
+	}
+
+	for rest != "" {
+		ranges, days := rest, "daily"
+		rest = ""
+		if i := strings.IndexByte(ranges, '('); i >= 0 {
+			j := strings.IndexByte(ranges[i:], ')')
+			if j < 0 {
+				return h, errors.Errorf("invalid timings %q: unclosed parenthesis", s)
+			}
+			ranges, days, rest = ranges[:i], ranges[i+1:i+j], ranges[i+j+1:]
+			rest = strings.TrimSpace(strings.TrimLeft(rest, ",; "))
+		}
+
+		ds, err := parseDays(days)
+		if err != nil {
+			return h, errors.Wrapf(err, "invalid timings %q", s)
+		}
+		for _, r := range strings.Split(ranges, ",") {
+			r = strings.ToLower(strings.TrimSpace(r))
+			switch r {
+			case "":
+				return h, errors.Errorf("invalid timings %q: missing hours", s)
+			case "closed":
+				for _, d := range ds {
+					closed[d] = true
+				}
+				continue
+			}
+
+			tr, err := parseTimeRange(r)
+			if err != nil {
+				return h, errors.Wrapf(err, "invalid timings %q", s)
+			}
+			for _, d := range ds {
+				h.Days[d] = append(h.Days[d], tr)
+			}
+		}
+	}
+
+	for d := range h.Days {
+		if closed[d] {
+			h.Days[d] = nil
+			continue
+		}
+		rs := h.Days[d]
+		sort.Slice(rs, func(i, j int) bool {
+			return rs[i].Open < rs[j].Open || rs[i].Open == rs[j].Open && rs[i].Close < rs[j].Close
+		})
+	}
+	return h, nil
+}
+
+// parseDays parses days 's', such as "Mon-Fri, Sun", in order of the week
+// from Monday.
+func parseDays(s string) ([]time.Weekday, error) {
+	var in [7]bool
+	for _, d := range strings.Split(s, ",") {
+		d = strings.ToLower(strings.TrimSpace(d))
+		switch d {
+		case "daily", "everyday", "every day", "all days":
+			d = "mon-sun"
+		}
+
+		bounds := daySep.Split(d, -1)
+		if len(bounds) > 2 {
+			return nil, errors.Errorf("invalid days %q", d)
+		}
+		first, err := parseDay(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = parseDay(bounds[1]); err != nil {
+				return nil, err
+			}
+		}
+		// Ranges of days wrap around the week, as in Fri-Mon
+		for i := dayIndex(first); ; i = (i + 1) % 7 {
+			in[weekdays[i]] = true
+			if weekdays[i] == last {
+				break
+			}
+		}
+	}
+
+	var days []time.Weekday
+	for _, d := range weekdays {
+		if in[d] {
+			days = append(days, d)
+		}
+	}
+	return days, nil
+}
+
+// parseDay parses day 's', a day name or its abbreviation such as "tue" or
+// "tues".
+func parseDay(s string) (time.Weekday, error) {
+	if len(s) >= 3 {
+		for _, d := range weekdays {
+			if name := strings.ToLower(d.String()); strings.HasPrefix(name, s) {
+				return d, nil
+			}
+		}
+	}
+	return 0, errors.Errorf("invalid day %q", s)
+}
+
+// dayIndex returns the index of 'd' in weekdays.
+func dayIndex(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+// parseTimeRange parses lower cased range 's', such as "7 pm to 11:30 pm" or
+// "24 hours".
+func parseTimeRange(s string) (TimeRange, error) {
+	if s == "24 hours" || s == "open 24 hours" {
+		return TimeRange{Open: 0, Close: minutesPerDay}, nil
+	}
+	bounds := rangeSep.Split(s, -1)
+	if len(bounds) != 2 {
+		return TimeRange{}, errors.Errorf("invalid hours %q", s)
+	}
+	open, err := parseClock(bounds[0])
+	if err != nil {
+		return TimeRange{}, err
+	}
+	close, err := parseClock(bounds[1])
+	if err != nil {
+		return TimeRange{}, err
+	}
+	open %= minutesPerDay
+	if close <= open {
+		close += minutesPerDay
+	}
+	return TimeRange{Open: open, Close: close}, nil
+}
+
+// parseClock parses lower cased time of day 's' as minutes after midnight,
+// on a 12-hour clock with a meridiem or else on a 24-hour clock.
+func parseClock(s string) (int, error) {
+	m := clockTime.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, errors.Errorf("invalid time %q", s)
+	}
+
+	hour, min, meridiem := 12, 0, m[3]
+	if m[1] == "" {
+		meridiem = m[0]
+	} else {
+		hour, _ = strconv.Atoi(m[1])
+	}
+	if m[2] != "" {
+		min, _ = strconv.Atoi(m[2])
+	}
+	if min > 59 {
+		return 0, errors.Errorf("invalid time %q", s)
+	}
+
+	switch meridiem {
+	case "":
+		if hour > 24 || hour == 24 && min > 0 {
+			return 0, errors.Errorf("invalid time %q", s)
+		}
+	case "noon", "midnight":
+		if hour != 12 || min != 0 {
+			return 0, errors.Errorf("invalid time %q", s)
+		}
+		if meridiem == "midnight" {
+			hour = 0
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return 0, errors.Errorf("invalid time %q", s)
+		}
+		hour %= 12
+		if meridiem[0] == 'p' {
+			hour += 12
+		}
+	}
+	return hour*60 + min, nil
+}
+
+// IsOpenAt reports whether the schedule is open at the wall clock time of
+// 't', including ranges of the day before closing after midnight.
+func (h OpeningHours) IsOpenAt(t time.Time) bool {
+	hour, min, _ := t.Clock()
+	m := hour*60 + min
+	day := t.Weekday()
+	for _, r := range h.Days[day] {
+		if m >= r.Open && m < r.Close {
+			return true
+		}
+	}
+	for _, r := range h.Days[(day+6)%7] {
+		if m+minutesPerDay < r.Close {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpening returns the next time at or after 't' the schedule opens, in
+// the location of 't', or false if it never opens.
+func (h OpeningHours) NextOpening(t time.Time) (time.Time, bool) {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := 0; i <= 7; i++ {
+		date := midnight.AddDate(0, 0, i)
+		for _, r := range h.Days[date.Weekday()] {
+			open := time.Date(date.Year(), date.Month(), date.Day(), 0, r.Open, 0, 0, t.Location())
+			if !open.Before(t) {
+				return open, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// String returns the schedule as timings, days of the same hours grouped as
+// in "12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)".
+func (h OpeningHours) String() string {
+	var groups []string
+	done := make(map[time.Weekday]bool)
+	for _, d := range weekdays {
+		if done[d] {
+			continue
+		}
+		var days []time.Weekday
+		for _, e := range weekdays {
+			if !done[e] && sameRanges(h.Days[d], h.Days[e]) {
+				days = append(days, e)
+				done[e] = true
+			}
+		}
+
+		var ranges []string
+		for _, r := range h.Days[d] {
+			if r == (TimeRange{Open: 0, Close: minutesPerDay}) {
+				ranges = append(ranges, "24 Hours")
+				continue
+			}
+			ranges = append(ranges, formatClock(r.Open)+" to "+formatClock(r.Close))
+		}
+		if len(ranges) == 0 {
+			ranges = []string{"Closed"}
+		}
+		groups = append(groups, strings.Join(ranges, ", ")+" ("+formatDays(days)+")")
+	}
+	return strings.Join(groups, ", ")
+}
+
+// sameRanges reports whether 'a' and 'b' are the same ranges.
+func sameRanges(a, b []TimeRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// formatClock returns 'm' minutes after midnight as a time of day such as
+// "3:30 PM" or "12 Noon".
+func formatClock(m int) string {
+	m %= minutesPerDay
+	hour, min := m/60, m%60
+	switch {
+	case m == 0:
+		return "12 Midnight"
+	case m == 12*60:
+		return "12 Noon"
+	}
+
+	meridiem := "AM"
+	if hour >= 12 {
+		meridiem = "PM"
+	}
+	if hour %= 12; hour == 0 {
+		hour = 12
+	}
+	if min == 0 {
+		return fmt.Sprintf("%d %s", hour, meridiem)
+	}
+	return fmt.Sprintf("%d:%02d %s", hour, min, meridiem)
+}
+
+// formatDays returns 'days', in order of weekdays, as runs of days such as
+// "Mon-Fri, Sun".
+func formatDays(days []time.Weekday) string {
+	var runs []string
+	for i := 0; i < len(days); {
+		j := i
+		for j+1 < len(days) && dayIndex(days[j+1]) == dayIndex(days[j])+1 {
+			j++
+		}
+		run := days[i].String()[:3]
+		if j > i {
+			run += "-" + days[j].String()[:3]
+		}
+		runs = append(runs, run)
+		i = j + 1
+	}
+	return strings.Join(runs, ", ")
+}
+
+// OpeningHours returns the parsed Timings of the restaurant, or
+// ErrNoTimings.
+func (r Restaurant) OpeningHours() (OpeningHours, error) {
+	if r.Timings == nil || strings.TrimSpace(*r.Timings) == "" {
+		return OpeningHours{}, ErrNoTimings
+	}
+	return ParseOpeningHours(*r.Timings)
+}
+
+// IsOpenAt reports whether the restaurant is open at 't', in the time zone of
+// its city if known. Restaurants of unparsable timings are not.
+func (r Restaurant) IsOpenAt(t time.Time) bool {
+	h, err := r.OpeningHours()
+	if err != nil {
+		return false
+	}
+	if loc, err := r.TimeZone(); err == nil {
+		t = t.In(loc)
+	}
+	return h.IsOpenAt(t)
+}
+
+// NextOpening returns the next time at or after 't' the restaurant opens, in
+// the time zone of its city if known, or false if it never opens or its
+// timings are unparsable.
+func (r Restaurant) NextOpening(t time.Time) (time.Time, bool) {
+	h, err := r.OpeningHours()
+	if err != nil {
+		return time.Time{}, false
+	}
+	if loc, err := r.TimeZone(); err == nil {
+		t = t.In(loc)
+	}
+	return h.NextOpening(t)
+}
@@ -0,0 +1,231 @@
+package zomato_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+)
+
+// week returns hours of 'ranges' every day of 'days'
+func week(ranges []zomato.TimeRange, days ...time.Weekday) zomato.OpeningHours {
+	var h zomato.OpeningHours
+	for _, d := range days {
+		h.Days[d] = ranges
+	}
+	return h
+}
+
+var (
+	allWeek  = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+)
+
+func TestParseOpeningHours(t *testing.T) {
+	lunchDinner := []zomato.TimeRange{{12 * 60, 15*60 + 30}, {19 * 60, 23*60 + 30}}
+	friSat := week([]zomato.TimeRange{{11 * 60, 25 * 60}}, time.Friday, time.Saturday)
+	friSat.Days[time.Sunday] = []zomato.TimeRange{{11 * 60, 23 * 60}}
+	for _, d := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday} {
+		friSat.Days[d] = friSat.Days[time.Sunday]
+	}
+	closedMon := week([]zomato.TimeRange{{0, 24 * 60}}, allWeek...)
+	closedMon.Days[time.Monday] = nil
+
+	tests := []struct {
+		timings string
+		want    zomato.OpeningHours
+		str     string
+	}{
+		{
+			"12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)",
+			week(lunchDinner, allWeek...),
+			"12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)",
+		},
+		{
+			"11 AM to 11 PM (Mon, Tue, Wed, Thu, Sun), 11 AM to 1 AM (Fri-Sat)",
+			friSat,
+			"11 AM to 11 PM (Mon-Thu, Sun), 11 AM to 1 AM (Fri-Sat)",
+		},
+		{
+			"24 Hours (Tue-Sun), Closed (Mon)",
+			closedMon,
+			"Closed (Mon), 24 Hours (Tue-Sun)",
+		},
+		{
+			"12noon – 12midnight",
+			week([]zomato.TimeRange{{12 * 60, 24 * 60}}, allWeek...),
+			"12 Noon to 12 Midnight (Mon-Sun)",
+		},
+		{
+			"9:00 - 17:30 (Monday to Friday)",
+			week([]zomato.TimeRange{{9 * 60, 17*60 + 30}}, weekdays...),
+			"9 AM to 5:30 PM (Mon-Fri), Closed (Sat-Sun)",
+		},
+		{
+			"7 PM to 2 AM (Fri-Mon)",
+			week([]zomato.TimeRange{{19 * 60, 26 * 60}}, time.Friday, time.Saturday, time.Sunday, time.Monday),
+			"7 PM to 2 AM (Mon, Fri-Sun), Closed (Tue-Thu)",
+		},
+		{
+			"7 PM to 11 PM, 12 Noon to 3 PM (Sat, Sun)",
+			week([]zomato.TimeRange{{12 * 60, 15 * 60}, {19 * 60, 23 * 60}}, time.Saturday, time.Sunday),
+			"Closed (Mon-Fri), 12 Noon to 3 PM, 7 PM to 11 PM (Sat-Sun)",
+		},
+		{
+			"12 Midnight to 6 AM (Sat)",
+			week([]zomato.TimeRange{{0, 6 * 60}}, time.Saturday),
+			"Closed (Mon-Fri, Sun), 12 Midnight to 6 AM (Sat)",
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := zomato.ParseOpeningHours(tt.timings)
+		if err != nil {
+			t.Errorf("ParseOpeningHours(%q) failed: %+v", tt.timings, err)
+			continue
+		}
+		if !reflect.DeepEqual(h, tt.want) {
+			t.Errorf("ParseOpeningHours(%q) = %+v, want %+v", tt.timings, h, tt.want)
+		}
+		if s := h.String(); s != tt.str {
+			t.Errorf("ParseOpeningHours(%q).String() = %q, want %q", tt.timings, s, tt.str)
+		}
+	}
+
+	for _, timings := range []string{
+		"",
+		"Sometimes",
+		"11 AM (Mon-Sun)",
+		"11 AM to 13 PM",
+		"11:60 AM to 3 PM",
+		"25:00 to 26:00",
+		"11 AM to 3 PM (Mon-Sun",
+		"11 AM to 3 PM (Someday)",
+		"11 AM to 3 PM (Mo)",
+		"11 AM to 3 PM (Mon-Wed-Fri)",
+		"(Mon-Sun)",
+		"11 AM to 3 PM, (Mon)",
+		"3 Noon to 4 PM",
+	} {
+		if _, err := zomato.ParseOpeningHours(timings); err == nil {
+			t.Errorf("ParseOpeningHours(%q) error = nil", timings)
+		}
+	}
+}
+
+func TestOpeningHours(t *testing.T) {
+	h, err := zomato.ParseOpeningHours("12 Noon to 3:30 PM, 7 PM to 2 AM (Tue-Sat), Closed (Sun-Mon)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Monday 9 April 2018
+	day := func(d, hour, min int) time.Time { return time.Date(2018, 4, d, hour, min, 0, 0, time.UTC) }
+
+	tests := []struct {
+		at   time.Time
+		open bool
+		next time.Time
+	}{
+		{day(9, 13, 0), false, day(10, 12, 0)},
+		{day(10, 11, 59), false, day(10, 12, 0)},
+		{day(10, 12, 0), true, day(10, 12, 0)},
+		{day(10, 12, 1), true, day(10, 19, 0)},
+		{day(10, 15, 30), false, day(10, 19, 0)},
+		{day(10, 23, 0), true, day(11, 12, 0)},
+		{day(11, 1, 59), true, day(11, 12, 0)},
+		{day(11, 2, 0), false, day(11, 12, 0)},
+		// Past Saturday night, into closed Sunday
+		{day(15, 1, 0), true, day(17, 12, 0)},
+		{day(15, 13, 0), false, day(17, 12, 0)},
+	}
+	for _, tt := range tests {
+		if open := h.IsOpenAt(tt.at); open != tt.open {
+			t.Errorf("IsOpenAt(%s) = %t, want %t", tt.at, open, tt.open)
+		}
+		if next, ok := h.NextOpening(tt.at); !ok || !next.Equal(tt.next) {
+			t.Errorf("NextOpening(%s) = %s, %t, want %s", tt.at, next, ok, tt.next)
+		}
+	}
+
+	closed, err := zomato.ParseOpeningHours("Closed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := closed.NextOpening(day(9, 0, 0)); ok {
+		t.Error("NextOpening() of closed hours = true")
+	}
+}
+
+func TestRestaurantOpeningHours(t *testing.T) {
+	var r zomato.Restaurant
+	data := `{"timings":"11 AM to 11 PM (Mon-Sun)","location":{"city_id":1,"country_id":1}}`
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Timings == nil || *r.Timings != "11 AM to 11 PM (Mon-Sun)" {
+		t.Fatalf("Unmarshal() timings = %v", r.Timings)
+	}
+
+	// 22:30 and 23:30 in Delhi
+	if at := time.Date(2018, 4, 9, 17, 0, 0, 0, time.UTC); !r.IsOpenAt(at) {
+		t.Errorf("IsOpenAt(%s) = false", at)
+	}
+	at := time.Date(2018, 4, 9, 18, 0, 0, 0, time.UTC)
+	if r.IsOpenAt(at) {
+		t.Errorf("IsOpenAt(%s) = true", at)
+	}
+	want := time.Date(2018, 4, 10, 5, 30, 0, 0, time.UTC)
+	if next, ok := r.NextOpening(at); !ok || !next.Equal(want) || next.Location().String() != "Asia/Kolkata" {
+		t.Errorf("NextOpening(%s) = %s, %t, want %s in Asia/Kolkata", at, next, ok, want)
+	}
+
+	if _, err := (zomato.Restaurant{}).OpeningHours(); err != zomato.ErrNoTimings {
+		t.Errorf("OpeningHours() without timings error = %v, want %v", err, zomato.ErrNoTimings)
+	}
+	if (zomato.Restaurant{}).IsOpenAt(at) {
+		t.Error("IsOpenAt() without timings = true")
+	}
+}
+
+func FuzzParseOpeningHours(f *testing.F) {
+	for _, s := range []string{
+		"12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)",
+		"11 AM to 11 PM (Mon, Tue, Wed, Thu, Sun), 11 AM to 1 AM (Fri-Sat)",
+		"24 Hours (Tue-Sun), Closed (Mon)",
+		"12noon – 12midnight",
+		"9:00 - 17:30 (Monday to Friday)",
+		"7 PM to 2 AM (Fri-Mon)",
+		"Closed",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, timings string) {
+		h, err := zomato.ParseOpeningHours(timings)
+		if err != nil {
+			return
+		}
+		for d, rs := range h.Days {
+			for _, r := range rs {
+				if r.Open < 0 || r.Open >= 24*60 || r.Close <= r.Open || r.Close > r.Open+24*60 {
+					t.Fatalf("ParseOpeningHours(%q) day %d has range %+v", timings, d, r)
+				}
+			}
+		}
+
+		again, err := zomato.ParseOpeningHours(h.String())
+		if err != nil {
+			t.Fatalf("ParseOpeningHours(%q) of ParseOpeningHours(%q) failed: %+v", h, timings, err)
+		}
+		if !reflect.DeepEqual(again, h) {
+			t.Fatalf("ParseOpeningHours(%q) = %+v, want %+v of %q", h, again, h, timings)
+		}
+
+		at := time.Date(2018, 4, 9, 0, 0, 0, 0, time.UTC)
+		if next, ok := h.NextOpening(at); ok && (!h.IsOpenAt(next) || next.Before(at)) {
+			t.Fatalf("ParseOpeningHours(%q) is closed at NextOpening() %s", timings, next)
+		}
+	})
+}
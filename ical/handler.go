@@ -0,0 +1,151 @@
+package ical
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// DefaultName is the name of feeds of handlers without one
+const DefaultName = "Zomato Events"
+
+// Handler serves the events of restaurants as iCalendar feeds, of the
+// restaurants of IDs "res_id", comma separated or repeated, or of the
+// restaurants of city "city_id".
+//
+//	http.Handle("/events.ics", ical.NewHandler(zomato.Intercept(client, zomato.NewCache(time.Hour).Intercept)))
+//
+// Calendar apps poll feeds, so its API is best cached.
+type Handler struct {
+	API zomato.API
+	// Name is the name of the feeds; DefaultName if empty
+	Name string
+	// MaxRestaurants limits the restaurants of a feed;
+	// zomato.MaxSearchResults if 0
+	MaxRestaurants int
+}
+
+// NewHandler returns a Handler of the events of restaurants of 'api'.
+func NewHandler(api zomato.API) *Handler {
+	return &Handler{API: api}
+}
+
+// ServeHTTP implements http.Handler, answering GET requests with the feed of
+// the restaurants of query parameters "res_id" or "city_id".
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	resIDs, err := ids(query["res_id"])
+	if err != nil {
+		http.Error(w, "invalid res_id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	cityIDs, err := ids(query["city_id"])
+	if err != nil || len(cityIDs) > 1 {
+		http.Error(w, "invalid city_id", http.StatusBadRequest)
+		return
+	}
+	max := h.MaxRestaurants
+	if max <= 0 {
+		max = zomato.MaxSearchResults
+	}
+	switch {
+	case len(resIDs) == 0 && len(cityIDs) == 0:
+		http.Error(w, "res_id or city_id required", http.StatusBadRequest)
+		return
+	case len(resIDs) > 0 && len(cityIDs) > 0:
+		http.Error(w, "res_id and city_id are exclusive", http.StatusBadRequest)
+		return
+	case len(resIDs) > max:
+		http.Error(w, "too many res_id", http.StatusBadRequest)
+		return
+	}
+
+	name := h.Name
+	if name == "" {
+		name = DefaultName
+	}
+	cal := &Calendar{Name: name}
+	if len(cityIDs) > 0 {
+		err = h.addCity(r.Context(), cal, cityIDs[0], max)
+	} else {
+		err = h.addRestaurants(r.Context(), cal, resIDs)
+	}
+	if apiErr, ok := errors.Cause(err).(*zomato.ErrAPI); ok && apiErr.StatusCode == http.StatusNotFound {
+		http.Error(w, apiErr.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="events.ics"`)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	if r.Method == http.MethodGet {
+		w.Write(buf.Bytes())
+	}
+}
+
+// addRestaurants adds the events of the restaurants of IDs 'resIDs' to 'cal'.
+func (h *Handler) addRestaurants(ctx context.Context, cal *Calendar, resIDs []int64) error {
+	for _, id := range resIDs {
+		res, err := h.API.Restaurant(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "get restaurant %d failed", id)
+		}
+		cal.Add(res)
+	}
+	return nil
+}
+
+// addCity adds the events of up to 'max' restaurants of city 'cityID' to
+// 'cal'.
+func (h *Handler) addCity(ctx context.Context, cal *Calendar, cityID int64, max int) error {
+	p := zomato.NewSearchPaginator(h.API, zomato.SearchReq{EntityID: cityID, EntityType: zomato.CityEntity})
+	n := 0
+	for n < max && p.Next(ctx) {
+		for _, res := range p.Restaurants() {
+			if n == max {
+				break
+			}
+			cal.Add(res)
+			n++
+		}
+	}
+	return errors.Wrapf(p.Err(), "search restaurants of city %d failed", cityID)
+}
+
+// ids parses the IDs of 'values', each comma separated.
+func ids(values []string) ([]int64, error) {
+	var ids []int64
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || id <= 0 {
+				return nil, errors.Errorf("invalid ID %q", s)
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
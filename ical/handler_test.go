@@ -0,0 +1,112 @@
+package ical_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/ical"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// feedAPI mocks Restaurant and a search of city 1, serving the restaurants
+// with events of the fixtures
+func feedAPI(t *testing.T) *zomatotest.Mock {
+	rs := append(fixture(t, "LocationDetails.json"), fixture(t, "GeoCode.json")...)
+	return &zomatotest.Mock{
+		RestaurantFunc: func(ctx context.Context, id int64) (zomato.Restaurant, error) {
+			for _, r := range rs {
+				if *r.ID == id {
+					return r, nil
+				}
+			}
+			return zomato.Restaurant{}, &zomato.ErrAPI{StatusCode: http.StatusNotFound}
+		},
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			if req.EntityID != 1 || req.EntityType != zomato.CityEntity {
+				return zomato.SearchResp{}, &zomato.ErrAPI{StatusCode: http.StatusBadRequest}
+			}
+			var resp zomato.SearchResp
+			for _, r := range rs {
+				r := r
+				resp.Restaurants = append(resp.Restaurants, struct {
+					Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+				}{&r})
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		method, target string
+		status         int
+		events         int
+		calls          map[string]int
+	}{
+		{http.MethodGet, "/events.ics?res_id=18558926", http.StatusOK, 1, map[string]int{"Restaurant": 1}},
+		{http.MethodGet, "/events.ics?res_id=18558926,309629", http.StatusOK, 2, map[string]int{"Restaurant": 2}},
+		{http.MethodGet, "/events.ics?res_id=18558926&res_id=309629", http.StatusOK, 2, map[string]int{"Restaurant": 2}},
+		{http.MethodGet, "/events.ics?res_id=463", http.StatusNotFound, 0, map[string]int{"Restaurant": 1}},
+		{http.MethodGet, "/events.ics?city_id=1", http.StatusOK, 2, map[string]int{"Search": 1}},
+		{http.MethodHead, "/events.ics?city_id=1", http.StatusOK, 0, map[string]int{"Search": 1}},
+		{http.MethodGet, "/events.ics?city_id=2", http.StatusBadGateway, 0, map[string]int{"Search": 1}},
+		{http.MethodGet, "/events.ics", http.StatusBadRequest, 0, nil},
+		{http.MethodGet, "/events.ics?res_id=x", http.StatusBadRequest, 0, nil},
+		{http.MethodGet, "/events.ics?res_id=1&city_id=1", http.StatusBadRequest, 0, nil},
+		{http.MethodGet, "/events.ics?city_id=1,2", http.StatusBadRequest, 0, nil},
+		{http.MethodPost, "/events.ics?city_id=1", http.StatusMethodNotAllowed, 0, nil},
+	}
+
+	for _, tt := range tests {
+		api := feedAPI(t)
+		rec := httptest.NewRecorder()
+		ical.NewHandler(api).ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+		if rec.Code != tt.status {
+			t.Errorf("%s %s status = %d, want %d", tt.method, tt.target, rec.Code, tt.status)
+			continue
+		}
+		for _, method := range []string{"Restaurant", "Search"} {
+			if n := len(api.CallsTo(method)); n != tt.calls[method] {
+				t.Errorf("%s %s called %s %d times, want %d", tt.method, tt.target, method, n, tt.calls[method])
+			}
+		}
+		if rec.Code != http.StatusOK {
+			continue
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "text/calendar; charset=utf-8" {
+			t.Errorf("%s %s Content-Type = %q", tt.method, tt.target, ct)
+		}
+		body := rec.Body.String()
+		if n := strings.Count(body, "BEGIN:VEVENT"); n != tt.events {
+			t.Errorf("%s %s has %d events, want %d", tt.method, tt.target, n, tt.events)
+		}
+		if tt.method == http.MethodGet && !strings.Contains(body, "X-WR-CALNAME:"+ical.DefaultName+"\r\n") {
+			t.Errorf("%s %s = %s, want calendar %s", tt.method, tt.target, body, ical.DefaultName)
+		}
+	}
+}
+
+func TestHandlerMaxRestaurants(t *testing.T) {
+	h := &ical.Handler{API: feedAPI(t), Name: "Rohini", MaxRestaurants: 1}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?city_id=1", nil))
+	if n := strings.Count(rec.Body.String(), "BEGIN:VEVENT"); n != 1 {
+		t.Errorf("city feed has %d events, want 1", n)
+	}
+	if !strings.Contains(rec.Body.String(), "X-WR-CALNAME:Rohini\r\n") {
+		t.Errorf("city feed = %s, want calendar Rohini", rec.Body)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?res_id=1,2", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("feed of 2 restaurants status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
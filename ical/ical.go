@@ -0,0 +1,306 @@
+// Package ical encodes the events of restaurants as iCalendar (RFC 5545)
+// calendars, and serves them as feeds calendar apps subscribe to.
+//
+//	var cal ical.Calendar
+//	cal.Add(restaurant)
+//	if err := cal.Encode(w); err != nil {
+//		return err
+//	}
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// ProdID identifies the product making the calendars
+const ProdID = "-//go-india//zomato//EN"
+
+// Time formats of iCalendar values
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+	utcFormat      = "20060102T150405Z"
+)
+
+// maxLineLen is the length in octets lines are folded at
+const maxLineLen = 75
+
+// Calendar is a calendar of events of restaurants.
+//
+// Times of events are in the time zone of their restaurant, as decoded by
+// zomato.Restaurant. Events of restaurants of unknown time zone have
+// floating times, in the time zone of the calendar app.
+type Calendar struct {
+	// Name is the name calendar apps show, as X-WR-CALNAME
+	Name string
+	// Now returns the DTSTAMP of events added at unknown times; defaults
+	// to time.Now
+	Now func() time.Time
+
+	events []event
+}
+
+// event is an event of a restaurant
+type event struct {
+	r zomato.Restaurant
+	e zomato.Event
+}
+
+// Add adds the events of restaurant 'r'.
+func (c *Calendar) Add(r zomato.Restaurant) {
+	for _, e := range r.ZomatoEvents {
+		if e.Event != nil {
+			c.AddEvent(r, *e.Event)
+		}
+	}
+}
+
+// AddEvent adds event 'e' of restaurant 'r'. Events without a StartDate are
+// left out.
+func (c *Calendar) AddEvent(r zomato.Restaurant, e zomato.Event) {
+	if e.StartDate == nil {
+		return
+	}
+	c.events = append(c.events, event{r: r, e: e})
+}
+
+// Len returns the number of events of the calendar.
+func (c *Calendar) Len() int {
+	return len(c.events)
+}
+
+// Encode writes the calendar to 'w', with events in order of start.
+func (c *Calendar) Encode(w io.Writer) error {
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+	events := append([]event(nil), c.events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].e.StartDate.Before(*events[j].e.StartDate)
+	})
+
+	bw := bufio.NewWriter(w)
+	l := &lineWriter{w: bw}
+	l.line("BEGIN", "VCALENDAR")
+	l.line("VERSION", "2.0")
+	l.line("PRODID", ProdID)
+	l.line("CALSCALE", "GREGORIAN")
+	if c.Name != "" {
+		l.line("X-WR-CALNAME", escape(c.Name))
+	}
+	for _, z := range zones(events) {
+		z.encode(l)
+	}
+	for _, e := range events {
+		e.encode(l, now().UTC())
+	}
+	l.line("END", "VCALENDAR")
+
+	if l.err != nil {
+		return errors.Wrap(l.err, "write calendar failed")
+	}
+	return errors.Wrap(bw.Flush(), "write calendar failed")
+}
+
+// encode writes the event as a VEVENT, stamped 'now' if added at an
+// unknown time.
+func (ev event) encode(l *lineWriter, now time.Time) {
+	r, e := ev.r, ev.e
+	l.line("BEGIN", "VEVENT")
+	l.line("UID", uid(r, e))
+	if e.DateAdded != nil {
+		now = e.DateAdded.UTC()
+	}
+	l.line("DTSTAMP", now.Format(utcFormat))
+
+	start, _ := e.Start()
+	end, ok := e.End()
+	switch {
+	case e.IsEndTimeSet != nil && *e.IsEndTimeSet && e.EndTime != nil:
+		// Daily from StartTime to EndTime, from StartDate until EndDate
+		first := at(start, *e.EndTime)
+		if !first.After(start) {
+			first = first.AddDate(0, 0, 1)
+		}
+		l.time("DTSTART", start)
+		l.time("DTEND", first)
+		if e.EndDate != nil && e.EndDate.After(*e.StartDate) {
+			last := at(*e.EndDate, start)
+			l.line("RRULE", "FREQ=DAILY;UNTIL="+untilValue(last))
+		}
+	case e.StartTime == nil || isMidnight(start):
+		// All day, from StartDate to EndDate
+		l.line("DTSTART;VALUE=DATE", start.Format(dateFormat))
+		if ok {
+			l.line("DTEND;VALUE=DATE", end.Format(dateFormat))
+		}
+	default:
+		l.time("DTSTART", start)
+		if ok {
+			l.time("DTEND", end)
+		}
+	}
+
+	if e.Title != nil {
+		l.line("SUMMARY", escape(*e.Title))
+	}
+	if d := description(e); d != "" {
+		l.line("DESCRIPTION", escape(d))
+	}
+	if loc := location(r); loc != "" {
+		l.line("LOCATION", escape(loc))
+	}
+	if r.Location != nil && r.Location.Latitude != nil && r.Location.Longitude != nil {
+		l.line("GEO", strconv.FormatFloat(*r.Location.Latitude, 'f', -1, 64)+";"+
+			strconv.FormatFloat(*r.Location.Longitude, 'f', -1, 64))
+	}
+	if u := eventURL(r, e); u != "" {
+		l.line("URL;VALUE=URI", u)
+	}
+	if e.EventCategoryName != nil && *e.EventCategoryName != "" {
+		l.line("CATEGORIES", escape(*e.EventCategoryName))
+	}
+	l.line("END", "VEVENT")
+}
+
+// uid returns the globally unique ID of event 'e' of restaurant 'r'.
+func uid(r zomato.Restaurant, e zomato.Event) string {
+	var eventID, resID int64
+	if e.ID != nil {
+		eventID = *e.ID
+	}
+	if r.ID != nil {
+		resID = *r.ID
+	}
+	if eventID == 0 {
+		return fmt.Sprintf("%d-%s@zomato.com", resID, e.StartDate.Format(dateFormat))
+	}
+	return fmt.Sprintf("%d-%d@zomato.com", eventID, resID)
+}
+
+// at returns 'date' at the time of day of 'clock'.
+func at(date, clock time.Time) time.Time {
+	h, m, s := clock.Clock()
+	return time.Date(date.Year(), date.Month(), date.Day(), h, m, s, 0, date.Location())
+}
+
+// isMidnight reports whether 't' is at the start of its day.
+func isMidnight(t time.Time) bool {
+	h, m, s := t.Clock()
+	return h == 0 && m == 0 && s == 0
+}
+
+// untilValue returns the UNTIL of a rule of events starting until 't', in UTC
+// unless 't' is floating.
+func untilValue(t time.Time) string {
+	if t.Location() == time.UTC {
+		return t.Format(dateTimeFormat)
+	}
+	return t.UTC().Format(utcFormat)
+}
+
+// description returns the description of 'e', with its disclaimer.
+func description(e zomato.Event) string {
+	var parts []string
+	if e.Description != nil && *e.Description != "" {
+		parts = append(parts, *e.Description)
+	}
+	if e.Disclaimer != nil && *e.Disclaimer != "" {
+		parts = append(parts, *e.Disclaimer)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// location returns the name and address of restaurant 'r'.
+func location(r zomato.Restaurant) string {
+	var parts []string
+	if r.Name != nil && *r.Name != "" {
+		parts = append(parts, *r.Name)
+	}
+	if r.Location != nil && r.Location.Address != nil && *r.Location.Address != "" {
+		parts = append(parts, *r.Location.Address)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// eventURL returns the page of event 'e', or else of restaurant 'r'.
+func eventURL(r zomato.Restaurant, e zomato.Event) string {
+	switch {
+	case e.ShareURL != nil && *e.ShareURL != "":
+		return *e.ShareURL
+	case r.URL != nil:
+		return *r.URL
+	}
+	return ""
+}
+
+// escape escapes 's' as an iCalendar TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// lineWriter writes content lines, folded at maxLineLen octets, keeping
+// the first error.
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes the content line of property 'name' of value 'value'.
+func (l *lineWriter) line(name, value string) {
+	if l.err != nil {
+		return
+	}
+	_, l.err = io.WriteString(l.w, fold(name+":"+value)+"\r\n")
+}
+
+// time writes the content line of date-time property 'name' at 't', in
+// the time zone of 't' or floating if UTC.
+func (l *lineWriter) time(name string, t time.Time) {
+	if t.Location() == time.UTC {
+		l.line(name, t.Format(dateTimeFormat))
+		return
+	}
+	l.line(name+";TZID="+t.Location().String(), t.Format(dateTimeFormat))
+}
+
+// fold folds line 's' at maxLineLen octets, without splitting UTF-8
+// sequences.
+func fold(s string) string {
+	if len(s) <= maxLineLen {
+		return s
+	}
+	var b strings.Builder
+	n := maxLineLen
+	for len(s) > n {
+		i := n
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		b.WriteString(s[:i])
+		b.WriteString("\r\n ")
+		s = s[i:]
+		// Continuation lines start with a space
+		n = maxLineLen - 1
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,190 @@
+package ical_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/ical"
+	"github.com/go-india/zomato/internal/testfixture"
+)
+
+// restaurant decodes restaurant 'data'
+func restaurant(t *testing.T, data string) zomato.Restaurant {
+	var r zomato.Restaurant
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// fixture returns the restaurants with events of fixture 'name'
+func fixture(t *testing.T, name string) []zomato.Restaurant {
+	var resp struct {
+		Nearby []struct{ Restaurant zomato.Restaurant } `json:"nearby_restaurants"`
+		Best   []struct{ Restaurant zomato.Restaurant } `json:"best_rated_restaurant"`
+	}
+	testfixture.Decode(t, name, &resp)
+	var rs []zomato.Restaurant
+	for _, r := range append(resp.Nearby, resp.Best...) {
+		if len(r.Restaurant.ZomatoEvents) > 0 {
+			rs = append(rs, r.Restaurant)
+		}
+	}
+	return rs
+}
+
+// encode encodes a calendar of the events of 'rs', checking line lengths
+// and endings, and returns its unfolded lines.
+func encode(t *testing.T, rs ...zomato.Restaurant) []string {
+	cal := ical.Calendar{
+		Name: "Delhi, NCR",
+		Now:  func() time.Time { return time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	for _, r := range rs {
+		cal.Add(r)
+	}
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.String()
+	if !strings.HasSuffix(data, "\r\n") {
+		t.Errorf("Encode() = %q, want CRLF ending", data)
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(data, "\r\n"), "\r\n") {
+		if len(l) > 75 || !utf8.ValidString(l) || strings.ContainsAny(l, "\r\n") {
+			t.Errorf("Encode() line %q is not a folded content line", l)
+		}
+	}
+	return strings.Split(strings.Replace(strings.TrimSuffix(data, "\r\n"), "\r\n ", "", -1), "\r\n")
+}
+
+// contains reports lines of 'want' missing from 'lines', in order.
+func contains(t *testing.T, lines []string, want ...string) {
+	i := 0
+	for _, l := range lines {
+		if i < len(want) && l == want[i] {
+			i++
+		}
+	}
+	if i < len(want) {
+		t.Errorf("Encode() = \n%s\nwant line %q", strings.Join(lines, "\n"), want[i])
+	}
+}
+
+func TestCalendarEncode(t *testing.T) {
+	rs := append(fixture(t, "LocationDetails.json"), fixture(t, "GeoCode.json")...)
+	if len(rs) != 2 {
+		t.Fatalf("fixtures have %d restaurants with events, want 2", len(rs))
+	}
+
+	lines := encode(t, rs...)
+	contains(t, lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:"+ical.ProdID,
+		`X-WR-CALNAME:Delhi\, NCR`,
+		"BEGIN:VTIMEZONE",
+		"TZID:Asia/Kolkata",
+		"BEGIN:STANDARD",
+		"DTSTART:20180101T000000",
+		"TZOFFSETFROM:+0530",
+		"TZOFFSETTO:+0530",
+		"TZNAME:IST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+
+		"BEGIN:VEVENT",
+		"UID:159665-309629@zomato.com",
+		"DTSTAMP:20171004T063415Z",
+		"DTSTART;TZID=Asia/Kolkata:20180101T120000",
+		"DTEND;TZID=Asia/Kolkata:20180102T000000",
+		"RRULE:FREQ=DAILY;UNTIL=20180430T063000Z",
+		"SUMMARY:Kebab of the Day",
+		`LOCATION:Kebab Xpress\, 118\, 1st Floor\, Unity Metro Mall\, Rohini West Metro Station\, Rohini\, New Delhi`,
+		"GEO:28.7148332542;77.1163436025",
+		"URL;VALUE=URI:http://www.zoma.to/r/307447",
+		"END:VEVENT",
+
+		"BEGIN:VEVENT",
+		"UID:207505-18558926@zomato.com",
+		"DTSTAMP:20180405T150257Z",
+		"DTSTART;TZID=Asia/Kolkata:20180406T113000",
+		"DTEND;TZID=Asia/Kolkata:20180406T223000",
+		"RRULE:FREQ=DAILY;UNTIL=20180409T060000Z",
+		"SUMMARY:Momo'sWeekend Mania",
+		`DESCRIPTION:Tandoori Momo's\, Achaari Momo's\,Afgani Momo's and Cocktail Momo's\n\nRestaurants are solely responsible for the service\; availability and quality of the events including all or any cancellations/ modifications/ complaints.`,
+		`LOCATION:Guru Chaap Wale\, Shop Number 5\, Pocket A1\, Sector 7 (Opp M2k Cinema)`,
+		"GEO:28.701475137;77.1178929135",
+		"URL;VALUE=URI:http://www.zoma.to/r/18558926",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	)
+}
+
+func TestCalendarEncodeTimes(t *testing.T) {
+	tests := []struct {
+		name       string
+		restaurant string
+		want       []string
+		absent     string
+	}{
+		{
+			"all day",
+			`{"id":"1","location":{"city_id":1,"country_id":1},"zomato_events":[{"event":{"event_id":2,"start_date":"2018-04-06","end_date":"2018-04-08","start_time":"00:00:00","end_time":"00:00:00","is_end_time_set":0}}]}`,
+			[]string{"UID:2-1@zomato.com", "DTSTAMP:20180401T000000Z", "DTSTART;VALUE=DATE:20180406", "DTEND;VALUE=DATE:20180409"},
+			"RRULE",
+		},
+		{
+			"from start time",
+			`{"id":"1","location":{"city_id":1,"country_id":1},"zomato_events":[{"event":{"event_id":2,"start_date":"2018-04-06","end_date":"2018-04-07","start_time":"10:00:00","end_time":"00:00:00","is_end_time_set":0}}]}`,
+			[]string{"DTSTART;TZID=Asia/Kolkata:20180406T100000", "DTEND;TZID=Asia/Kolkata:20180408T000000"},
+			"RRULE",
+		},
+		{
+			"one evening",
+			`{"id":"1","location":{"city_id":1,"country_id":1},"zomato_events":[{"event":{"event_id":2,"start_date":"2018-04-06","end_date":"2018-04-06","start_time":"20:00:00","end_time":"02:00:00","is_end_time_set":1}}]}`,
+			[]string{"DTSTART;TZID=Asia/Kolkata:20180406T200000", "DTEND;TZID=Asia/Kolkata:20180407T020000"},
+			"RRULE",
+		},
+		{
+			"floating",
+			`{"id":"1","name":"Café; Bar","url":"https://www.zomato.com/1","zomato_events":[{"event":{"start_date":"2018-04-06","end_date":"2018-04-09","start_time":"11:30:00","end_time":"22:30:00","is_end_time_set":1,"event_category_name":"Music"}}]}`,
+			[]string{"UID:1-20180406@zomato.com", "DTSTART:20180406T113000", "DTEND:20180406T223000",
+				"RRULE:FREQ=DAILY;UNTIL=20180409T113000", `LOCATION:Café\; Bar`, "URL;VALUE=URI:https://www.zomato.com/1", "CATEGORIES:Music"},
+			"BEGIN:VTIMEZONE",
+		},
+	}
+
+	for _, tt := range tests {
+		lines := encode(t, restaurant(t, tt.restaurant))
+		contains(t, lines, tt.want...)
+		for _, l := range lines {
+			if strings.HasPrefix(l, tt.absent) {
+				t.Errorf("%s: Encode() has %q", tt.name, l)
+			}
+		}
+	}
+}
+
+func TestCalendarEncodeFolding(t *testing.T) {
+	title := strings.Repeat("Biryani बिरयानी, ", 10)
+	r := restaurant(t, `{"id":"1","zomato_events":[{"event":{"start_date":"2018-04-06","title":"`+title+`"}}]}`)
+
+	lines := encode(t, r)
+	contains(t, lines, "SUMMARY:"+strings.Replace(title, ",", `\,`, -1))
+}
+
+func TestCalendarAdd(t *testing.T) {
+	var cal ical.Calendar
+	cal.Add(restaurant(t, `{"zomato_events":[{"event":{"start_date":"2018-04-06"}},{"event":{"title":"Undated"}},{}]}`))
+	if cal.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", cal.Len())
+	}
+}
@@ -0,0 +1,129 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// zone is a time zone of events, from the year of the first until the year
+// after the last
+type zone struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+// zones returns the time zones of 'events', but for floating times, in
+// order of name.
+func zones(events []event) []zone {
+	byName := make(map[string]*zone)
+	for _, ev := range events {
+		loc := ev.e.StartDate.Location()
+		if loc == time.UTC {
+			continue
+		}
+		from := *ev.e.StartDate
+		to := from
+		if end, ok := ev.e.End(); ok {
+			to = end
+		}
+		z, ok := byName[loc.String()]
+		if !ok {
+			byName[loc.String()] = &zone{loc: loc, from: from, to: to}
+			continue
+		}
+		if from.Before(z.from) {
+			z.from = from
+		}
+		if to.After(z.to) {
+			z.to = to
+		}
+	}
+
+	var zs []zone
+	for _, z := range byName {
+		zs = append(zs, *z)
+	}
+	sort.Slice(zs, func(i, j int) bool { return zs[i].loc.String() < zs[j].loc.String() })
+	return zs
+}
+
+// observance is a STANDARD or DAYLIGHT observance of a time zone
+type observance struct {
+	start      time.Time
+	name       string
+	from, to   int
+	isDaylight bool
+}
+
+// observances returns the observances of the zone, the one in effect at the
+// start of its first year and then one per transition.
+func (z zone) observances() []observance {
+	t := time.Date(z.from.Year(), time.January, 1, 0, 0, 0, 0, z.loc)
+	end := time.Date(z.to.Year()+1, time.January, 1, 0, 0, 0, 0, z.loc)
+
+	name, offset := t.Zone()
+	obs := []observance{{start: t, name: name, from: offset, to: offset, isDaylight: t.IsDST()}}
+	for ; t.Before(end); t = t.Add(24 * time.Hour) {
+		next := t.Add(24 * time.Hour)
+		if _, o := next.Zone(); o == offset {
+			continue
+		}
+		// Bisect to the second of the transition
+		lo, hi := t, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, o := mid.Zone(); o == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		name, o := hi.Zone()
+		obs = append(obs, observance{
+			// Starts are in the local time of the offset before
+			start:      hi.In(time.FixedZone("", offset)),
+			name:       name,
+			from:       offset,
+			to:         o,
+			isDaylight: hi.IsDST(),
+		})
+		offset = o
+	}
+	return obs
+}
+
+// encode writes the zone as a VTIMEZONE.
+func (z zone) encode(l *lineWriter) {
+	l.line("BEGIN", "VTIMEZONE")
+	l.line("TZID", z.loc.String())
+	for _, o := range z.observances() {
+		kind := "STANDARD"
+		if o.isDaylight {
+			kind = "DAYLIGHT"
+		}
+		l.line("BEGIN", kind)
+		l.line("DTSTART", o.start.Format(dateTimeFormat))
+		l.line("TZOFFSETFROM", utcOffset(o.from))
+		l.line("TZOFFSETTO", utcOffset(o.to))
+		if o.name != "" {
+			l.line("TZNAME", escape(o.name))
+		}
+		l.line("END", kind)
+	}
+	l.line("END", "VTIMEZONE")
+}
+
+// utcOffset returns offset 'secs' east of UTC as a UTC-OFFSET value, such as
+// "+0530".
+func utcOffset(secs int) string {
+	sign := '+'
+	if secs < 0 {
+		sign, secs = '-', -secs
+	}
+	s := fmt.Sprintf("%c%02d%02d", sign, secs/3600, secs/60%60)
+	if secs%60 != 0 {
+		s += fmt.Sprintf("%02d", secs%60)
+	}
+	return s
+}
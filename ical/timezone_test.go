@@ -0,0 +1,36 @@
+package ical_test
+
+import "testing"
+
+func TestCalendarEncodeTimeZones(t *testing.T) {
+	// New York City, in daylight saving time from 11 March to 4 November 2018
+	r := restaurant(t, `{"id":"1","location":{"city_id":280,"country_id":216},"zomato_events":[{"event":{"event_id":2,"start_date":"2018-04-06","end_date":"2018-12-01","start_time":"18:00:00","end_time":"22:00:00","is_end_time_set":1}}]}`)
+
+	lines := encode(t, r)
+	contains(t, lines,
+		"BEGIN:VTIMEZONE",
+		"TZID:America/New_York",
+		"BEGIN:STANDARD",
+		"DTSTART:20180101T000000",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20180311T020000",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0400",
+		"TZNAME:EDT",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20181104T020000",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"DTSTART;TZID=America/New_York:20180406T180000",
+		"DTEND;TZID=America/New_York:20180406T220000",
+		"RRULE:FREQ=DAILY;UNTIL=20181201T230000Z",
+	)
+}
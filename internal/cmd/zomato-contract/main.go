@@ -0,0 +1,111 @@
+// Command zomato-contract checks the zomato package types against the Swagger
+// specification and reports drift as a diff.
+//
+// Usage:
+//
+//	zomato-contract -spec swagger/swagger.json -accept swagger/contract.accept
+//
+// Lines starting with '-' are spec parameters or properties with no struct
+// field, '+' are struct fields not in the spec and '~' are fields whose types
+// disagree. Findings listed in the accept file are known drift and are not
+// reported. It exits with status 1 if any drift is reported.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/contract"
+	"github.com/go-india/zomato/internal/swagger"
+)
+
+var registry = contract.Registry{
+	Requests: map[string]reflect.Type{
+		"/categories":       reflect.TypeOf(zomato.CategoriesReq{}),
+		"/cities":           reflect.TypeOf(zomato.CitiesReq{}),
+		"/collections":      reflect.TypeOf(zomato.CollectionsReq{}),
+		"/cuisines":         reflect.TypeOf(zomato.CuisinesReq{}),
+		"/dailymenu":        reflect.TypeOf(zomato.DailyMenuReq{}),
+		"/establishments":   reflect.TypeOf(zomato.EstablishmentsReq{}),
+		"/geocode":          reflect.TypeOf(zomato.GeoCodeReq{}),
+		"/location_details": reflect.TypeOf(zomato.LocationDetailsReq{}),
+		"/locations":        reflect.TypeOf(zomato.LocationsReq{}),
+		"/restaurant":       reflect.TypeOf(zomato.RestaurantReq{}),
+		"/reviews":          reflect.TypeOf(zomato.ReviewsReq{}),
+		"/search":           reflect.TypeOf(zomato.SearchReq{}),
+	},
+	Models: map[string]reflect.Type{
+		"Categories":        reflect.TypeOf(zomato.Categorie{}),
+		"City":              reflect.TypeOf(zomato.City{}),
+		"Collection":        reflect.TypeOf(zomato.Collection{}),
+		"Cuisine":           reflect.TypeOf(zomato.Cuisine{}),
+		"DailyMenu":         reflect.TypeOf(zomato.DailyMenuResp{}),
+		"DailyMenuCategory": reflect.TypeOf(zomato.DailyMenu{}),
+		"DailyMenuItem":     reflect.TypeOf(zomato.Dish{}),
+		"Establishment":     reflect.TypeOf(zomato.Establishment{}),
+		"Geocode":           reflect.TypeOf(zomato.GeoCodeResp{}),
+		"Location":          reflect.TypeOf(zomato.Location{}),
+		"LocationDetails":   reflect.TypeOf(zomato.LocationDetailsResp{}),
+		"Photo":             reflect.TypeOf(zomato.Photo{}),
+		"Popularity":        reflect.TypeOf(zomato.Popularity{}),
+		"ResLocation":       reflect.TypeOf(zomato.RestaurantLocation{}),
+		"Restaurant":        reflect.TypeOf(zomato.Restaurant{}),
+		"Review":            reflect.TypeOf(zomato.Review{}),
+		"Search":            reflect.TypeOf(zomato.SearchResp{}),
+		"User":              reflect.TypeOf(zomato.User{}),
+		"UserRating":        reflect.TypeOf(zomato.UserRating{}),
+	},
+}
+
+func main() {
+	var (
+		specPath   = flag.String("spec", "swagger/swagger.json", "path of the Swagger specification")
+		acceptPath = flag.String("accept", "", "file listing accepted findings, one key per line")
+		keys       = flag.Bool("keys", false, "print keys of findings for the accept file instead of a diff")
+	)
+	flag.Parse()
+
+	findings, err := run(*specPath, *acceptPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "zomato-contract:", err)
+		os.Exit(2)
+	}
+
+	if *keys {
+		for _, f := range findings {
+			fmt.Println(f.Key())
+		}
+	} else if err := contract.Report(os.Stdout, findings); err != nil {
+		fmt.Fprintln(os.Stderr, "zomato-contract:", err)
+		os.Exit(2)
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func run(specPath, acceptPath string) ([]contract.Finding, error) {
+	spec, err := swagger.Load(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := contract.Check(spec, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	if acceptPath == "" {
+		return findings, nil
+	}
+
+	accepted, err := contract.ReadAccept(acceptPath)
+	if err != nil {
+		return nil, err
+	}
+	return contract.Filter(findings, accepted), nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-india/zomato/internal/contract"
+)
+
+func TestContract(t *testing.T) {
+	findings, err := run("../../../swagger/swagger.json", "../../../swagger/contract.accept")
+	if err != nil {
+		t.Fatalf("run failed: %+v", err)
+	}
+
+	if len(findings) > 0 {
+		var buf bytes.Buffer
+		contract.Report(&buf, findings)
+		t.Fatalf("zomato package drifted from swagger.json:\n%s", buf.String())
+	}
+}
+
+func TestContractAcceptMissing(t *testing.T) {
+	if _, err := run("../../../swagger/swagger.json", "missing.accept"); err == nil {
+		t.Fatal("expected error for missing accept file")
+	}
+}
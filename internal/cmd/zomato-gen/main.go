@@ -0,0 +1,64 @@
+// Command zomato-gen generates request types, Client methods and the API
+// interface of the zomato package from the Swagger specification, and the
+// mock of the zomatotest package.
+//
+// Usage:
+//
+//	zomato-gen -spec swagger/swagger.json -overlay swagger/overlay.json -o requests_gen.go -mock zomatotest/mock_gen.go
+//
+// It is run by 'go generate' from the repository root.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/go-india/zomato/internal/gen"
+	"github.com/go-india/zomato/internal/swagger"
+)
+
+func main() {
+	var (
+		specPath    = flag.String("spec", "swagger/swagger.json", "path of the Swagger specification")
+		overlayPath = flag.String("overlay", "swagger/overlay.json", "path of the Go overlay for the specification")
+		out         = flag.String("o", "requests_gen.go", "output file")
+		mockOut     = flag.String("mock", "", "output file of the mock; not generated if empty")
+	)
+	flag.Parse()
+
+	if err := run(*specPath, *overlayPath, *out, *mockOut); err != nil {
+		fmt.Fprintln(os.Stderr, "zomato-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, overlayPath, out, mockOut string) error {
+	spec, err := swagger.Load(specPath)
+	if err != nil {
+		return err
+	}
+
+	overlay, err := gen.LoadOverlay(overlayPath)
+	if err != nil {
+		return err
+	}
+
+	src, err := gen.Generate(spec, overlay)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(out, src, 0644); err != nil {
+		return err
+	}
+
+	if mockOut == "" {
+		return nil
+	}
+	mock, err := gen.GenerateMock(spec, overlay)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(mockOut, mock, 0644)
+}
@@ -0,0 +1,358 @@
+// Package contract compares Go request and response types of the zomato
+// package with the Swagger specification of the API.
+//
+// Request types are matched to operation query parameters using their url
+// tags. Response types are matched to definition properties using the JSON
+// keys they marshal to, so custom MarshalJSON methods are honoured.
+package contract
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-india/zomato/internal/swagger"
+	"github.com/pkg/errors"
+)
+
+// Kind of a Finding
+type Kind string
+
+// Finding kinds
+const (
+	Missing  Kind = "missing"  // spec defines it, Go type has no field for it
+	Extra    Kind = "extra"    // Go type has a field the spec does not define
+	Mismatch Kind = "mismatch" // types of spec and Go field disagree
+)
+
+// Registry maps the specification to Go types
+type Registry struct {
+	// Requests holds request types by operation path, e.g. "/search"
+	Requests map[string]reflect.Type
+	// Models holds response types by definition name, e.g. "Restaurant"
+	Models map[string]reflect.Type
+}
+
+// Finding is a single difference between the specification and a Go type
+type Finding struct {
+	Kind   Kind
+	Type   string // Go type name
+	Source string // operation or definition, e.g. "GET /search"
+	Name   string // parameter or property name
+	Spec   string // type in the spec
+	Field  string // Go field and its type
+}
+
+// Key identifies the finding in an accept list.
+func (f Finding) Key() string {
+	return fmt.Sprintf("%s %s %s", f.Type, f.Name, f.Kind)
+}
+
+// String renders the finding as a diff line.
+func (f Finding) String() string {
+	switch f.Kind {
+	case Missing:
+		return fmt.Sprintf("-\t%s %s\t(spec has no struct field)", f.Name, f.Spec)
+	case Extra:
+		return fmt.Sprintf("+\t%s %s\t(struct field not in spec)", f.Name, f.Field)
+	}
+	return fmt.Sprintf("~\t%s\t(spec %s, Go %s)", f.Name, f.Spec, f.Field)
+}
+
+// Check compares the types in 'reg' with 'spec'.
+//
+// Findings are sorted by Go type and parameter or property name.
+func Check(spec *swagger.Spec, reg Registry) ([]Finding, error) {
+	var findings []Finding
+
+	for path, typ := range reg.Requests {
+		item, ok := spec.Paths[path]
+		if !ok || item.Get == nil {
+			return nil, errors.Errorf("operation GET %s not in spec", path)
+		}
+		findings = append(findings, checkRequest(item.Get, "GET "+path, typ)...)
+	}
+
+	for name, typ := range reg.Models {
+		def, ok := spec.Definitions[name]
+		if !ok {
+			return nil, errors.Errorf("definition %s not in spec", name)
+		}
+		fs, err := checkModel(def, "#/definitions/"+name, typ)
+		if err != nil {
+			return nil, errors.Wrapf(err, "check %s failed", typ)
+		}
+		findings = append(findings, fs...)
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Type != findings[j].Type {
+			return findings[i].Type < findings[j].Type
+		}
+		return findings[i].Name < findings[j].Name
+	})
+	return findings, nil
+}
+
+// Filter returns findings whose keys are not in 'accepted'.
+func Filter(findings []Finding, accepted map[string]bool) []Finding {
+	var out []Finding
+	for _, f := range findings {
+		if !accepted[f.Key()] {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// ReadAccept reads finding keys from 'path', skipping blank and '#' comment
+// lines.
+func ReadAccept(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open accept file failed")
+	}
+	defer f.Close()
+
+	accepted := map[string]bool{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			accepted[line] = true
+		}
+	}
+	return accepted, errors.Wrap(s.Err(), "read accept file failed")
+}
+
+// Report writes 'findings' grouped by Go type as a readable diff.
+func Report(w io.Writer, findings []Finding) error {
+	var last string
+	for _, f := range findings {
+		if f.Type != last {
+			if last != "" {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s (%s)\n", f.Type, f.Source)
+			last = f.Type
+		}
+		if _, err := fmt.Fprintln(w, f); err != nil {
+			return errors.Wrap(err, "write report failed")
+		}
+	}
+	return nil
+}
+
+func checkRequest(op *swagger.Operation, source string, typ reflect.Type) []Finding {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := strings.Split(f.Tag.Get("url"), ",")[0]
+		if f.PkgPath != "" || name == "" || name == "-" {
+			continue
+		}
+		fields[name] = f
+	}
+
+	var findings []Finding
+	params := map[string]bool{}
+	for _, p := range op.QueryParams() {
+		params[p.Name] = true
+		finding := Finding{Type: typ.Name(), Source: source, Name: p.Name, Spec: p.Type}
+
+		f, ok := fields[p.Name]
+		if !ok {
+			finding.Kind = Missing
+			findings = append(findings, finding)
+			continue
+		}
+
+		goKind := kind(f.Type)
+		if p.CollectionFormat == "csv" && goKind == "array" {
+			goKind = "string"
+		}
+		if !compatible(p.Type, goKind) {
+			finding.Kind = Mismatch
+			finding.Field = fmt.Sprintf("%s %s", f.Name, f.Type)
+			findings = append(findings, finding)
+		}
+	}
+
+	for name, f := range fields {
+		if !params[name] {
+			findings = append(findings, Finding{
+				Kind:   Extra,
+				Type:   typ.Name(),
+				Source: source,
+				Name:   name,
+				Field:  fmt.Sprintf("%s %s", f.Name, f.Type),
+			})
+		}
+	}
+	return findings
+}
+
+func checkModel(def swagger.Schema, source string, typ reflect.Type) ([]Finding, error) {
+	fields, err := jsonFields(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for name, prop := range def.Properties {
+		specType := prop.Type
+		if specType == "" && prop.Ref != "" {
+			specType = "object"
+		}
+		finding := Finding{Type: typ.Name(), Source: source, Name: name, Spec: specType}
+
+		f, ok := fields[name]
+		if !ok {
+			finding.Kind = Missing
+			findings = append(findings, finding)
+			continue
+		}
+
+		if !compatible(specType, kind(f.Type)) {
+			finding.Kind = Mismatch
+			finding.Field = fmt.Sprintf("%s %s", f.Name, f.Type)
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+// jsonFields returns fields of struct 'typ' by the JSON keys they marshal to.
+//
+// Each field is set to a sample value in turn; keys whose marshalled value
+// differs from the zero struct belong to that field.
+func jsonFields(typ reflect.Type) (map[string]reflect.StructField, error) {
+	base, err := marshalKeys(reflect.New(typ).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		v := reflect.New(typ).Elem()
+		v.Field(i).Set(sample(f.Type, 0))
+
+		keys, err := marshalKeys(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", f.Name)
+		}
+		for k, raw := range keys {
+			if !bytes.Equal(base[k], raw) {
+				fields[k] = f
+			}
+		}
+	}
+	return fields, nil
+}
+
+func marshalKeys(v reflect.Value) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal failed")
+	}
+
+	var keys map[string]json.RawMessage
+	return keys, errors.Wrap(json.Unmarshal(data, &keys), "unmarshal failed")
+}
+
+// maxSampleDepth limits sample values of recursive types
+const maxSampleDepth = 3
+
+// sample returns a non-zero value of 'typ'.
+func sample(typ reflect.Type, depth int) reflect.Value {
+	v := reflect.New(typ).Elem()
+	if depth > maxSampleDepth {
+		return v
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(typ.Elem()))
+		v.Elem().Set(sample(typ.Elem(), depth+1))
+	case reflect.Struct:
+		if typ == timeType {
+			v.Set(reflect.ValueOf(time.Unix(1e9, 0)))
+			break
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).PkgPath == "" {
+				v.Field(i).Set(sample(typ.Field(i).Type, depth+1))
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.Append(v, sample(typ.Elem(), depth+1)))
+	case reflect.Interface:
+		if s := reflect.ValueOf("sample"); s.Type().AssignableTo(typ) {
+			v.Set(s)
+		}
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("sample")
+	}
+	return v
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// kind returns the Swagger type matching Go type 'typ'.
+func kind(typ reflect.Type) string {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == timeType {
+		return "time"
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Interface:
+		return "any"
+	}
+	return "object"
+}
+
+// compatible reports whether Swagger type 'spec' can be held by Go kind 'goKind'.
+func compatible(spec, goKind string) bool {
+	switch goKind {
+	case spec, "any":
+		return true
+	case "time":
+		// timestamps are sent either as formatted strings or unix seconds
+		return spec == "string" || spec == "integer"
+	}
+	return false
+}
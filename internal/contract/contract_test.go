@@ -0,0 +1,130 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato/internal/contract"
+	"github.com/go-india/zomato/internal/swagger"
+)
+
+const testSpec = `{
+	"paths": {
+		"/search": {"get": {"parameters": [
+			{"name": "user-key", "in": "header", "type": "string"},
+			{"name": "q", "in": "query", "type": "string"},
+			{"name": "count", "in": "query", "type": "integer"},
+			{"name": "cuisines", "in": "query", "type": "string", "collectionFormat": "csv"},
+			{"name": "radius", "in": "query", "type": "number"}
+		]}}
+	},
+	"definitions": {
+		"Dish": {"properties": {
+			"id": {"type": "integer"},
+			"name": {"type": "string"},
+			"vegetarian": {"type": "boolean"},
+			"added": {"type": "integer"},
+			"price": {"type": "number"}
+		}}
+	}
+}`
+
+type searchReq struct {
+	Query    string   `url:"q,omitempty"`
+	Count    string   `url:"count,omitempty"`
+	Cuisines []string `url:"cuisines,comma,omitempty"`
+	Sort     string   `url:"sort,omitempty"`
+}
+
+type dish struct {
+	ID    *int64     `json:"id,omitempty"`
+	Name  *string    `json:"name,omitempty"`
+	Added *time.Time `json:"-"`
+	Price *string    `json:"price,omitempty"`
+}
+
+func (d dish) MarshalJSON() ([]byte, error) {
+	type Alias dish
+	t := struct {
+		Alias
+		Added int64 `json:"added,omitempty"`
+	}{Alias: Alias(d)}
+
+	if d.Added != nil {
+		t.Added = d.Added.Unix()
+	}
+	return json.Marshal(t)
+}
+
+func TestCheck(t *testing.T) {
+	var spec swagger.Spec
+	if err := json.Unmarshal([]byte(testSpec), &spec); err != nil {
+		t.Fatalf("decode spec failed: %+v", err)
+	}
+
+	findings, err := contract.Check(&spec, contract.Registry{
+		Requests: map[string]reflect.Type{"/search": reflect.TypeOf(searchReq{})},
+		Models:   map[string]reflect.Type{"Dish": reflect.TypeOf(dish{})},
+	})
+	if err != nil {
+		t.Fatalf("Check failed: %+v", err)
+	}
+
+	var keys []string
+	for _, f := range findings {
+		keys = append(keys, f.Key())
+	}
+
+	expected := []string{
+		"dish price mismatch",
+		"dish vegetarian missing",
+		"searchReq count mismatch",
+		"searchReq radius missing",
+		"searchReq sort extra",
+	}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected: `%v`, actual `%v`", expected, keys)
+	}
+
+	filtered := contract.Filter(findings, map[string]bool{"searchReq sort extra": true})
+	if len(filtered) != len(findings)-1 {
+		t.Fatalf("expected %d findings after filter, actual %d", len(findings)-1, len(filtered))
+	}
+
+	var buf bytes.Buffer
+	if err := contract.Report(&buf, findings); err != nil {
+		t.Fatalf("Report failed: %+v", err)
+	}
+	for _, line := range []string{
+		"searchReq (GET /search)",
+		"-\tradius number",
+		"+\tsort Sort string",
+		"~\tcount\t(spec integer, Go Count string)",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Fatalf("report missing `%s`:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestCheckUnknown(t *testing.T) {
+	var spec swagger.Spec
+
+	_, err := contract.Check(&spec, contract.Registry{
+		Requests: map[string]reflect.Type{"/search": reflect.TypeOf(searchReq{})},
+	})
+	if err == nil {
+		t.Fatal("expected error for operation not in spec")
+	}
+
+	_, err = contract.Check(&spec, contract.Registry{
+		Models: map[string]reflect.Type{"Dish": reflect.TypeOf(dish{})},
+	})
+	if err == nil {
+		t.Fatal("expected error for definition not in spec")
+	}
+}
@@ -0,0 +1,487 @@
+// Package gen generates request types, their Requester implementations,
+// Client methods and the API interface of the zomato package from the Swagger
+// specification, along with the mock of the zomatotest package.
+//
+// The specification lacks Go specific details like identifiers, parameter
+// types and response models, these are supplied by an overlay file. Response
+// models and their JSON quirks stay hand-written in the zomato package.
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/go-india/zomato/internal/swagger"
+	"github.com/pkg/errors"
+)
+
+// Overlay holds Go specific details missing from the specification
+type Overlay struct {
+	// Params holds defaults for parameters by name, used by every operation
+	Params map[string]Param `json:"params"`
+	// Operations holds operation details by path
+	Operations map[string]Operation `json:"operations"`
+}
+
+// Param holds Go details of a query parameter
+type Param struct {
+	Name string `json:"name,omitempty"` // Go field name
+	Type string `json:"type,omitempty"` // Go field type
+	Doc  string `json:"doc,omitempty"`  // field comment; defaults to spec description
+}
+
+// Operation holds Go details of an API operation
+type Operation struct {
+	// Name of the Client method; request type is named Name+"Req"
+	Name string `json:"name,omitempty"`
+	// Response type of the Client method; defaults to json.RawMessage
+	Response string `json:"response,omitempty"`
+	// Doc holds method comment lines; defaults to spec summary
+	Doc []string `json:"doc,omitempty"`
+	// Params overrides the default parameter details for this operation
+	Params map[string]Param `json:"params,omitempty"`
+	// Args lists parameters the Client method takes as arguments instead of a request
+	Args []Arg `json:"args,omitempty"`
+}
+
+// Arg maps a parameter to a Client method argument
+type Arg struct {
+	Param string `json:"param"` // parameter name in the spec
+	Name  string `json:"name"`  // Go argument name
+}
+
+// LoadOverlay reads the overlay from the JSON file at 'path'.
+func LoadOverlay(path string) (*Overlay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open overlay failed")
+	}
+	defer f.Close()
+
+	var o Overlay
+	if err := json.NewDecoder(f).Decode(&o); err != nil {
+		return nil, errors.Wrap(err, "decode overlay failed")
+	}
+	return &o, nil
+}
+
+// Endpoint is an API operation ready to be rendered
+type Endpoint struct {
+	Name     string
+	Path     string
+	Response string
+	Doc      []string
+	Fields   []Field
+	Args     []Field // method arguments; nil if method takes a request
+	Validate bool    // request has required parameters
+}
+
+// Field is a request struct field
+type Field struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  string
+	Arg  string // Client method argument name
+}
+
+// Endpoints resolves the operations of 'spec' using 'overlay'.
+//
+// Endpoints are sorted by name.
+func Endpoints(spec *swagger.Spec, overlay *Overlay) ([]Endpoint, error) {
+	// DefaultBaseURL already holds the '/api' part of spec's base path
+	prefix := strings.TrimPrefix(spec.BasePath, "/api")
+
+	var endpoints []Endpoint
+	for _, path := range spec.SortedPaths() {
+		op := spec.Paths[path].Get
+		if op == nil {
+			continue
+		}
+
+		o := overlay.Operations[path]
+		e := Endpoint{
+			Name:     o.Name,
+			Path:     prefix + path,
+			Response: o.Response,
+			Doc:      o.Doc,
+		}
+		if e.Name == "" {
+			e.Name = camel(op.OperationID)
+		}
+		if e.Response == "" {
+			e.Response = "json.RawMessage"
+		}
+		if len(e.Doc) == 0 {
+			e.Doc = []string{e.Name + " " + lowerFirst(strings.TrimSuffix(op.Summary, ".")) + "."}
+		}
+
+		for _, p := range op.QueryParams() {
+			f := field(p, overlay.Params[p.Name], o.Params[p.Name])
+			e.Fields = append(e.Fields, f)
+			e.Validate = e.Validate || p.Required
+		}
+
+		for _, a := range o.Args {
+			var found bool
+			for _, f := range e.Fields {
+				if f.Name != "" && paramName(f) == a.Param {
+					f.Arg = a.Name
+					e.Args = append(e.Args, f)
+					found = true
+				}
+			}
+			if !found {
+				return nil, errors.Errorf("%s: argument %q is not a query parameter", path, a.Param)
+			}
+		}
+		endpoints = append(endpoints, e)
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Name < endpoints[j].Name })
+	return endpoints, nil
+}
+
+// Generate renders Go source of the zomato package for 'spec' and 'overlay'.
+func Generate(spec *swagger.Spec, overlay *Overlay) ([]byte, error) {
+	endpoints, err := Endpoints(spec, overlay)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve endpoints failed")
+	}
+
+	return render(tmpl, endpoints)
+}
+
+// GenerateMock renders Go source of the zomatotest package mock for 'spec'
+// and 'overlay'.
+func GenerateMock(spec *swagger.Spec, overlay *Overlay) ([]byte, error) {
+	endpoints, err := Endpoints(spec, overlay)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve endpoints failed")
+	}
+	return render(mockTmpl, endpoints)
+}
+
+// render executes 't' with 'endpoints' and formats the result.
+func render(t *template.Template, endpoints []Endpoint) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, endpoints); err != nil {
+		return nil, errors.Wrap(err, "execute template failed")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "format source failed:\n%s", buf.Bytes())
+	}
+	return src, nil
+}
+
+// field merges parameter 'p' with its default and operation overlays.
+func field(p swagger.Parameter, def, op Param) Field {
+	f := Field{Name: camel(p.Name), Type: goType(p), Doc: oneLine(p.Description)}
+	for _, o := range []Param{def, op} {
+		if o.Name != "" {
+			f.Name = o.Name
+		}
+		if o.Type != "" {
+			f.Type = o.Type
+		}
+		if o.Doc != "" {
+			f.Doc = o.Doc
+		}
+	}
+
+	url := p.Name
+	if p.CollectionFormat == "csv" && strings.HasPrefix(f.Type, "[]") {
+		url += ",comma"
+	}
+	if !p.Required {
+		url += ",omitempty"
+	}
+	f.Tag = `url:"` + url + `"`
+	if p.Required {
+		f.Tag += ` validate:"required"`
+	}
+	return f
+}
+
+// paramName returns spec parameter name from the url tag of 'f'.
+func paramName(f Field) string {
+	name := strings.TrimPrefix(f.Tag, `url:"`)
+	return name[:strings.IndexAny(name, `,"`)]
+}
+
+// goType returns the default Go type of parameter 'p'.
+func goType(p swagger.Parameter) string {
+	switch p.Type {
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "string"
+}
+
+// camel converts snake_case and kebab-case names to CamelCase.
+func camel(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	for i, p := range parts {
+		switch p {
+		case "id", "url":
+			parts[i] = strings.ToUpper(p)
+		default:
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// oneLine collapses whitespace in 's'.
+func oneLine(s string) string { return strings.Join(strings.Fields(s), " ") }
+
+// args renders method arguments, grouping consecutive ones of the same type,
+// with types qualified by package 'pkg' if not empty.
+func args(fields []Field, pkg string) string {
+	var buf strings.Builder
+	for i, f := range fields {
+		buf.WriteString(", " + f.Arg)
+		if i+1 == len(fields) || fields[i+1].Type != f.Type {
+			buf.WriteString(" " + qualify(f.Type, pkg))
+		}
+	}
+	return buf.String()
+}
+
+// params renders the parameters of the Client method of 'e' following the
+// context, qualified by package 'pkg' if not empty.
+func params(e Endpoint, pkg string) string {
+	switch {
+	case e.Args != nil:
+		return args(e.Args, pkg)
+	case e.Fields != nil:
+		return ", req " + qualify(e.Name+"Req", pkg)
+	}
+	return ""
+}
+
+// call renders the arguments passing the parameters of the Client method of
+// 'e' following the context.
+func call(e Endpoint) string {
+	switch {
+	case e.Args != nil:
+		var buf strings.Builder
+		for _, f := range e.Args {
+			buf.WriteString(", " + f.Arg)
+		}
+		return buf.String()
+	case e.Fields != nil:
+		return ", req"
+	}
+	return ""
+}
+
+// qualify prefixes exported type 't' with package 'pkg' if not empty.
+func qualify(t, pkg string) string {
+	if pkg == "" || t == "" || !unicode.IsUpper([]rune(t)[0]) {
+		return t
+	}
+	return pkg + "." + t
+}
+
+func usesJSON(endpoints []Endpoint) bool {
+	for _, e := range endpoints {
+		if strings.HasPrefix(e.Response, "json.") {
+			return true
+		}
+	}
+	return false
+}
+
+var funcs = template.FuncMap{
+	"args":     args,
+	"call":     call,
+	"params":   params,
+	"qualify":  qualify,
+	"usesJSON": usesJSON,
+}
+
+var tmpl = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by zomato-gen from swagger/swagger.json; DO NOT EDIT.
+
+package zomato
+
+import (
+	"context"
+	{{- if usesJSON .}}
+	"encoding/json"
+	{{- end}}
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	"github.com/pkg/errors"
+)
+{{range .}}
+// {{.Name}}Req parameters
+{{- if .Fields}}
+type {{.Name}}Req struct {
+	{{- range .Fields}}
+	// {{.Doc}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+	{{- end}}
+}
+{{- else}}
+type {{.Name}}Req struct{}
+{{- end}}
+
+// Request encodes {{.Name}}Req parameters returning a new http.Request
+func (r {{.Name}}Req) Request() (*http.Request, error) {
+	{{- if .Validate}}
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	{{end}}
+	urlStr := DefaultBaseURL + "{{.Path}}"
+	{{- if .Fields}}
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+	{{end}}
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+{{range .Doc}}
+//{{if .}} {{.}}{{end}}
+{{- end}}
+func (c Client) {{.Name}}(ctx context.Context{{params . ""}}) (resp {{.Response}}, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	{{- if .Args}}
+
+	req := {{.Name}}Req{
+		{{- range .Args}}
+		{{.Name}}: {{.Arg}},
+		{{- end}}
+	}
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	{{- else if .Fields}}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	{{- else}}
+
+	err = c.Do(c.Auth(WithCtx(ctx, {{.Name}}Req{})), &resp)
+	{{- end}}
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+{{end}}
+// API lists the endpoint methods of Client, so that decorators and fakes can
+// stand in for it.
+type API interface {
+	{{- range .}}
+	{{.Name}}(ctx context.Context{{params . ""}}) ({{.Response}}, error)
+	{{- end}}
+}
+
+var _ API = Client{}
+
+// Interceptor is called for each call of API method 'method' with request
+// 'req'. It makes the call using 'invoke' and returns its response.
+type Interceptor func(ctx context.Context, method string, req Requester,
+	invoke func(ctx context.Context) (interface{}, error)) (interface{}, error)
+
+// Intercept returns an API making the calls of 'api' through 'f'.
+func Intercept(api API, f Interceptor) API {
+	return intercepted{api: api, f: f}
+}
+
+type intercepted struct {
+	api API
+	f   Interceptor
+}
+{{range .}}
+// {{.Name}} implements API
+func (a intercepted) {{.Name}}(ctx context.Context{{params . ""}}) (resp {{.Response}}, err error) {
+	{{- if .Args}}
+	req := {{.Name}}Req{
+		{{- range .Args}}
+		{{.Name}}: {{.Arg}},
+		{{- end}}
+	}
+	{{- else if not .Fields}}
+	req := {{.Name}}Req{}
+	{{- end}}
+	v, err := a.f(ctx, "{{.Name}}", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.{{.Name}}(ctx{{call .}})
+	})
+	if v, ok := v.({{.Response}}); ok {
+		resp = v
+	}
+	return resp, err
+}
+{{end}}`))
+
+var mockTmpl = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by zomato-gen from swagger/swagger.json; DO NOT EDIT.
+
+package zomatotest
+
+import (
+	"context"
+	{{- if usesJSON .}}
+	"encoding/json"
+	{{- end}}
+
+	"github.com/go-india/zomato"
+)
+
+// Mock implements zomato.API by calling its function fields, recording
+// each call. Methods whose function is nil return ErrNotMocked.
+type Mock struct {
+	recorder
+	{{range .}}
+	{{.Name}}Func func(ctx context.Context{{params . "zomato"}}) ({{qualify .Response "zomato"}}, error)
+	{{- end}}
+}
+
+var _ zomato.API = &Mock{}
+{{range .}}
+// {{.Name}} implements zomato.API
+func (m *Mock) {{.Name}}(ctx context.Context{{params . "zomato"}}) (resp {{qualify .Response "zomato"}}, err error) {
+	{{- if .Args}}
+	m.record("{{.Name}}", zomato.{{.Name}}Req{
+		{{- range .Args}}
+		{{.Name}}: {{.Arg}},
+		{{- end}}
+	})
+	{{- else if .Fields}}
+	m.record("{{.Name}}", req)
+	{{- else}}
+	m.record("{{.Name}}", zomato.{{.Name}}Req{})
+	{{- end}}
+	if m.{{.Name}}Func == nil {
+		return resp, notMocked("{{.Name}}")
+	}
+	return m.{{.Name}}Func(ctx{{call .}})
+}
+{{end}}`))
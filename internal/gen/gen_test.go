@@ -0,0 +1,112 @@
+package gen_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/go-india/zomato/internal/gen"
+	"github.com/go-india/zomato/internal/swagger"
+)
+
+func load(t *testing.T) (*swagger.Spec, *gen.Overlay) {
+	spec, err := swagger.Load("../../swagger/swagger.json")
+	if err != nil {
+		t.Fatalf("Load failed: %+v", err)
+	}
+
+	overlay, err := gen.LoadOverlay("../../swagger/overlay.json")
+	if err != nil {
+		t.Fatalf("LoadOverlay failed: %+v", err)
+	}
+	return spec, overlay
+}
+
+func TestGenerateUpToDate(t *testing.T) {
+	spec, overlay := load(t)
+
+	src, err := gen.Generate(spec, overlay)
+	if err != nil {
+		t.Fatalf("Generate failed: %+v", err)
+	}
+
+	current, err := ioutil.ReadFile("../../requests_gen.go")
+	if err != nil {
+		t.Fatalf("read generated file failed: %+v", err)
+	}
+
+	if !bytes.Equal(src, current) {
+		t.Fatal("requests_gen.go is out of date; run 'go generate' in the repository root")
+	}
+}
+
+func TestGenerateMockUpToDate(t *testing.T) {
+	spec, overlay := load(t)
+
+	src, err := gen.GenerateMock(spec, overlay)
+	if err != nil {
+		t.Fatalf("GenerateMock failed: %+v", err)
+	}
+
+	current, err := ioutil.ReadFile("../../zomatotest/mock_gen.go")
+	if err != nil {
+		t.Fatalf("read generated file failed: %+v", err)
+	}
+
+	if !bytes.Equal(src, current) {
+		t.Fatal("zomatotest/mock_gen.go is out of date; run 'go generate' in the repository root")
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	spec, overlay := load(t)
+
+	endpoints, err := gen.Endpoints(spec, overlay)
+	if err != nil {
+		t.Fatalf("Endpoints failed: %+v", err)
+	}
+
+	tests := []struct {
+		endpoint string
+		field    string
+		typ      string
+		tag      string
+	}{
+		{"Cities", "CityIDs", "[]int64", `url:"city_ids,comma,omitempty"`},
+		{"GeoCode", "Latitude", "float64", `url:"lat" validate:"required"`},
+		{"LocationDetails", "EntityType", "EntityType", `url:"entity_type" validate:"required"`},
+		{"Search", "Cuisines", "[]string", `url:"cuisines,comma,omitempty"`},
+		{"Search", "Radius", "float64", `url:"radius,omitempty"`},
+	}
+
+	for _, tt := range tests {
+		var found bool
+		for _, e := range endpoints {
+			for _, f := range e.Fields {
+				if e.Name != tt.endpoint || f.Name != tt.field {
+					continue
+				}
+				found = true
+				if f.Type != tt.typ || f.Tag != tt.tag {
+					t.Errorf("%s.%s: expected `%s %s`, actual `%s %s`",
+						tt.endpoint, tt.field, tt.typ, tt.tag, f.Type, f.Tag)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s.%s not generated", tt.endpoint, tt.field)
+		}
+	}
+}
+
+func TestEndpointsUnknownArg(t *testing.T) {
+	spec, overlay := load(t)
+
+	op := overlay.Operations["/geocode"]
+	op.Args = []gen.Arg{{Param: "radius", Name: "radius"}}
+	overlay.Operations["/geocode"] = op
+
+	if _, err := gen.Endpoints(spec, overlay); err == nil {
+		t.Fatal("expected error for argument which is not a query parameter")
+	}
+}
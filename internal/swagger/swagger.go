@@ -0,0 +1,110 @@
+// Package swagger loads the Swagger 2.0 specification of the Zomato API.
+//
+// Only the parts of the specification used by the code generator and the
+// contract checker are decoded.
+package swagger
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Spec holds a Swagger 2.0 specification
+type Spec struct {
+	Host        string              `json:"host"`
+	BasePath    string              `json:"basePath"`
+	Paths       map[string]PathItem `json:"paths"`
+	Definitions map[string]Schema   `json:"definitions"`
+}
+
+// PathItem holds operations available on a single path
+type PathItem struct {
+	Get *Operation `json:"get,omitempty"`
+}
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+	Tags        []string            `json:"tags"`
+}
+
+// Parameter describes a single operation parameter
+type Parameter struct {
+	Name             string   `json:"name"`
+	In               string   `json:"in"` // one of [query, header, path, formData, body]
+	Description      string   `json:"description"`
+	Required         bool     `json:"required"`
+	Type             string   `json:"type"`
+	Format           string   `json:"format"`
+	Enum             []string `json:"enum"`
+	CollectionFormat string   `json:"collectionFormat"`
+}
+
+// Response describes a single response from an API operation
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Schema describes a data type
+type Schema struct {
+	Ref         string            `json:"$ref"`
+	Type        string            `json:"type"`
+	Format      string            `json:"format"`
+	Description string            `json:"description"`
+	Items       *Schema           `json:"items,omitempty"`
+	Properties  map[string]Schema `json:"properties"`
+}
+
+// Load reads the specification from the JSON file at 'path'.
+func Load(path string) (*Spec, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open spec failed")
+	}
+	defer f.Close()
+
+	var spec Spec
+	if err := json.NewDecoder(f).Decode(&spec); err != nil {
+		return nil, errors.Wrap(err, "decode spec failed")
+	}
+	return &spec, nil
+}
+
+// SortedPaths returns paths of the specification in lexical order.
+func (s *Spec) SortedPaths() []string {
+	paths := make([]string, 0, len(s.Paths))
+	for path := range s.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Resolve returns the definition referenced by 'ref', e.g. "#/definitions/City".
+func (s *Spec) Resolve(ref string) (Schema, bool) {
+	name := strings.TrimPrefix(ref, "#/definitions/")
+	def, ok := s.Definitions[name]
+	return def, ok
+}
+
+// QueryParams returns the query parameters of the operation.
+//
+// Header parameters are skipped as the API key is added by the client authenticator.
+func (o *Operation) QueryParams() []Parameter {
+	var params []Parameter
+	for _, p := range o.Parameters {
+		if p.In == "query" {
+			params = append(params, p)
+		}
+	}
+	return params
+}
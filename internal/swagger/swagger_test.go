@@ -0,0 +1,38 @@
+package swagger_test
+
+import (
+	"testing"
+
+	"github.com/go-india/zomato/internal/swagger"
+)
+
+func TestLoad(t *testing.T) {
+	spec, err := swagger.Load("../../swagger/swagger.json")
+	if err != nil {
+		t.Fatalf("Load failed: %+v", err)
+	}
+
+	if spec.BasePath != "/api/v2.1" {
+		t.Fatalf("expected basePath `/api/v2.1`, actual `%s`", spec.BasePath)
+	}
+
+	op := spec.Paths["/geocode"].Get
+	if op == nil {
+		t.Fatal("GET /geocode missing")
+	}
+
+	params := op.QueryParams()
+	if len(params) != 2 || params[0].Name != "lat" || !params[0].Required {
+		t.Fatalf("unexpected /geocode query params: %+v", params)
+	}
+
+	if _, ok := spec.Resolve("#/definitions/Restaurant"); !ok {
+		t.Fatal("Restaurant definition not resolved")
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	if _, err := swagger.Load("missing.json"); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
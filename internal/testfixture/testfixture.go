@@ -0,0 +1,21 @@
+// Package testfixture decodes the API responses of the testdata directory in
+// tests of packages one directory below the module root.
+package testfixture
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// Decode decodes testdata file 'name' into 'v', failing the test on error.
+func Decode(t *testing.T, name string, v interface{}) {
+	data, err := ioutil.ReadFile(filepath.Join("..", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,40 +1,11 @@
 package zomato
 
 import (
-	"context"
 	"encoding/json"
-	"net/http"
 
-	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
 )
 
-// LocationDetailsReq parameters
-type LocationDetailsReq struct {
-	EntityID   int64      `url:"entity_id" validate:"required"`   // location id obtained from locations api
-	EntityType EntityType `url:"entity_type" validate:"required"` // location type obtained from locations api
-}
-
-// Request encodes LocationDetailsReq parameters returning a new http.Request
-func (r LocationDetailsReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
-
-	urlStr := DefaultBaseURL + "/v2.1/location_details"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // LocationDetailsResp holds location details
 type LocationDetailsResp struct {
 	Location           *Location `json:"location,omitempty"`
@@ -58,9 +29,9 @@ type Location struct {
 	// Name of the location
 	Title *string `json:"title,omitempty"`
 	// Coordinates of the (centre of) location
-	Latitude *float64 // `json:"latitude,string,omitempty"`
+	Latitude *float64 `json:"-"`
 	// Coordinates of the (centre of) location
-	Longitude *float64 // `json:"longitude,string,omitempty"`
+	Longitude *float64 `json:"-"`
 	// ID of city
 	CityID *int64 `json:"city_id,omitempty"`
 	// Name of the city
@@ -105,56 +76,27 @@ func (l *Location) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// LocationDetails gets Zomato location details.
-//
-// Get Foodie Index, Nightlife Index, Top Cuisines and Best rated restaurants in a given location.
-func (c Client) LocationDetails(ctx context.Context,
-	entityID int64, entityType EntityType) (resp LocationDetailsResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, LocationDetailsReq{
-		EntityID:   entityID,
-		EntityType: entityType,
-	})), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// LocationsReq parameters
-type LocationsReq struct {
-	Query     string  `url:"query" validate:"required"` // suggestion for location name
-	Latitude  float64 `url:"lat,omitempty"`             // latitude
-	Longitude float64 `url:"lon,omitempty"`             // longitude
-	Count     uint64  `url:"count,omitempty"`           // number of max results to display
-}
-
-// Request encodes LocationsReq parameters returning a new http.Request
-func (r LocationsReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
-
-	urlStr := DefaultBaseURL + "/v2.1/locations"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
+// MarshalJSON convert struct to JSON data
+func (l Location) MarshalJSON() ([]byte, error) {
+	type Alias Location
+	return json.Marshal(struct {
+		Alias
 
-	return http.NewRequest(http.MethodGet, urlStr, nil)
+		Latitude  *float64 `json:"latitude,omitempty"`
+		Longitude *float64 `json:"longitude,omitempty"`
+	}{
+		Alias:     Alias(l),
+		Latitude:  l.Latitude,
+		Longitude: l.Longitude,
+	})
 }
 
 // LocationsResp holds locations from LocationsReq query
 type LocationsResp struct {
 	LocationSuggestions []Location `json:"location_suggestions,omitempty"`
 	Status              *string    `json:"status,omitempty"`
-	HasMore             *bool      // json:"has_more,omitempty"`
-	HasTotal            *bool      // `json:"has_total,omitempty"`
+	HasMore             *bool      `json:"-"`
+	HasTotal            *bool      `json:"-"`
 }
 
 // UnmarshalJSON convert JSON data to struct
@@ -175,15 +117,16 @@ func (l *LocationsResp) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Locations searches for locations.
-//
-// Search for Zomato locations by keyword.
-// Provide coordinates to get better search results.
-func (c Client) Locations(ctx context.Context, req LocationsReq) (resp LocationsResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
+// MarshalJSON convert struct to JSON data
+func (l LocationsResp) MarshalJSON() ([]byte, error) {
+	type Alias LocationsResp
+	return json.Marshal(struct {
+		Alias
+		HasMore  uint8 `json:"has_more"`
+		HasTotal uint8 `json:"has_total"`
+	}{
+		Alias:    Alias(l),
+		HasMore:  boolToUint8(l.HasMore),
+		HasTotal: boolToUint8(l.HasTotal),
+	})
 }
@@ -0,0 +1,219 @@
+package offline
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+// ErrUnavailable is returned by ReadOnly for calls the store cannot answer
+var ErrUnavailable = errors.New("offline: not available in store")
+
+// ReadOnly implements zomato.API answering calls from the store, never
+// writing to it.
+//
+// Calls the store cannot answer, finds nothing for or only has results
+// older than MaxAge for are passed to Next. Without Next, they return
+// ErrUnavailable, or what the store has.
+//
+//	var api zomato.API = offline.ReadOnly{Backend: backend, Next: client, MaxAge: 24 * time.Hour}
+type ReadOnly struct {
+	Backend *Backend
+	// Next answers calls the store cannot; may be nil
+	Next zomato.API
+	// MaxAge is the age after which stored results are passed over for
+	// Next; 0 accepts any age
+	MaxAge time.Duration
+}
+
+var _ zomato.API = ReadOnly{}
+
+// next returns Next, or ErrUnavailable for 'method' if there is none.
+func (r ReadOnly) next(method string) (zomato.API, error) {
+	if r.Next == nil {
+		return nil, errors.Wrap(ErrUnavailable, method)
+	}
+	return r.Next, nil
+}
+
+// fresh reports whether results of 'staleness' are usable, not older than
+// MaxAge.
+func (r ReadOnly) fresh(staleness ...Staleness) bool {
+	for _, s := range staleness {
+		if r.MaxAge > 0 && s.Age > r.MaxAge {
+			return false
+		}
+	}
+	return true
+}
+
+// Search implements zomato.API, passing searches finding nothing or with
+// ignored parameters to Next.
+func (r ReadOnly) Search(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+	resp, err := r.Backend.Search(ctx, req)
+	if err != nil {
+		return zomato.SearchResp{}, err
+	}
+	if r.Next != nil && (resp.ResultsFound == 0 || len(resp.Ignored) > 0 || !r.fresh(resp.Staleness...)) {
+		return r.Next.Search(ctx, req)
+	}
+	return resp.SearchResp, nil
+}
+
+// Restaurant implements zomato.API
+func (r ReadOnly) Restaurant(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+	resp, err := r.Backend.Restaurant(ctx, restaurantID)
+	if r.Next != nil && (errors.Cause(err) == store.ErrNotFound || err == nil && !r.fresh(resp.Staleness)) {
+		return r.Next.Restaurant(ctx, restaurantID)
+	}
+	return resp.Restaurant, err
+}
+
+// Reviews implements zomato.API
+func (r ReadOnly) Reviews(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+	resp, err := r.Backend.Reviews(ctx, req)
+	if err != nil {
+		return zomato.ReviewsResp{}, err
+	}
+	if r.Next != nil && (*resp.ReviewsCount == 0 || !r.fresh(resp.Staleness...)) {
+		return r.Next.Reviews(ctx, req)
+	}
+	return resp.ReviewsResp, nil
+}
+
+// DailyMenu implements zomato.API
+func (r ReadOnly) DailyMenu(ctx context.Context, restaurantID int64) (resp zomato.DailyMenuResp, err error) {
+	ms, err := r.Backend.Store.DailyMenus(ctx, restaurantID)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.DailyMenus failed")
+	}
+
+	var staleness []Staleness
+	for _, m := range ms {
+		menu := m.DailyMenu
+		resp.DailyMenus = append(resp.DailyMenus, struct {
+			DailyMenu *zomato.DailyMenu `json:"daily_menu,omitempty"`
+		}{&menu})
+		staleness = append(staleness, r.Backend.staleness(m.FetchedAt))
+	}
+	if r.Next != nil && (len(ms) == 0 || !r.fresh(staleness...)) {
+		return r.Next.DailyMenu(ctx, restaurantID)
+	}
+	return resp, nil
+}
+
+// Collections implements zomato.API, answering requests by city ID.
+func (r ReadOnly) Collections(ctx context.Context, req zomato.CollectionsReq) (resp zomato.CollectionsResp, err error) {
+	if req.CityID == 0 {
+		next, err := r.next("Collections")
+		if err != nil {
+			return resp, err
+		}
+		return next.Collections(ctx, req)
+	}
+
+	cs, err := r.Backend.Store.Collections(ctx, req.CityID)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.Collections failed")
+	}
+	i, j := bounds(len(cs), 0, req.Count)
+
+	var staleness []Staleness
+	for _, c := range cs[i:j] {
+		collection := c.Collection
+		resp.Collections = append(resp.Collections, struct {
+			Collection *zomato.Collection `json:"collection,omitempty"`
+		}{&collection})
+		staleness = append(staleness, r.Backend.staleness(c.FetchedAt))
+	}
+	if r.Next != nil && (len(cs) == 0 || !r.fresh(staleness...)) {
+		return r.Next.Collections(ctx, req)
+	}
+	return resp, nil
+}
+
+// Cuisines implements zomato.API, answering requests by city ID.
+func (r ReadOnly) Cuisines(ctx context.Context, req zomato.CuisinesReq) (resp zomato.CuisinesResp, err error) {
+	if req.CityID == 0 {
+		next, err := r.next("Cuisines")
+		if err != nil {
+			return resp, err
+		}
+		return next.Cuisines(ctx, req)
+	}
+
+	cs, err := r.Backend.Store.Cuisines(ctx, req.CityID)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.Cuisines failed")
+	}
+
+	var staleness []Staleness
+	for _, c := range cs {
+		cuisine := c.Cuisine
+		resp.Cuisines = append(resp.Cuisines, struct {
+			Cuisine *zomato.Cuisine `json:"cuisine,omitempty"`
+		}{&cuisine})
+		staleness = append(staleness, r.Backend.staleness(c.FetchedAt))
+	}
+	if r.Next != nil && (len(cs) == 0 || !r.fresh(staleness...)) {
+		return r.Next.Cuisines(ctx, req)
+	}
+	return resp, nil
+}
+
+// Categories implements zomato.API using Next.
+func (r ReadOnly) Categories(ctx context.Context) (zomato.CategoriesResp, error) {
+	next, err := r.next("Categories")
+	if err != nil {
+		return zomato.CategoriesResp{}, err
+	}
+	return next.Categories(ctx)
+}
+
+// Cities implements zomato.API using Next.
+func (r ReadOnly) Cities(ctx context.Context, req zomato.CitiesReq) (zomato.CitiesResp, error) {
+	next, err := r.next("Cities")
+	if err != nil {
+		return zomato.CitiesResp{}, err
+	}
+	return next.Cities(ctx, req)
+}
+
+// Establishments implements zomato.API using Next.
+func (r ReadOnly) Establishments(ctx context.Context, req zomato.EstablishmentsReq) (zomato.EstablishmentsResp, error) {
+	next, err := r.next("Establishments")
+	if err != nil {
+		return zomato.EstablishmentsResp{}, err
+	}
+	return next.Establishments(ctx, req)
+}
+
+// GeoCode implements zomato.API using Next.
+func (r ReadOnly) GeoCode(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+	next, err := r.next("GeoCode")
+	if err != nil {
+		return zomato.GeoCodeResp{}, err
+	}
+	return next.GeoCode(ctx, lat, long)
+}
+
+// LocationDetails implements zomato.API using Next.
+func (r ReadOnly) LocationDetails(ctx context.Context, entityID int64, entityType zomato.EntityType) (zomato.LocationDetailsResp, error) {
+	next, err := r.next("LocationDetails")
+	if err != nil {
+		return zomato.LocationDetailsResp{}, err
+	}
+	return next.LocationDetails(ctx, entityID, entityType)
+}
+
+// Locations implements zomato.API using Next.
+func (r ReadOnly) Locations(ctx context.Context, req zomato.LocationsReq) (zomato.LocationsResp, error) {
+	next, err := r.next("Locations")
+	if err != nil {
+		return zomato.LocationsResp{}, err
+	}
+	return next.Locations(ctx, req)
+}
@@ -0,0 +1,124 @@
+package offline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/offline"
+	"github.com/go-india/zomato/zomatotest"
+	"github.com/pkg/errors"
+)
+
+func TestReadOnly(t *testing.T) {
+	b := newBackend(t)
+	found := int64(-1)
+	m := &zomatotest.Mock{
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			return zomato.SearchResp{ResultsFound: found}, nil
+		},
+		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+			return zomato.Restaurant{ID: &restaurantID}, nil
+		},
+		ReviewsFunc: func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+			return zomato.ReviewsResp{ReviewsCount: &found}, nil
+		},
+		CuisinesFunc: func(ctx context.Context, req zomato.CuisinesReq) (zomato.CuisinesResp, error) {
+			return zomato.CuisinesResp{}, nil
+		},
+		CategoriesFunc: func(ctx context.Context) (zomato.CategoriesResp, error) {
+			return zomato.CategoriesResp{}, nil
+		},
+	}
+	api := offline.ReadOnly{Backend: b, Next: m}
+
+	tests := []struct {
+		name   string
+		maxAge time.Duration
+		call   func() (int64, error)
+		want   int64
+		next   string
+	}{
+		{"search", 0, func() (int64, error) {
+			resp, err := api.Search(ctx, zomato.SearchReq{Query: "kachori"})
+			return resp.ResultsFound, err
+		}, 5, ""},
+		{"search finding nothing", 0, func() (int64, error) {
+			resp, err := api.Search(ctx, zomato.SearchReq{Query: "pizza"})
+			return resp.ResultsFound, err
+		}, -1, "Search"},
+		{"search ignoring parameters", 0, func() (int64, error) {
+			resp, err := api.Search(ctx, zomato.SearchReq{Query: "kachori", Category: "1"})
+			return resp.ResultsFound, err
+		}, -1, "Search"},
+		{"stale search", time.Hour, func() (int64, error) {
+			resp, err := api.Search(ctx, zomato.SearchReq{Query: "kachori"})
+			return resp.ResultsFound, err
+		}, -1, "Search"},
+		{"restaurant", 3 * time.Hour, func() (int64, error) {
+			r, err := api.Restaurant(ctx, 310309)
+			return int64(len(*r.Name)), err
+		}, 16, ""},
+		{"missing restaurant", 0, func() (int64, error) {
+			r, err := api.Restaurant(ctx, 463)
+			return *r.ID, err
+		}, 463, "Restaurant"},
+		{"reviews", 0, func() (int64, error) {
+			resp, err := api.Reviews(ctx, zomato.ReviewsReq{RestaurantID: 463})
+			return *resp.ReviewsCount, err
+		}, 5, ""},
+		{"missing reviews", 0, func() (int64, error) {
+			resp, err := api.Reviews(ctx, zomato.ReviewsReq{RestaurantID: 310309})
+			return *resp.ReviewsCount, err
+		}, -1, "Reviews"},
+		{"cuisines", 0, func() (int64, error) {
+			resp, err := api.Cuisines(ctx, zomato.CuisinesReq{CityID: 1})
+			return int64(len(resp.Cuisines)), err
+		}, 113, ""},
+		{"cuisines by coordinates", 0, func() (int64, error) {
+			resp, err := api.Cuisines(ctx, zomato.CuisinesReq{Latitude: 28.6, Longitude: 77.2})
+			return int64(len(resp.Cuisines)), err
+		}, 0, "Cuisines"},
+		{"categories", 0, func() (int64, error) {
+			resp, err := api.Categories(ctx)
+			return int64(len(resp.Categories)), err
+		}, 0, "Categories"},
+	}
+	for _, tt := range tests {
+		m.Reset()
+		api.MaxAge = tt.maxAge
+		got, err := tt.call()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+		calls := m.Calls()
+		if tt.next == "" && len(calls) != 0 || tt.next != "" && (len(calls) != 1 || calls[0].Method != tt.next) {
+			t.Errorf("%s: calls to next %+v, want %q", tt.name, calls, tt.next)
+		}
+	}
+}
+
+func TestReadOnlyWithoutNext(t *testing.T) {
+	api := offline.ReadOnly{Backend: newBackend(t)}
+
+	if resp, err := api.Search(ctx, zomato.SearchReq{Query: "pizza"}); err != nil || resp.ResultsFound != 0 {
+		t.Errorf("Search = %+v, %v", resp, err)
+	}
+	if resp, err := api.DailyMenu(ctx, 310309); err != nil || len(resp.DailyMenus) != 0 {
+		t.Errorf("DailyMenu = %+v, %v", resp, err)
+	}
+	if _, err := api.Restaurant(ctx, 463); err == nil {
+		t.Errorf("Restaurant of missing restaurant did not fail")
+	}
+	if _, err := api.GeoCode(ctx, 28.6, 77.2); errors.Cause(err) != offline.ErrUnavailable {
+		t.Errorf("GeoCode error = %v, want ErrUnavailable", err)
+	}
+	if _, err := api.Collections(ctx, zomato.CollectionsReq{Latitude: 28.6, Longitude: 77.2}); errors.Cause(err) != offline.ErrUnavailable {
+		t.Errorf("Collections error = %v, want ErrUnavailable", err)
+	}
+}
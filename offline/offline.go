@@ -0,0 +1,168 @@
+// Package offline answers Zomato API calls from data kept in a local store,
+// for when the API quota runs out or there is no network.
+//
+// Backend serves Search, Restaurant and Reviews with the same arguments as
+// zomato.Client, and reports how stale each result is:
+//
+//	s, err := store.Open("zomato.db")
+//	backend := offline.New(s)
+//	resp, err := backend.Search(ctx, zomato.SearchReq{Query: "biryani", EntityID: 1, EntityType: zomato.CityEntity})
+//	for i, r := range resp.Restaurants {
+//		fmt.Println(*r.Restaurant.Name, resp.Staleness[i].Age)
+//	}
+//
+// Backend is also an http.RoundTripper, so existing code using a
+// zomato.Client can run unchanged against the store:
+//
+//	client := zomato.Client{
+//		HTTPClient: &http.Client{Transport: backend},
+//		Auth:       zomato.NewAuth(""),
+//	}
+//
+// ReadOnly is a zomato.API answering from the store where it can, and from
+// another API otherwise.
+//
+// Search filters are emulated on a best-effort basis; see Backend.Search.
+package offline
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+// Staleness describes how old a stored result is
+type Staleness struct {
+	// FetchedAt is when the result was fetched from the API
+	FetchedAt time.Time
+	// Age is the time passed since FetchedAt
+	Age time.Duration
+}
+
+// SearchResp holds restaurants found in the store
+type SearchResp struct {
+	zomato.SearchResp
+
+	// Staleness of each of Restaurants
+	Staleness []Staleness
+	// Ignored lists request parameters that could not be applied
+	Ignored []string
+}
+
+// RestaurantResp holds a restaurant found in the store
+type RestaurantResp struct {
+	zomato.Restaurant
+
+	Staleness Staleness
+}
+
+// MarshalJSON convert struct to JSON data: the restaurant with its Staleness,
+// which the promoted Restaurant.MarshalJSON would drop
+func (r RestaurantResp) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(r.Restaurant)
+	if err != nil {
+		return nil, errors.Wrap(err, "MarshalJSON failed")
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrap(err, "MarshalJSON failed")
+	}
+	if fields["Staleness"], err = json.Marshal(r.Staleness); err != nil {
+		return nil, errors.Wrap(err, "MarshalJSON failed")
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON convert JSON data to struct
+func (r *RestaurantResp) UnmarshalJSON(data []byte) error {
+	var t struct{ Staleness Staleness }
+	if err := json.Unmarshal(data, &t); err != nil {
+		return errors.Wrap(err, "UnmarshalJSON failed")
+	}
+	if err := json.Unmarshal(data, &r.Restaurant); err != nil {
+		return errors.Wrap(err, "UnmarshalJSON failed")
+	}
+	r.Staleness = t.Staleness
+	return nil
+}
+
+// ReviewsResp holds reviews found in the store
+type ReviewsResp struct {
+	zomato.ReviewsResp
+
+	// Staleness of each of UserReviews
+	Staleness []Staleness
+}
+
+// Backend serves API calls from a store
+type Backend struct {
+	Store *store.Store
+	// Now returns the time staleness is measured at; defaults to time.Now
+	Now func() time.Time
+}
+
+// New returns a backend serving from store 's'.
+func New(s *store.Store) *Backend {
+	return &Backend{Store: s, Now: time.Now}
+}
+
+// staleness returns the staleness of a result fetched at 'fetchedAt'.
+func (b *Backend) staleness(fetchedAt time.Time) Staleness {
+	now := time.Now
+	if b.Now != nil {
+		now = b.Now
+	}
+	return Staleness{FetchedAt: fetchedAt, Age: now().Sub(fetchedAt)}
+}
+
+// Restaurant gets stored restaurant 'restaurantID'. The returned error has
+// cause store.ErrNotFound if it is not stored.
+func (b *Backend) Restaurant(ctx context.Context, restaurantID int64) (resp RestaurantResp, err error) {
+	r, err := b.Store.Restaurant(ctx, restaurantID)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.Restaurant failed")
+	}
+	return RestaurantResp{Restaurant: r.Restaurant, Staleness: b.staleness(r.FetchedAt)}, nil
+}
+
+// Reviews gets stored reviews of a restaurant, newest first. All reviews
+// after 'req.Start' are returned if 'req.Count' is 0.
+func (b *Backend) Reviews(ctx context.Context, req zomato.ReviewsReq) (resp ReviewsResp, err error) {
+	rs, err := b.Store.Reviews(ctx, req.RestaurantID)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.Reviews failed")
+	}
+
+	count, start := int64(len(rs)), int64(req.Start)
+	i, j := bounds(len(rs), req.Start, req.Count)
+	rs = rs[i:j]
+	shown := int64(len(rs))
+	resp.ReviewsCount, resp.ReviewsStart, resp.ReviewsShown = &count, &start, &shown
+
+	for _, r := range rs {
+		review := r.Review
+		resp.UserReviews = append(resp.UserReviews, struct {
+			Review *zomato.Review `json:"review,omitempty"`
+		}{&review})
+		resp.Staleness = append(resp.Staleness, b.staleness(r.FetchedAt))
+	}
+	return resp, nil
+}
+
+// bounds returns the slice bounds of a page of 'count' items from 'start'
+// among 'n' items, where a 'count' of 0 means all remaining items.
+func bounds(n int, start, count uint64) (int, int) {
+	i := n
+	if start < uint64(n) {
+		i = int(start)
+	}
+	j := n
+	if count > 0 && count < uint64(n-i) {
+		j = i + int(count)
+	}
+	return i, j
+}
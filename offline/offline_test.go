@@ -0,0 +1,179 @@
+package offline_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/offline"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+var (
+	ctx     = context.Background()
+	fetched = time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	now     = fetched.Add(2 * time.Hour)
+)
+
+// newBackend returns a backend serving the testdata restaurants of Delhi
+// NCR, the reviews of restaurant 463, cuisines and locations.
+func newBackend(t *testing.T) *offline.Backend {
+	dir, err := ioutil.TempDir("", "offline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	s, err := store.Open(filepath.Join(dir, "zomato.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	s.Now = func() time.Time { return fetched }
+
+	var search zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &search)
+	var restaurants []zomato.Restaurant
+	for _, r := range search.Restaurants {
+		switch *r.Restaurant.ID {
+		case 8530:
+			cost := int64(1500)
+			r.Restaurant.AverageCostForTwo = &cost
+		case 9166:
+			r.Restaurant.AverageCostForTwo = nil
+		}
+		restaurants = append(restaurants, *r.Restaurant)
+	}
+	if err := s.PutRestaurants(ctx, restaurants...); err != nil {
+		t.Fatal(err)
+	}
+
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	for _, r := range reviews.UserReviews {
+		if err := s.PutReviews(ctx, 463, *r.Review); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var cuisines zomato.CuisinesResp
+	testfixture.Decode(t, "Cuisines.json", &cuisines)
+	for _, c := range cuisines.Cuisines {
+		if err := s.PutCuisines(ctx, 1, *c.Cuisine); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+	if err := s.PutLocations(ctx, *geocode.Location); err != nil {
+		t.Fatal(err)
+	}
+
+	b := offline.New(s)
+	b.Now = func() time.Time { return now }
+	return b
+}
+
+func TestRestaurant(t *testing.T) {
+	b := newBackend(t)
+
+	r, err := b.Restaurant(ctx, 310309)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *r.Name != "Fateh Ki Kachori" {
+		t.Errorf("name = %q", *r.Name)
+	}
+	if want := (offline.Staleness{FetchedAt: fetched, Age: 2 * time.Hour}); !r.Staleness.FetchedAt.Equal(want.FetchedAt) || r.Staleness.Age != want.Age {
+		t.Errorf("staleness = %+v, want %+v", r.Staleness, want)
+	}
+
+	if _, err := b.Restaurant(ctx, 463); errors.Cause(err) != store.ErrNotFound {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRestaurantRespJSON(t *testing.T) {
+	want := offline.RestaurantResp{Staleness: offline.Staleness{
+		FetchedAt: time.Date(2018, 4, 1, 12, 0, 0, 0, time.UTC),
+		Age:       48 * time.Hour,
+	}}
+	testfixture.Decode(t, "Restaurant.json", &want.Restaurant)
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got offline.RestaurantResp
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal(Marshal()) = %+v, want %+v", got, want)
+	}
+	if !strings.Contains(string(data), `"Staleness":{"FetchedAt":"2018-04-01T12:00:00Z","Age":172800000000000}`) {
+		t.Errorf("Marshal() = %s, want Staleness", data)
+	}
+}
+
+func TestReviews(t *testing.T) {
+	b := newBackend(t)
+
+	tests := []struct {
+		req          zomato.ReviewsReq
+		count, shown int64
+		want         []int64
+	}{
+		{zomato.ReviewsReq{RestaurantID: 463}, 5, 5, []int64{34508218, 34501403, 34493213, 34486031, 34484649}},
+		{zomato.ReviewsReq{RestaurantID: 463, Start: 1, Count: 2}, 5, 2, []int64{34501403, 34493213}},
+		{zomato.ReviewsReq{RestaurantID: 463, Start: 4, Count: 2}, 5, 1, []int64{34484649}},
+		{zomato.ReviewsReq{RestaurantID: 463, Start: 9}, 5, 0, nil},
+		{zomato.ReviewsReq{RestaurantID: 1}, 0, 0, nil},
+	}
+	for _, tt := range tests {
+		resp, err := b.Reviews(ctx, tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *resp.ReviewsCount != tt.count || *resp.ReviewsShown != tt.shown || *resp.ReviewsStart != int64(tt.req.Start) {
+			t.Errorf("%+v: count %d, shown %d, start %d", tt.req, *resp.ReviewsCount, *resp.ReviewsShown, *resp.ReviewsStart)
+		}
+		var got []int64
+		for _, r := range resp.UserReviews {
+			got = append(got, *r.Review.ID)
+		}
+		if !equalIDs(got, tt.want) {
+			t.Errorf("%+v: reviews %v, want %v", tt.req, got, tt.want)
+		}
+		if len(resp.Staleness) != len(got) {
+			t.Errorf("%+v: %d staleness for %d reviews", tt.req, len(resp.Staleness), len(got))
+		}
+		for _, s := range resp.Staleness {
+			if s.Age != 2*time.Hour {
+				t.Errorf("%+v: age %v", tt.req, s.Age)
+			}
+		}
+	}
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,264 @@
+package offline
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+// DefaultSearchCount is the number of restaurants returned by Search when
+// the request has no count, as the API does.
+const DefaultSearchCount = 20
+
+// Search finds stored restaurants matching 'req'.
+//
+// Filters are emulated on a best-effort basis:
+//
+//   - a city entity limits results to the city; other entities limit results
+//     to the city of the stored location, whose coordinates are used for
+//     distance when the request has none
+//   - every word of the query must appear in the name, cuisines or locality
+//   - cuisines, by ID of a stored cuisine or by name, match restaurants
+//     serving any of them
+//   - radius keeps restaurants within that many meters of the coordinates
+//   - restaurants sort by cost or distance ascending and by rating
+//     descending unless an order is given; restaurants missing the sort
+//     value come last
+//
+// Parameters that cannot be applied, such as establishment type,
+// collection and category, are listed in the response as ignored.
+func (b *Backend) Search(ctx context.Context, req zomato.SearchReq) (resp SearchResp, err error) {
+	var q store.RestaurantQuery
+	lat, lon := req.Latitude, req.Longitude
+	switch {
+	case req.EntityType == zomato.CityEntity:
+		q.CityID = req.EntityID
+	case req.EntityType != "" && req.EntityID != 0:
+		l, err := b.Store.Location(ctx, req.EntityType, req.EntityID)
+		if errors.Cause(err) == store.ErrNotFound {
+			resp.Ignored = append(resp.Ignored, "entity_id")
+			break
+		}
+		if err != nil {
+			return resp, errors.Wrap(err, "Store.Location failed")
+		}
+		if l.Location.CityID != nil {
+			q.CityID = *l.Location.CityID
+		}
+		if lat == 0 && lon == 0 && l.Location.Latitude != nil && l.Location.Longitude != nil {
+			lat, lon = *l.Location.Latitude, *l.Location.Longitude
+		}
+	}
+
+	rs, err := b.Store.Restaurants(ctx, q)
+	if err != nil {
+		return resp, errors.Wrap(err, "Store.Restaurants failed")
+	}
+
+	cuisines, ignored, err := b.cuisines(ctx, req.Cuisines)
+	if err != nil {
+		return resp, err
+	}
+	resp.Ignored = append(resp.Ignored, ignored...)
+	if len(req.Cuisines) > 0 && len(cuisines) > 0 {
+		rs = filter(rs, func(r zomato.Restaurant) bool { return servesAny(r, cuisines) })
+	}
+
+	if words := strings.Fields(strings.ToLower(req.Query)); len(words) > 0 {
+		rs = filter(rs, func(r zomato.Restaurant) bool { return matches(r, words) })
+	}
+
+	hasCenter := lat != 0 || lon != 0
+	if req.Radius > 0 {
+		if hasCenter {
+			rs = filter(rs, func(r zomato.Restaurant) bool {
+				d, ok := distance(r, lat, lon)
+				return ok && d <= req.Radius
+			})
+		} else {
+			resp.Ignored = append(resp.Ignored, "radius")
+		}
+	}
+
+	for _, p := range []struct{ name, value string }{
+		{"establishment_type", req.Establishment},
+		{"collection_id", req.Collection},
+		{"category", req.Category},
+	} {
+		if p.value != "" {
+			resp.Ignored = append(resp.Ignored, p.name)
+		}
+	}
+
+	if req.Sort != "" {
+		if key := sortKey(req.Sort, lat, lon, hasCenter); key != nil {
+			order := req.Order
+			if order == "" {
+				order = zomato.Ascending
+				if req.Sort == zomato.Rating {
+					order = zomato.Descending
+				}
+			}
+			sortRestaurants(rs, key, order == zomato.Descending)
+		} else {
+			resp.Ignored = append(resp.Ignored, "sort")
+		}
+	}
+
+	count := req.Count
+	if count == 0 {
+		count = DefaultSearchCount
+	}
+	i, j := bounds(len(rs), req.Start, count)
+	resp.ResultsFound = int64(len(rs))
+	resp.ResultsStart = int64(req.Start)
+	resp.ResultsShown = int64(j - i)
+	for _, r := range rs[i:j] {
+		restaurant := r.Restaurant
+		resp.Restaurants = append(resp.Restaurants, struct {
+			Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+		}{&restaurant})
+		resp.Staleness = append(resp.Staleness, b.staleness(r.FetchedAt))
+	}
+	return resp, nil
+}
+
+// cuisines resolves cuisine IDs or names to lower case names, returning
+// the IDs not found in the store as ignored.
+func (b *Backend) cuisines(ctx context.Context, cuisines []string) (names, ignored []string, err error) {
+	var byID map[int64]string
+	for _, c := range cuisines {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(c, 10, 64)
+		if err != nil {
+			names = append(names, strings.ToLower(c))
+			continue
+		}
+
+		if byID == nil {
+			stored, err := b.Store.Cuisines(ctx, 0)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "Store.Cuisines failed")
+			}
+			byID = make(map[int64]string, len(stored))
+			for _, s := range stored {
+				byID[s.Cuisine.ID] = strings.ToLower(s.Cuisine.Name)
+			}
+		}
+		if name, ok := byID[id]; ok {
+			names = append(names, name)
+		} else {
+			ignored = append(ignored, "cuisines="+c)
+		}
+	}
+	return names, ignored, nil
+}
+
+// filter returns the records of 'rs' whose restaurant satisfies 'f'.
+func filter(rs []store.RestaurantRecord, f func(r zomato.Restaurant) bool) []store.RestaurantRecord {
+	var kept []store.RestaurantRecord
+	for _, r := range rs {
+		if f(r.Restaurant) {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// servesAny reports whether 'r' serves any of lower case 'cuisines'.
+func servesAny(r zomato.Restaurant, cuisines []string) bool {
+	for _, c := range r.Cuisines {
+		c = strings.ToLower(strings.TrimSpace(c))
+		for _, want := range cuisines {
+			if c == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches reports whether every one of lower case 'words' appears in the
+// name, cuisines or locality of 'r'.
+func matches(r zomato.Restaurant, words []string) bool {
+	var text []string
+	if r.Name != nil {
+		text = append(text, *r.Name)
+	}
+	text = append(text, r.Cuisines...)
+	if r.Location != nil && r.Location.Locality != nil {
+		text = append(text, *r.Location.Locality)
+	}
+	s := strings.ToLower(strings.Join(text, " "))
+
+	for _, w := range words {
+		if !strings.Contains(s, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// distance returns the distance in meters of 'r' from 'lat', 'lon', and
+// whether 'r' has coordinates.
+func distance(r zomato.Restaurant, lat, lon float64) (float64, bool) {
+	c, ok := geo.Of(r)
+	if !ok {
+		return 0, false
+	}
+	return geo.Haversine(geo.Coordinates{Lat: lat, Lon: lon}, c), true
+}
+
+// sortKey returns the value restaurants are sorted by for 's', or nil if it
+// cannot be applied.
+func sortKey(s zomato.Sort, lat, lon float64, hasCenter bool) func(r zomato.Restaurant) (float64, bool) {
+	switch s {
+	case zomato.Cost:
+		return func(r zomato.Restaurant) (float64, bool) {
+			if r.AverageCostForTwo == nil {
+				return 0, false
+			}
+			return float64(*r.AverageCostForTwo), true
+		}
+	case zomato.Rating:
+		return func(r zomato.Restaurant) (float64, bool) {
+			if r.UserRating == nil || r.UserRating.AggregateRating == nil {
+				return 0, false
+			}
+			return *r.UserRating.AggregateRating, true
+		}
+	case zomato.RealDistance:
+		if !hasCenter {
+			return nil
+		}
+		return func(r zomato.Restaurant) (float64, bool) {
+			return distance(r, lat, lon)
+		}
+	}
+	return nil
+}
+
+// sortRestaurants stably sorts 'rs' by 'key', keeping restaurants without a
+// key last.
+func sortRestaurants(rs []store.RestaurantRecord, key func(r zomato.Restaurant) (float64, bool), desc bool) {
+	sort.SliceStable(rs, func(i, j int) bool {
+		a, aok := key(rs[i].Restaurant)
+		b, bok := key(rs[j].Restaurant)
+		if !aok || !bok {
+			return aok && !bok
+		}
+		if desc {
+			return a > b
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,72 @@
+package offline_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+)
+
+func TestSearch(t *testing.T) {
+	b := newBackend(t)
+
+	tests := []struct {
+		name    string
+		req     zomato.SearchReq
+		found   int64
+		want    []int64
+		ignored []string
+	}{
+		{"all", zomato.SearchReq{Count: 3}, 20, []int64{8530, 9166, 9271}, nil},
+		{"page", zomato.SearchReq{Start: 18}, 20, []int64{18537921, 18541065}, nil},
+		{"city", zomato.SearchReq{EntityType: zomato.CityEntity, EntityID: 1, Count: 1}, 20, []int64{8530}, nil},
+		{"other city", zomato.SearchReq{EntityType: zomato.CityEntity, EntityID: 2}, 0, nil, nil},
+		{"query", zomato.SearchReq{Query: "kachori"}, 5,
+			[]int64{9166, 307327, 310309, 18137099, 18541065}, nil},
+		{"query words", zomato.SearchReq{Query: "Kachori kashmiri"}, 2, []int64{310309, 18137099}, nil},
+		{"query cuisine", zomato.SearchReq{Query: "north indian"}, 3, []int64{9271, 303363, 18312486}, nil},
+		{"cuisine id", zomato.SearchReq{Cuisines: []string{"50"}}, 3, []int64{9271, 303363, 18312486}, nil},
+		{"cuisine names", zomato.SearchReq{Cuisines: []string{"mithai", "Bakery"}}, 2, []int64{8530, 18137099}, nil},
+		{"unknown cuisine", zomato.SearchReq{Cuisines: []string{"999999"}, Count: 1}, 20, []int64{8530},
+			[]string{"cuisines=999999"}},
+		{"radius", zomato.SearchReq{Latitude: 28.6557047961, Longitude: 77.2299033031, Radius: 1500}, 3,
+			[]int64{9166, 310309, 18137099}, nil},
+		{"radius without center", zomato.SearchReq{Radius: 1500, Count: 1}, 20, []int64{8530}, []string{"radius"}},
+		{"distance", zomato.SearchReq{Latitude: 28.6557047961, Longitude: 77.2299033031, Sort: zomato.RealDistance, Count: 4}, 20,
+			[]int64{9166, 18137099, 310309, 307327}, nil},
+		{"distance from entity", zomato.SearchReq{EntityType: zomato.SubZone, EntityID: 289, Sort: zomato.RealDistance, Count: 3}, 20,
+			[]int64{18541065, 18492057, 18312486}, nil},
+		{"distance without center", zomato.SearchReq{Sort: zomato.RealDistance, Count: 1}, 20, []int64{8530}, []string{"sort"}},
+		{"unknown entity", zomato.SearchReq{EntityType: zomato.Zone, EntityID: 1, Count: 1}, 20, []int64{8530}, []string{"entity_id"}},
+		{"rating", zomato.SearchReq{Sort: zomato.Rating, Count: 3}, 20, []int64{310309, 9166, 307327}, nil},
+		{"rating ascending", zomato.SearchReq{Sort: zomato.Rating, Order: zomato.Ascending, Count: 3}, 20,
+			[]int64{9271, 302835, 303363}, nil},
+		{"cost descending", zomato.SearchReq{Sort: zomato.Cost, Order: zomato.Descending, Count: 2}, 20, []int64{8530, 9271}, nil},
+		{"cost missing last", zomato.SearchReq{Sort: zomato.Cost, Start: 19}, 20, []int64{9166}, nil},
+		{"unsupported", zomato.SearchReq{Establishment: "1", Collection: "1", Category: "2", Count: 1}, 20, []int64{8530},
+			[]string{"establishment_type", "collection_id", "category"}},
+	}
+	for _, tt := range tests {
+		resp, err := b.Search(ctx, tt.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []int64
+		for _, r := range resp.Restaurants {
+			got = append(got, *r.Restaurant.ID)
+		}
+		if resp.ResultsFound != tt.found || resp.ResultsShown != int64(len(got)) || resp.ResultsStart != int64(tt.req.Start) {
+			t.Errorf("%s: found %d shown %d start %d, want found %d", tt.name,
+				resp.ResultsFound, resp.ResultsShown, resp.ResultsStart, tt.found)
+		}
+		if !equalIDs(got, tt.want) {
+			t.Errorf("%s: restaurants %v, want %v", tt.name, got, tt.want)
+		}
+		if !reflect.DeepEqual(resp.Ignored, tt.ignored) {
+			t.Errorf("%s: ignored %q, want %q", tt.name, resp.Ignored, tt.ignored)
+		}
+		if len(resp.Staleness) != len(got) {
+			t.Errorf("%s: %d staleness for %d restaurants", tt.name, len(resp.Staleness), len(got))
+		}
+	}
+}
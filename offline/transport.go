@@ -0,0 +1,181 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+// Response headers set by RoundTrip
+const (
+	// FetchedAtHeader holds the RFC 3339 fetch time of each result, in order
+	FetchedAtHeader = "X-Offline-Fetched-At"
+	// IgnoredHeader holds each request parameter that could not be applied
+	IgnoredHeader = "X-Offline-Ignored"
+)
+
+// RoundTrip implements http.RoundTripper, answering search, restaurant and
+// reviews API requests from the store.
+//
+// Staleness is reported in FetchedAtHeader. Restaurants not in the store
+// get a 404 response and other endpoints a 501 response.
+func (b *Backend) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	values := req.URL.Query()
+
+	var v interface{}
+	var fetched []Staleness
+	var ignored []string
+	var err error
+	switch path := strings.TrimSuffix(req.URL.Path, "/"); {
+	case strings.HasSuffix(path, "/search"):
+		var r zomato.SearchReq
+		if r, err = searchReq(values); err != nil {
+			return respond(req, http.StatusBadRequest, err.Error(), nil)
+		}
+		var resp SearchResp
+		resp, err = b.Search(ctx, r)
+		v, fetched, ignored = resp.SearchResp, resp.Staleness, resp.Ignored
+
+	case strings.HasSuffix(path, "/restaurant"):
+		var id int64
+		if id, err = strconv.ParseInt(values.Get("res_id"), 10, 64); err != nil {
+			return respond(req, http.StatusBadRequest, "invalid res_id", nil)
+		}
+		var resp RestaurantResp
+		resp, err = b.Restaurant(ctx, id)
+		v, fetched = resp.Restaurant, []Staleness{resp.Staleness}
+
+	case strings.HasSuffix(path, "/reviews"):
+		var r zomato.ReviewsReq
+		if r, err = reviewsReq(values); err != nil {
+			return respond(req, http.StatusBadRequest, err.Error(), nil)
+		}
+		var resp ReviewsResp
+		resp, err = b.Reviews(ctx, r)
+		v, fetched = resp.ReviewsResp, resp.Staleness
+
+	default:
+		return respond(req, http.StatusNotImplemented, fmt.Sprintf("%s is not available offline", req.URL.Path), nil)
+	}
+
+	if errors.Cause(err) == store.ErrNotFound {
+		return respond(req, http.StatusNotFound, err.Error(), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode response failed")
+	}
+	header := http.Header{"Content-Type": {"application/json"}}
+	for _, s := range fetched {
+		header.Add(FetchedAtHeader, s.FetchedAt.UTC().Format(time.RFC3339))
+	}
+	for _, p := range ignored {
+		header.Add(IgnoredHeader, p)
+	}
+	return respond(req, http.StatusOK, string(body), header)
+}
+
+// respond returns a response to 'req' with 'status' and 'body'.
+func respond(req *http.Request, status int, body string, header http.Header) (*http.Response, error) {
+	if header == nil {
+		header = http.Header{"Content-Type": {"text/plain; charset=utf-8"}}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// searchReq decodes search query parameters.
+func searchReq(values url.Values) (r zomato.SearchReq, err error) {
+	r.EntityType = zomato.EntityType(values.Get("entity_type"))
+	r.Query = values.Get("q")
+	r.Establishment = values.Get("establishment_type")
+	r.Collection = values.Get("collection_id")
+	r.Category = values.Get("category")
+	r.Sort = zomato.Sort(values.Get("sort"))
+	r.Order = zomato.Order(values.Get("order"))
+	if c := values.Get("cuisines"); c != "" {
+		r.Cuisines = strings.Split(c, ",")
+	}
+
+	p := params{values: values}
+	r.EntityID = p.int("entity_id")
+	r.Start = p.uint("start")
+	r.Count = p.uint("count")
+	r.Latitude = p.float("lat")
+	r.Longitude = p.float("lon")
+	r.Radius = p.float("radius")
+	return r, p.err
+}
+
+// reviewsReq decodes reviews query parameters.
+func reviewsReq(values url.Values) (r zomato.ReviewsReq, err error) {
+	p := params{values: values}
+	r.RestaurantID = p.int("res_id")
+	r.Start = p.uint("start")
+	r.Count = p.uint("count")
+	return r, p.err
+}
+
+// params parses numeric query parameters, keeping the first error.
+type params struct {
+	values url.Values
+	err    error
+}
+
+func (p *params) parse(name string, f func(s string) error) {
+	s := p.values.Get(name)
+	if s == "" || p.err != nil {
+		return
+	}
+	if err := f(s); err != nil {
+		p.err = errors.Errorf("invalid %s %q", name, s)
+	}
+}
+
+func (p *params) int(name string) (i int64) {
+	p.parse(name, func(s string) (err error) {
+		i, err = strconv.ParseInt(s, 10, 64)
+		return err
+	})
+	return i
+}
+
+func (p *params) uint(name string) (u uint64) {
+	p.parse(name, func(s string) (err error) {
+		u, err = strconv.ParseUint(s, 10, 64)
+		return err
+	})
+	return u
+}
+
+func (p *params) float(name string) (f float64) {
+	p.parse(name, func(s string) (err error) {
+		f, err = strconv.ParseFloat(s, 64)
+		return err
+	})
+	return f
+}
@@ -0,0 +1,95 @@
+package offline_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/offline"
+	"github.com/pkg/errors"
+)
+
+func TestClient(t *testing.T) {
+	b := newBackend(t)
+	client := zomato.Client{
+		HTTPClient: &http.Client{Transport: b},
+		Auth:       zomato.NewAuth(""),
+	}
+
+	search, err := client.Search(ctx, zomato.SearchReq{Query: "kachori", Sort: zomato.Rating, Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if search.ResultsFound != 5 || len(search.Restaurants) != 2 || *search.Restaurants[0].Restaurant.ID != 310309 {
+		t.Errorf("search = %+v", search)
+	}
+
+	r, err := client.Restaurant(ctx, 310309)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *r.Name != "Fateh Ki Kachori" || *r.Location.Latitude != 28.6658207380 {
+		t.Errorf("restaurant = %+v", r)
+	}
+
+	reviews, err := client.Reviews(ctx, zomato.ReviewsReq{RestaurantID: 463, Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews.UserReviews) != 2 || *reviews.UserReviews[0].Review.ID != 34508218 || *reviews.ReviewsCount != 5 {
+		t.Errorf("reviews = %+v", reviews)
+	}
+
+	_, err = client.Restaurant(ctx, 463)
+	if e, ok := errors.Cause(err).(*zomato.ErrAPI); !ok || e.StatusCode != http.StatusNotFound {
+		t.Errorf("missing restaurant error = %v, want 404", err)
+	}
+	_, err = client.Categories(ctx)
+	if e, ok := errors.Cause(err).(*zomato.ErrAPI); !ok || e.StatusCode != http.StatusNotImplemented {
+		t.Errorf("categories error = %v, want 501", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	b := newBackend(t)
+
+	tests := []struct {
+		url       string
+		status    int
+		fetchedAt int
+		ignored   []string
+	}{
+		{"/api/v2.1/search?q=kashmiri&category=1", http.StatusOK, 2, []string{"category"}},
+		{"/api/v2.1/search?lat=x", http.StatusBadRequest, 0, nil},
+		{"/api/v2.1/restaurant?res_id=9166", http.StatusOK, 1, nil},
+		{"/api/v2.1/restaurant", http.StatusBadRequest, 0, nil},
+		{"/api/v2.1/reviews?res_id=463&start=1", http.StatusOK, 4, nil},
+		{"/api/v2.1/reviews?res_id=463&count=-1", http.StatusBadRequest, 0, nil},
+		{"/api/v2.1/dailymenu?res_id=463", http.StatusNotImplemented, 0, nil},
+	}
+	for _, tt := range tests {
+		resp, err := b.RoundTrip(httptest.NewRequest(http.MethodGet, tt.url, nil))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.url, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: status %d, want %d", tt.url, resp.StatusCode, tt.status)
+		}
+		fetchedAt := resp.Header[offline.FetchedAtHeader]
+		if len(fetchedAt) != tt.fetchedAt {
+			t.Errorf("%s: %d fetch times, want %d", tt.url, len(fetchedAt), tt.fetchedAt)
+		}
+		for _, f := range fetchedAt {
+			if f != fetched.Format(time.RFC3339) {
+				t.Errorf("%s: fetched at %s", tt.url, f)
+			}
+		}
+		if !reflect.DeepEqual(resp.Header[offline.IgnoredHeader], tt.ignored) {
+			t.Errorf("%s: ignored %q, want %q", tt.url, resp.Header[offline.IgnoredHeader], tt.ignored)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package zomato
+
+import "context"
+
+// Search pagination limits of the API
+const (
+	// MaxSearchResults is the number of results of a search the API serves
+	MaxSearchResults = 100
+	// SearchPageSize is the largest page of results the API serves
+	SearchPageSize = 20
+)
+
+// SearchPaginator pages through the results of a search.
+//
+//	p := zomato.NewSearchPaginator(client, zomato.SearchReq{EntityID: 1, EntityType: zomato.CityEntity})
+//	for p.Next(ctx) {
+//		for _, r := range p.Restaurants() {
+//			fmt.Println(*r.Name)
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		return err
+//	}
+type SearchPaginator struct {
+	api  API
+	req  SearchReq
+	resp SearchResp
+	err  error
+	done bool
+}
+
+// NewSearchPaginator returns a paginator of the results of 'req' from
+// req.Start, in pages of req.Count results; SearchPageSize if 0.
+func NewSearchPaginator(api API, req SearchReq) *SearchPaginator {
+	if req.Count == 0 || req.Count > SearchPageSize {
+		req.Count = SearchPageSize
+	}
+	return &SearchPaginator{api: api, req: req}
+}
+
+// Next fetches the next page, reporting whether there was one. It returns
+// false when the results run out or on error.
+func (p *SearchPaginator) Next(ctx context.Context) bool {
+	if p.done || p.err != nil || p.req.Start >= MaxSearchResults {
+		return false
+	}
+
+	req := p.req
+	if left := MaxSearchResults - req.Start; left < req.Count {
+		req.Count = left
+	}
+	p.resp, p.err = p.api.Search(ctx, req)
+	if p.err != nil {
+		return false
+	}
+
+	n := uint64(len(p.resp.Restaurants))
+	p.req.Start += n
+	if n == 0 {
+		p.done = true
+		return false
+	}
+	p.done = p.req.Start >= uint64(p.resp.ResultsFound)
+	return true
+}
+
+// Page returns the page fetched by the last call to Next.
+func (p *SearchPaginator) Page() SearchResp {
+	return p.resp
+}
+
+// Restaurants returns the restaurants of the page fetched by the last call
+// to Next.
+func (p *SearchPaginator) Restaurants() []Restaurant {
+	rs := make([]Restaurant, 0, len(p.resp.Restaurants))
+	for _, r := range p.resp.Restaurants {
+		if r.Restaurant != nil {
+			rs = append(rs, *r.Restaurant)
+		}
+	}
+	return rs
+}
+
+// Err returns the error that stopped Next, if any.
+func (p *SearchPaginator) Err() error {
+	return p.err
+}
@@ -0,0 +1,99 @@
+package zomato_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// searchOf mocks a search of 'found' restaurants with ids 0 to found-1
+func searchOf(found int64) *zomatotest.Mock {
+	return &zomatotest.Mock{
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			resp := zomato.SearchResp{ResultsFound: found, ResultsStart: int64(req.Start)}
+			for id := int64(req.Start); id < found && id < int64(req.Start+req.Count); id++ {
+				id := id
+				resp.Restaurants = append(resp.Restaurants, struct {
+					Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+				}{&zomato.Restaurant{ID: &id}})
+			}
+			resp.ResultsShown = int64(len(resp.Restaurants))
+			return resp, nil
+		},
+	}
+}
+
+func TestSearchPaginator(t *testing.T) {
+	tests := []struct {
+		name         string
+		found        int64
+		start, count uint64
+		pages        []int // restaurants of each page
+	}{
+		{"one page", 7, 0, 0, []int{7}},
+		{"pages", 45, 0, 0, []int{20, 20, 5}},
+		{"page size", 25, 0, 10, []int{10, 10, 5}},
+		{"from start", 45, 40, 0, []int{5}},
+		{"max results", 500, 70, 0, []int{20, 10}},
+		{"large page size", 30, 0, 50, []int{20, 10}},
+		{"none", 0, 0, 0, nil},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := zomato.NewSearchPaginator(searchOf(tt.found), zomato.SearchReq{Start: tt.start, Count: tt.count})
+			var pages []int
+			next := int64(tt.start)
+			for p.Next(ctx) {
+				rs := p.Restaurants()
+				pages = append(pages, len(rs))
+				for _, r := range rs {
+					if *r.ID != next {
+						t.Fatalf("restaurant %d, want %d", *r.ID, next)
+					}
+					next++
+				}
+			}
+			if err := p.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(pages, tt.pages) {
+				t.Errorf("pages of %v, want %v", pages, tt.pages)
+			}
+			if p.Next(ctx) {
+				t.Error("Next() after the last page = true")
+			}
+		})
+	}
+}
+
+func TestSearchPaginatorErr(t *testing.T) {
+	ctx := context.Background()
+	m := searchOf(45)
+	search := m.SearchFunc
+	m.SearchFunc = func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+		if req.Start > 0 {
+			return zomato.SearchResp{}, &zomato.ErrAPI{StatusCode: http.StatusTooManyRequests}
+		}
+		return search(ctx, req)
+	}
+
+	p := zomato.NewSearchPaginator(m, zomato.SearchReq{})
+	if !p.Next(ctx) || len(p.Restaurants()) != 20 {
+		t.Fatalf("first Next() = false or %d restaurants, want a page of 20", len(p.Restaurants()))
+	}
+	if p.Next(ctx) {
+		t.Error("Next() on error = true")
+	}
+	if err, ok := p.Err().(*zomato.ErrAPI); !ok || err.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Err() = %v, want 429 ErrAPI", p.Err())
+	}
+	if calls := len(m.CallsTo("Search")); calls != 2 {
+		t.Errorf("Search called %d times, want 2", calls)
+	}
+}
@@ -0,0 +1,123 @@
+package zomato
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PostalCode is a postal code as the API sends it, such as "110085",
+// "02134" or "SW1A 1AA". Its format depends on the country.
+type PostalCode string
+
+// postalFormat is the postal code format of a country
+type postalFormat struct {
+	// valid matches normalized codes
+	valid *regexp.Regexp
+	// normalize optionally rewrites upper cased codes without spaces or
+	// hyphens
+	normalize func(s string) string
+}
+
+// postalFormats are the postal code formats by Zomato country ID
+var postalFormats = map[int64]postalFormat{
+	// India
+	1: {valid: regexp.MustCompile(`^[1-9][0-9]{5}$`)},
+	// Australia
+	14: {valid: regexp.MustCompile(`^[0-9]{4}$`)},
+	// Brazil
+	30: {valid: regexp.MustCompile(`^[0-9]{5}-[0-9]{3}$`), normalize: splitAt(5, "-")},
+	// Canada
+	37: {valid: regexp.MustCompile(`^[A-Z][0-9][A-Z] [0-9][A-Z][0-9]$`), normalize: splitAt(3, " ")},
+	// Indonesia
+	94: {valid: regexp.MustCompile(`^[0-9]{5}$`)},
+	// New Zealand
+	148: {valid: regexp.MustCompile(`^[0-9]{4}$`)},
+	// Philippines
+	162: {valid: regexp.MustCompile(`^[0-9]{4}$`)},
+	// Singapore
+	184: {valid: regexp.MustCompile(`^[0-9]{6}$`)},
+	// South Africa
+	189: {valid: regexp.MustCompile(`^[0-9]{4}$`)},
+	// Sri Lanka
+	191: {valid: regexp.MustCompile(`^[0-9]{5}$`)},
+	// Turkey
+	208: {valid: regexp.MustCompile(`^[0-9]{5}$`)},
+	// United Kingdom
+	215: {valid: regexp.MustCompile(`^(GIR 0AA|[A-Z]{1,2}[0-9][A-Z0-9]? [0-9][A-Z]{2})$`), normalize: splitAt(-3, " ")},
+	// United States
+	216: {valid: regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`), normalize: splitAt(5, "-")},
+}
+
+// anyPostalCode matches codes of countries of unknown format
+var anyPostalCode = regexp.MustCompile(`^[A-Z0-9]+([ -][A-Z0-9]+)*$`)
+
+// splitAt returns a normalize func inserting 'sep' at 'i', counting from the
+// end if negative.
+func splitAt(i int, sep string) func(s string) string {
+	return func(s string) string {
+		j := i
+		if j < 0 {
+			j += len(s)
+		}
+		if j <= 0 || j >= len(s) {
+			return s
+		}
+		return s[:j] + sep + s[j:]
+	}
+}
+
+// Normalize returns 'p' in the standard form of country 'countryID', as
+// "SW1A 1AA" for "sw1a1aa" in the United Kingdom. Codes of other formats
+// are returned upper cased, with spaces trimmed and collapsed.
+func (p PostalCode) Normalize(countryID int64) string {
+	s := strings.ToUpper(strings.Join(strings.Fields(string(p)), " "))
+	f, ok := postalFormats[countryID]
+	if !ok || f.valid.MatchString(s) {
+		return s
+	}
+
+	n := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if f.normalize != nil {
+		n = f.normalize(n)
+	}
+	if f.valid.MatchString(n) {
+		return n
+	}
+	return s
+}
+
+// Valid reports whether 'p' is a postal code of country 'countryID' once
+// normalized. Codes of countries of unknown format are valid when made of
+// letters, digits, spaces and hyphens.
+func (p PostalCode) Valid(countryID int64) bool {
+	s := p.Normalize(countryID)
+	if f, ok := postalFormats[countryID]; ok {
+		return f.valid.MatchString(s)
+	}
+	return anyPostalCode.MatchString(s)
+}
+
+// UnmarshalJSON convert JSON data to PostalCode, from a string or a number
+func (p *PostalCode) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return errors.Wrap(err, "UnmarshalJSON failed")
+	}
+
+	switch v := v.(type) {
+	case nil:
+	case string:
+		*p = PostalCode(v)
+	case json.Number:
+		*p = PostalCode(v.String())
+	default:
+		return errors.Errorf("invalid postal code %s", data)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package zomato_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-india/zomato"
+)
+
+func TestPostalCode(t *testing.T) {
+	tests := []struct {
+		code      zomato.PostalCode
+		countryID int64
+		want      string
+		valid     bool
+	}{
+		{"110085", 1, "110085", true},
+		{"110 085", 1, "110085", true},
+		{"0", 1, "0", false},
+		{"02134", 216, "02134", true},
+		{"021341234", 216, "02134-1234", true},
+		{"02134 1234", 216, "02134-1234", true},
+		{"2134", 216, "2134", false},
+		{"SW1A 1AA", 215, "SW1A 1AA", true},
+		{" sw1a1aa ", 215, "SW1A 1AA", true},
+		{"m11ae", 215, "M1 1AE", true},
+		{"gir0aa", 215, "GIR 0AA", true},
+		{"SW1A", 215, "SW1A", false},
+		{"k1a 0b1", 37, "K1A 0B1", true},
+		{"K1A0B1", 37, "K1A 0B1", true},
+		{"12345", 37, "12345", false},
+		{"01310100", 30, "01310-100", true},
+		{"2000", 14, "2000", true},
+		{"00186", 97, "00186", true},
+		{"d02 x285", 0, "D02 X285", true},
+		{"#12", 0, "#12", false},
+		{"", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.code.Normalize(tt.countryID); got != tt.want {
+			t.Errorf("PostalCode(%q).Normalize(%d) = %q, want %q", tt.code, tt.countryID, got, tt.want)
+		}
+		if got := tt.code.Valid(tt.countryID); got != tt.valid {
+			t.Errorf("PostalCode(%q).Valid(%d) = %t, want %t", tt.code, tt.countryID, got, tt.valid)
+		}
+	}
+}
+
+func TestRestaurantLocationZipcode(t *testing.T) {
+	tests := []struct {
+		json       string
+		zipcode    *zomato.PostalCode
+		postalCode string
+	}{
+		{`{"zipcode":"SW1A 1AA","country_id":215}`, postalCode("SW1A 1AA"), "SW1A 1AA"},
+		{`{"zipcode":"02134","country_id":216}`, postalCode("02134"), "02134"},
+		{`{"zipcode":110085,"country_id":1}`, postalCode("110085"), "110085"},
+		{`{"zipcode":"0","country_id":1}`, postalCode("0"), ""},
+		{`{"zipcode":"10003"}`, postalCode("10003"), ""},
+		{`{"zipcode":"","country_id":1}`, nil, ""},
+		{`{"zipcode":null}`, nil, ""},
+		{`{}`, nil, ""},
+	}
+
+	for _, tt := range tests {
+		var l zomato.RestaurantLocation
+		if err := json.Unmarshal([]byte(tt.json), &l); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %+v", tt.json, err)
+		}
+		if (l.Zipcode == nil) != (tt.zipcode == nil) || l.Zipcode != nil && *l.Zipcode != *tt.zipcode {
+			t.Errorf("Unmarshal(%s) zipcode = %v, want %v", tt.json, l.Zipcode, tt.zipcode)
+		}
+		code, ok := l.PostalCode()
+		if code != tt.postalCode || ok != (tt.postalCode != "") {
+			t.Errorf("Unmarshal(%s) PostalCode() = %q, %t, want %q", tt.json, code, ok, tt.postalCode)
+		}
+
+		data, err := json.Marshal(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.zipcode != nil && !strings.Contains(string(data), `"zipcode":"`+string(*tt.zipcode)+`"`) {
+			t.Errorf("Marshal() = %s, want zipcode %q", data, *tt.zipcode)
+		}
+	}
+
+	var l zomato.RestaurantLocation
+	if err := json.Unmarshal([]byte(`{"zipcode":{}}`), &l); err == nil {
+		t.Error("Unmarshal() of an object zipcode error = nil")
+	}
+}
+
+func postalCode(s string) *zomato.PostalCode {
+	p := zomato.PostalCode(s)
+	return &p
+}
@@ -0,0 +1,721 @@
+// Code generated by zomato-gen from swagger/swagger.json; DO NOT EDIT.
+
+package zomato
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	"github.com/pkg/errors"
+)
+
+// CategoriesReq parameters
+type CategoriesReq struct{}
+
+// Request encodes CategoriesReq parameters returning a new http.Request
+func (r CategoriesReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/categories"
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Categories gets a list of categories.
+//
+// List of all restaurants categorized under a particular restaurant type can
+// be obtained using /Search API with Category ID as inputs
+func (c Client) Categories(ctx context.Context) (resp CategoriesResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, CategoriesReq{})), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// CitiesReq parameters
+type CitiesReq struct {
+	// query by city name
+	Query string `url:"q,omitempty"`
+	// latitude
+	Latitude float64 `url:"lat,omitempty"`
+	// longitude
+	Longitude float64 `url:"lon,omitempty"`
+	// comma separated city_id values
+	CityIDs []int64 `url:"city_ids,comma,omitempty"`
+	// number of max results to display
+	Count uint64 `url:"count,omitempty"`
+}
+
+// Request encodes CitiesReq parameters returning a new http.Request
+func (r CitiesReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/cities"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Cities gets city details.
+//
+// Find the Zomato ID and other details for a city.
+// You can obtain the Zomato City ID in one of the following ways:
+//
+//	City Name in the Search Query - Returns list of cities matching the query
+//	Using coordinates - Identifies the city details based on the coordinates of any location inside a city
+//
+// If you already know the Zomato City ID, this API can be used to get other details of the city.
+func (c Client) Cities(ctx context.Context, req CitiesReq) (resp CitiesResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// CollectionsReq parameters
+type CollectionsReq struct {
+	// id of the city for which collections are needed
+	CityID int64 `url:"city_id,omitempty"`
+	// latitude / longitude of any point within a city
+	Latitude float64 `url:"lat,omitempty"`
+	// latitude / longitude of any point within a city
+	Longitude float64 `url:"lon,omitempty"`
+	// max number of results needed
+	Count uint64 `url:"count,omitempty"`
+}
+
+// Request encodes CollectionsReq parameters returning a new http.Request
+func (r CollectionsReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/collections"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Collections returns Zomato Restaurant Collections in a City.
+//
+// The location/City input can be provided in the following ways:
+//
+//	Using Zomato City ID
+//	Using coordinates of any location within a city
+//
+// List of all restaurants listed in any particular Zomato Collection can be
+// obtained using the '/search' API with Collection ID and Zomato City ID as the input
+func (c Client) Collections(ctx context.Context, req CollectionsReq) (resp CollectionsResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// CuisinesReq parameters
+type CuisinesReq struct {
+	// id of the city for which cuisines are needed
+	CityID int64 `url:"city_id,omitempty"`
+	// latitude / longitude of any point within a city
+	Latitude float64 `url:"lat,omitempty"`
+	// latitude / longitude of any point within a city
+	Longitude float64 `url:"lon,omitempty"`
+}
+
+// Request encodes CuisinesReq parameters returning a new http.Request
+func (r CuisinesReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/cuisines"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Cuisines gets a list of all cuisines of restaurants listed in a city.
+//
+// The location/City input can be provided in the following ways:
+//
+//	Using Zomato City ID
+//	Using coordinates of any location within a city
+//
+// List of all restaurants serving a particular cuisine can be obtained
+// using '/search' API with cuisine ID and location details
+func (c Client) Cuisines(ctx context.Context, req CuisinesReq) (resp CuisinesResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// DailyMenuReq parameters
+type DailyMenuReq struct {
+	// id of restaurant whose details are requested
+	RestaurantID int64 `url:"res_id" validate:"required"`
+}
+
+// Request encodes DailyMenuReq parameters returning a new http.Request
+func (r DailyMenuReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/dailymenu"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// DailyMenu gets daily menu using Zomato restaurant ID.
+func (c Client) DailyMenu(ctx context.Context, restaurantID int64) (resp DailyMenuResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	req := DailyMenuReq{
+		RestaurantID: restaurantID,
+	}
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// EstablishmentsReq parameters
+type EstablishmentsReq struct {
+	// id of the city
+	CityID int64 `url:"city_id,omitempty"`
+	// latitude / longitude of any point within a city
+	Latitude float64 `url:"lat,omitempty"`
+	// latitude / longitude of any point within a city
+	Longitude float64 `url:"lon,omitempty"`
+}
+
+// Request encodes EstablishmentsReq parameters returning a new http.Request
+func (r EstablishmentsReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/establishments"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Establishments gets a list of restaurant types in a city.
+//
+// The location/City input can be provided in the following ways:
+//
+//	Using Zomato City ID
+//	Using coordinates of any location within a city
+//
+// List of all restaurants categorized under a particular restaurant type can
+// be obtained using /Search API with Establishment ID and location details as inputs.
+func (c Client) Establishments(ctx context.Context, req EstablishmentsReq) (resp EstablishmentsResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// GeoCodeReq parameters
+type GeoCodeReq struct {
+	// latitude
+	Latitude float64 `url:"lat" validate:"required"`
+	// longitude
+	Longitude float64 `url:"lon" validate:"required"`
+}
+
+// Request encodes GeoCodeReq parameters returning a new http.Request
+func (r GeoCodeReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/geocode"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// GeoCode gets location details based on coordinates.
+//
+// Get Foodie and Nightlife Index, list of popular cuisines and nearby
+// restaurants around the given coordinates
+func (c Client) GeoCode(ctx context.Context, lat, long float64) (resp GeoCodeResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	req := GeoCodeReq{
+		Latitude:  lat,
+		Longitude: long,
+	}
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// LocationDetailsReq parameters
+type LocationDetailsReq struct {
+	// location id obtained from locations api
+	EntityID int64 `url:"entity_id" validate:"required"`
+	// location type obtained from locations api
+	EntityType EntityType `url:"entity_type" validate:"required"`
+}
+
+// Request encodes LocationDetailsReq parameters returning a new http.Request
+func (r LocationDetailsReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/location_details"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// LocationDetails gets Zomato location details.
+//
+// Get Foodie Index, Nightlife Index, Top Cuisines and Best rated restaurants in a given location.
+func (c Client) LocationDetails(ctx context.Context, entityID int64, entityType EntityType) (resp LocationDetailsResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	req := LocationDetailsReq{
+		EntityID:   entityID,
+		EntityType: entityType,
+	}
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// LocationsReq parameters
+type LocationsReq struct {
+	// suggestion for location name
+	Query string `url:"query" validate:"required"`
+	// latitude
+	Latitude float64 `url:"lat,omitempty"`
+	// longitude
+	Longitude float64 `url:"lon,omitempty"`
+	// max number of results to fetch
+	Count uint64 `url:"count,omitempty"`
+}
+
+// Request encodes LocationsReq parameters returning a new http.Request
+func (r LocationsReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/locations"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Locations searches for locations.
+//
+// Search for Zomato locations by keyword.
+// Provide coordinates to get better search results.
+func (c Client) Locations(ctx context.Context, req LocationsReq) (resp LocationsResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// RestaurantReq parameters
+type RestaurantReq struct {
+	// id of restaurant whose details are requested
+	RestaurantID int64 `url:"res_id" validate:"required"`
+}
+
+// Request encodes RestaurantReq parameters returning a new http.Request
+func (r RestaurantReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/restaurant"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Restaurant gets restaurant details.
+//
+// Get detailed restaurant information using Zomato restaurant ID.
+// Partner Access is required to access photos and reviews.
+func (c Client) Restaurant(ctx context.Context, restaurantID int64) (resp Restaurant, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	req := RestaurantReq{
+		RestaurantID: restaurantID,
+	}
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// ReviewsReq parameters
+type ReviewsReq struct {
+	// id of restaurant whose details are requested
+	RestaurantID int64 `url:"res_id" validate:"required"`
+	// fetch results after this offset
+	Start uint64 `url:"start,omitempty"`
+	// max number of results to retrieve
+	Count uint64 `url:"count,omitempty"`
+}
+
+// Request encodes ReviewsReq parameters returning a new http.Request
+func (r ReviewsReq) Request() (*http.Request, error) {
+	err := validate.Struct(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	urlStr := DefaultBaseURL + "/v2.1/reviews"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Reviews gets restaurant reviews.
+//
+// Get restaurant reviews using the Zomato restaurant ID.
+// Only 5 latest reviews are available under the Basic API plan.
+func (c Client) Reviews(ctx context.Context, req ReviewsReq) (resp ReviewsResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// SearchReq parameters
+type SearchReq struct {
+	// location id
+	EntityID int64 `url:"entity_id,omitempty"`
+	// location type
+	EntityType EntityType `url:"entity_type,omitempty"`
+	// search keyword
+	Query string `url:"q,omitempty"`
+	// fetch results after offset
+	Start uint64 `url:"start,omitempty"`
+	// max number of results to display
+	Count uint64 `url:"count,omitempty"`
+	// latitude
+	Latitude float64 `url:"lat,omitempty"`
+	// longitude
+	Longitude float64 `url:"lon,omitempty"`
+	// radius around (lat,lon); to define search area, defined in meters(M)
+	Radius float64 `url:"radius,omitempty"`
+	// list of cuisine id's separated by comma
+	Cuisines []string `url:"cuisines,comma,omitempty"`
+	// establishment id obtained from establishments call
+	Establishment string `url:"establishment_type,omitempty"`
+	// collection id obtained from collections call
+	Collection string `url:"collection_id,omitempty"`
+	// category ids obtained from categories call
+	Category string `url:"category,omitempty"`
+	// sort restaurants by ...
+	Sort Sort `url:"sort,omitempty"`
+	// used with 'sort' parameter to define ascending / descending
+	Order Order `url:"order,omitempty"`
+}
+
+// Request encodes SearchReq parameters returning a new http.Request
+func (r SearchReq) Request() (*http.Request, error) {
+	urlStr := DefaultBaseURL + "/v2.1/search"
+
+	values, err := query.Values(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding query params failed")
+	}
+	if params := values.Encode(); params != "" {
+		urlStr += "?" + params
+	}
+
+	return http.NewRequest(http.MethodGet, urlStr, nil)
+}
+
+// Search provides search for restaurants.
+//
+// The location input can be specified using Zomato location ID or coordinates.
+// Cuisine/Establishment/Collection IDs can be obtained from respective API calls.
+//
+// Get up to 100 restaurants by changing the 'start' and 'count' parameters with
+// the maximum value of count being 20.
+//
+// Examples:
+//
+//	To search for 'Italian' restaurants in 'Manhattan, New York City', set cuisines = 55, entity_id = 94741 and entity_type = zone
+//	To search for 'cafes' in 'Manhattan, New York City', set establishment_type = 1, entity_type = zone and entity_id = 94741
+//	Get list of all restaurants in 'Trending this Week' collection in 'New York City' by using entity_id = 280, entity_type = city and collection_id = 1
+//
+// Partner Access is required to access photos and reviews.
+func (c Client) Search(ctx context.Context, req SearchReq) (resp SearchResp, err error) {
+	if c.Auth == nil {
+		return resp, ErrNoAuth
+	}
+
+	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
+	return resp, errors.Wrap(err, "Client.Do failed")
+}
+
+// API lists the endpoint methods of Client, so that decorators and fakes can
+// stand in for it.
+type API interface {
+	Categories(ctx context.Context) (CategoriesResp, error)
+	Cities(ctx context.Context, req CitiesReq) (CitiesResp, error)
+	Collections(ctx context.Context, req CollectionsReq) (CollectionsResp, error)
+	Cuisines(ctx context.Context, req CuisinesReq) (CuisinesResp, error)
+	DailyMenu(ctx context.Context, restaurantID int64) (DailyMenuResp, error)
+	Establishments(ctx context.Context, req EstablishmentsReq) (EstablishmentsResp, error)
+	GeoCode(ctx context.Context, lat, long float64) (GeoCodeResp, error)
+	LocationDetails(ctx context.Context, entityID int64, entityType EntityType) (LocationDetailsResp, error)
+	Locations(ctx context.Context, req LocationsReq) (LocationsResp, error)
+	Restaurant(ctx context.Context, restaurantID int64) (Restaurant, error)
+	Reviews(ctx context.Context, req ReviewsReq) (ReviewsResp, error)
+	Search(ctx context.Context, req SearchReq) (SearchResp, error)
+}
+
+var _ API = Client{}
+
+// Interceptor is called for each call of API method 'method' with request
+// 'req'. It makes the call using 'invoke' and returns its response.
+type Interceptor func(ctx context.Context, method string, req Requester,
+	invoke func(ctx context.Context) (interface{}, error)) (interface{}, error)
+
+// Intercept returns an API making the calls of 'api' through 'f'.
+func Intercept(api API, f Interceptor) API {
+	return intercepted{api: api, f: f}
+}
+
+type intercepted struct {
+	api API
+	f   Interceptor
+}
+
+// Categories implements API
+func (a intercepted) Categories(ctx context.Context) (resp CategoriesResp, err error) {
+	req := CategoriesReq{}
+	v, err := a.f(ctx, "Categories", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Categories(ctx)
+	})
+	if v, ok := v.(CategoriesResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Cities implements API
+func (a intercepted) Cities(ctx context.Context, req CitiesReq) (resp CitiesResp, err error) {
+	v, err := a.f(ctx, "Cities", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Cities(ctx, req)
+	})
+	if v, ok := v.(CitiesResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Collections implements API
+func (a intercepted) Collections(ctx context.Context, req CollectionsReq) (resp CollectionsResp, err error) {
+	v, err := a.f(ctx, "Collections", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Collections(ctx, req)
+	})
+	if v, ok := v.(CollectionsResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Cuisines implements API
+func (a intercepted) Cuisines(ctx context.Context, req CuisinesReq) (resp CuisinesResp, err error) {
+	v, err := a.f(ctx, "Cuisines", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Cuisines(ctx, req)
+	})
+	if v, ok := v.(CuisinesResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// DailyMenu implements API
+func (a intercepted) DailyMenu(ctx context.Context, restaurantID int64) (resp DailyMenuResp, err error) {
+	req := DailyMenuReq{
+		RestaurantID: restaurantID,
+	}
+	v, err := a.f(ctx, "DailyMenu", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.DailyMenu(ctx, restaurantID)
+	})
+	if v, ok := v.(DailyMenuResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Establishments implements API
+func (a intercepted) Establishments(ctx context.Context, req EstablishmentsReq) (resp EstablishmentsResp, err error) {
+	v, err := a.f(ctx, "Establishments", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Establishments(ctx, req)
+	})
+	if v, ok := v.(EstablishmentsResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// GeoCode implements API
+func (a intercepted) GeoCode(ctx context.Context, lat, long float64) (resp GeoCodeResp, err error) {
+	req := GeoCodeReq{
+		Latitude:  lat,
+		Longitude: long,
+	}
+	v, err := a.f(ctx, "GeoCode", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.GeoCode(ctx, lat, long)
+	})
+	if v, ok := v.(GeoCodeResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// LocationDetails implements API
+func (a intercepted) LocationDetails(ctx context.Context, entityID int64, entityType EntityType) (resp LocationDetailsResp, err error) {
+	req := LocationDetailsReq{
+		EntityID:   entityID,
+		EntityType: entityType,
+	}
+	v, err := a.f(ctx, "LocationDetails", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.LocationDetails(ctx, entityID, entityType)
+	})
+	if v, ok := v.(LocationDetailsResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Locations implements API
+func (a intercepted) Locations(ctx context.Context, req LocationsReq) (resp LocationsResp, err error) {
+	v, err := a.f(ctx, "Locations", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Locations(ctx, req)
+	})
+	if v, ok := v.(LocationsResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Restaurant implements API
+func (a intercepted) Restaurant(ctx context.Context, restaurantID int64) (resp Restaurant, err error) {
+	req := RestaurantReq{
+		RestaurantID: restaurantID,
+	}
+	v, err := a.f(ctx, "Restaurant", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Restaurant(ctx, restaurantID)
+	})
+	if v, ok := v.(Restaurant); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Reviews implements API
+func (a intercepted) Reviews(ctx context.Context, req ReviewsReq) (resp ReviewsResp, err error) {
+	v, err := a.f(ctx, "Reviews", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Reviews(ctx, req)
+	})
+	if v, ok := v.(ReviewsResp); ok {
+		resp = v
+	}
+	return resp, err
+}
+
+// Search implements API
+func (a intercepted) Search(ctx context.Context, req SearchReq) (resp SearchResp, err error) {
+	v, err := a.f(ctx, "Search", req, func(ctx context.Context) (interface{}, error) {
+		return a.api.Search(ctx, req)
+	})
+	if v, ok := v.(SearchResp); ok {
+		resp = v
+	}
+	return resp, err
+}
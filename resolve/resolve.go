@@ -0,0 +1,273 @@
+// Package resolve resolves place names as users type them to the locations
+// search takes.
+//
+//	r := resolve.New(client)
+//	m, err := r.Resolve(ctx, resolve.Query{Text: "Connaught Place, Delhi"})
+//	if err != nil {
+//		return err
+//	}
+//	req := zomato.SearchReq{EntityID: m.EntityID(), EntityType: m.EntityType()}
+//
+// Suggestions of the Locations API are ranked by how alike their title is
+// to the text, whether they are in the hinted city and how near they are.
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound is returned when no location matches a query
+var ErrNotFound = errors.New("resolve: no location found")
+
+// Weights of the parts of the confidence of a match; parts not asked for
+// are left out.
+const (
+	textWeight      = 0.6
+	cityWeight      = 0.25
+	proximityWeight = 0.15
+)
+
+// Query is a place to resolve
+type Query struct {
+	// Text is the place name, like "Connaught Place, Delhi"
+	Text string
+	// City optionally names the city of the place; defaults to the text
+	// after the last comma of Text, if any
+	City string
+	// Near optionally favours locations near it
+	Near *geo.Coordinates
+}
+
+// Match is a location resolved
+type Match struct {
+	Location zomato.Location
+	// Confidence is how well Location matches, from 0 to 1
+	Confidence float64
+}
+
+// EntityID returns the ID of the location.
+func (m Match) EntityID() int64 {
+	if m.Location.EntityID == nil {
+		return 0
+	}
+	return *m.Location.EntityID
+}
+
+// EntityType returns the type of the location.
+func (m Match) EntityType() zomato.EntityType {
+	if m.Location.EntityType == nil {
+		return ""
+	}
+	return zomato.EntityType(*m.Location.EntityType)
+}
+
+// Resolver resolves place names with Locations, and Cities to tell apart
+// cities hinted by name. Resolutions are cached.
+//
+// Resolver is safe for use by multiple go routines. Cached matches are
+// shared by callers and must not be modified.
+type Resolver struct {
+	API zomato.API
+	// Count is the number of suggestions ranked; defaults to 10
+	Count uint64
+	// Scale is the distance in meters from Near at which proximity counts
+	// half; defaults to 5km
+	Scale float64
+	// TTL is how long resolutions are cached; 0 keeps them forever
+	TTL time.Duration
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]resolution
+}
+
+type resolution struct {
+	matches []Match
+	expires time.Time
+}
+
+// New returns a resolver using 'api'.
+func New(api zomato.API) *Resolver {
+	return &Resolver{API: api, Count: 10, Scale: 5000, Now: time.Now}
+}
+
+// Resolve returns the best match of 'q', or ErrNotFound.
+func (r *Resolver) Resolve(ctx context.Context, q Query) (Match, error) {
+	ms, err := r.Candidates(ctx, q)
+	if err != nil {
+		return Match{}, err
+	}
+	if len(ms) == 0 {
+		return Match{}, ErrNotFound
+	}
+	return ms[0], nil
+}
+
+// Candidates returns the matches of 'q', best first.
+func (r *Resolver) Candidates(ctx context.Context, q Query) ([]Match, error) {
+	place, city := split(q)
+	if normalize(place) == "" {
+		return nil, errors.New("resolve: empty place name")
+	}
+
+	key := normalize(place) + "|" + normalize(city)
+	if q.Near != nil {
+		key += fmt.Sprintf("|%.3f,%.3f", q.Near.Lat, q.Near.Lon)
+	}
+	now := r.now()
+	r.mu.Lock()
+	res, found := r.cache[key]
+	r.mu.Unlock()
+	if found && (res.expires.IsZero() || now.Before(res.expires)) {
+		return res.matches, nil
+	}
+
+	ms, err := r.rank(ctx, place, city, q.Near)
+	if err != nil {
+		return nil, err
+	}
+
+	res = resolution{matches: ms}
+	if r.TTL > 0 {
+		res.expires = now.Add(r.TTL)
+	}
+	r.mu.Lock()
+	if r.cache == nil {
+		r.cache = make(map[string]resolution)
+	}
+	r.cache[key] = res
+	r.mu.Unlock()
+	return ms, nil
+}
+
+// rank fetches and scores the suggestions for 'place'.
+func (r *Resolver) rank(ctx context.Context, place, city string, near *geo.Coordinates) ([]Match, error) {
+	req := zomato.LocationsReq{Query: place, Count: r.Count}
+	if req.Count == 0 {
+		req.Count = 10
+	}
+	if near != nil {
+		req.Latitude, req.Longitude = near.Lat, near.Lon
+	}
+	resp, err := r.API.Locations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	ls := resp.LocationSuggestions
+
+	cityID, err := r.cityID(ctx, city, ls)
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]Match, 0, len(ls))
+	for _, l := range ls {
+		ms = append(ms, Match{Location: l, Confidence: r.score(l, place, city, cityID, near)})
+	}
+	sort.SliceStable(ms, func(i, j int) bool { return ms[i].Confidence > ms[j].Confidence })
+	return ms, nil
+}
+
+// cityID returns the ID of the city named 'city', or 0 when no city is
+// hinted or a suggestion of 'ls' is in a city of that very name.
+func (r *Resolver) cityID(ctx context.Context, city string, ls []zomato.Location) (int64, error) {
+	if normalize(city) == "" || len(ls) == 0 {
+		return 0, nil
+	}
+	for _, l := range ls {
+		if l.CityName != nil && normalize(*l.CityName) == normalize(city) {
+			return 0, nil
+		}
+	}
+
+	resp, err := r.API.Cities(ctx, zomato.CitiesReq{Query: city, Count: 1})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.LocationSuggestions) == 0 {
+		return 0, nil
+	}
+	return resp.LocationSuggestions[0].ID, nil
+}
+
+// score returns the confidence of 'l' matching.
+func (r *Resolver) score(l zomato.Location, place, city string, cityID int64, near *geo.Coordinates) float64 {
+	var title string
+	if l.Title != nil {
+		title = *l.Title
+	}
+	text := Similarity(place, title)
+	// Titles often end in their city, as in "Connaught Place, New Delhi"
+	if i := strings.Index(title, ","); i >= 0 {
+		if s := Similarity(place, title[:i]); s > text {
+			text = s
+		}
+	}
+	sum, weights := textWeight*text, textWeight
+
+	if normalize(city) != "" {
+		var s float64
+		if cityID != 0 && l.CityID != nil && *l.CityID == cityID {
+			s = 1
+		} else if l.CityName != nil {
+			s = Similarity(city, *l.CityName)
+		}
+		sum, weights = sum+cityWeight*s, weights+cityWeight
+	}
+
+	if near != nil {
+		var s float64
+		if c, ok := geo.OfLocation(l); ok {
+			s = 1 / (1 + geo.Haversine(*near, c)/r.scale())
+		}
+		sum, weights = sum+proximityWeight*s, weights+proximityWeight
+	}
+	return sum / weights
+}
+
+// split returns the place name and city of 'q'.
+func split(q Query) (place, city string) {
+	place, city = q.Text, q.City
+	if city == "" {
+		if i := strings.LastIndex(place, ","); i >= 0 {
+			place, city = place[:i], place[i+1:]
+		}
+	}
+	return strings.TrimSpace(place), strings.TrimSpace(city)
+}
+
+// Purge removes expired resolutions, or all if 'all'.
+func (r *Resolver) Purge(all bool) {
+	now := r.now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, res := range r.cache {
+		if all || !res.expires.IsZero() && !now.Before(res.expires) {
+			delete(r.cache, k)
+		}
+	}
+}
+
+func (r *Resolver) scale() float64 {
+	if r.Scale <= 0 {
+		return 5000
+	}
+	return r.Scale
+}
+
+func (r *Resolver) now() time.Time {
+	if r.Now == nil {
+		return time.Now()
+	}
+	return r.Now()
+}
@@ -0,0 +1,165 @@
+package resolve_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/geo"
+	"github.com/go-india/zomato/resolve"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+// location returns a subzone 'id' titled 'title' in city 'cityID'
+func location(id int64, title string, cityID int64, city string, lat, lon float64) zomato.Location {
+	typ := string(zomato.SubZone)
+	return zomato.Location{EntityID: &id, EntityType: &typ, Title: &title,
+		CityID: &cityID, CityName: &city, Latitude: &lat, Longitude: &lon}
+}
+
+var (
+	cpDelhi    = location(101, "Connaught Place", 1, "Delhi NCR", 28.6315, 77.2167)
+	cpDehradun = location(202, "Connaught Place", 9, "Dehradun", 30.3244, 78.0418)
+	janpath    = location(103, "Janpath, Connaught Place", 1, "Delhi NCR", 28.6247, 77.2183)
+	dehradun   = geo.Coordinates{Lat: 30.3165, Lon: 78.0322}
+)
+
+// locator mocks Locations and Cities, suggesting 'ls' for any query
+func locator(ls ...zomato.Location) *zomatotest.Mock {
+	return &zomatotest.Mock{
+		LocationsFunc: func(ctx context.Context, req zomato.LocationsReq) (zomato.LocationsResp, error) {
+			return zomato.LocationsResp{LocationSuggestions: ls}, nil
+		},
+		CitiesFunc: func(ctx context.Context, req zomato.CitiesReq) (zomato.CitiesResp, error) {
+			return zomato.CitiesResp{LocationSuggestions: []zomato.City{{ID: 1, Name: "Delhi NCR"}}}, nil
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		q      resolve.Query
+		ls     []zomato.Location
+		want   int64
+		cities int
+	}{
+		{"city from text", resolve.Query{Text: "Connaught Place, Delhi"},
+			[]zomato.Location{cpDehradun, janpath, cpDelhi}, 101, 1},
+		{"city hint", resolve.Query{Text: "Connaught Place", City: "Dehradun"},
+			[]zomato.Location{cpDelhi, janpath, cpDehradun}, 202, 0},
+		{"near", resolve.Query{Text: "connaught place", Near: &dehradun},
+			[]zomato.Location{cpDelhi, cpDehradun}, 202, 0},
+		{"title", resolve.Query{Text: "Janpath"},
+			[]zomato.Location{cpDelhi, janpath}, 103, 0},
+		{"first of equals", resolve.Query{Text: "Connaught Place"},
+			[]zomato.Location{cpDehradun, cpDelhi}, 202, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := locator(tt.ls...)
+			got, err := resolve.New(m).Resolve(ctx, tt.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.EntityID() != tt.want || got.EntityType() != zomato.SubZone {
+				t.Errorf("Resolve() = %d %s, want %d subzone", got.EntityID(), got.EntityType(), tt.want)
+			}
+			if got.Confidence <= 0 || got.Confidence > 1 {
+				t.Errorf("Resolve() confidence = %f, want in (0, 1]", got.Confidence)
+			}
+			if calls := len(m.CallsTo("Cities")); calls != tt.cities {
+				t.Errorf("Cities called %d times, want %d", calls, tt.cities)
+			}
+		})
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	m := locator(cpDehradun, janpath, cpDelhi)
+	ms, err := resolve.New(m).Candidates(context.Background(), resolve.Query{Text: " Connaught Place ", City: "Delhi", Near: &dehradun})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 3 {
+		t.Fatalf("Candidates() = %d matches, want 3", len(ms))
+	}
+	for i := 1; i < len(ms); i++ {
+		if ms[i].Confidence > ms[i-1].Confidence {
+			t.Errorf("Candidates() not best first: %f before %f", ms[i-1].Confidence, ms[i].Confidence)
+		}
+	}
+
+	req := m.CallsTo("Locations")[0].Req.(zomato.LocationsReq)
+	if req.Query != "Connaught Place" || req.Latitude != dehradun.Lat || req.Longitude != dehradun.Lon {
+		t.Errorf("Locations called with %+v", req)
+	}
+	if req := m.CallsTo("Cities")[0].Req.(zomato.CitiesReq); req.Query != "Delhi" {
+		t.Errorf("Cities called with %+v", req)
+	}
+}
+
+func TestResolveCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	m := locator(cpDelhi)
+	r := resolve.New(m)
+	r.TTL = time.Hour
+	r.Now = func() time.Time { return now }
+
+	for _, step := range []struct {
+		text  string
+		after time.Duration
+		calls int
+	}{
+		{"Connaught Place, Delhi", 0, 1},
+		{"connaught place,  DELHI", time.Minute, 1},
+		{"Connaught Place", time.Minute, 2},
+		{"Connaught Place, Delhi", time.Hour, 3},
+	} {
+		now = now.Add(step.after)
+		if _, err := r.Resolve(ctx, resolve.Query{Text: step.text}); err != nil {
+			t.Fatal(err)
+		}
+		if calls := len(m.CallsTo("Locations")); calls != step.calls {
+			t.Errorf("%q: Locations called %d times, want %d", step.text, calls, step.calls)
+		}
+	}
+
+	r.Purge(true)
+	if _, err := r.Resolve(ctx, resolve.Query{Text: "Connaught Place"}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := len(m.CallsTo("Locations")); calls != 4 {
+		t.Errorf("after Purge: Locations called %d times, want 4", calls)
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := resolve.New(locator()).Resolve(ctx, resolve.Query{Text: "Atlantis"}); err != resolve.ErrNotFound {
+		t.Errorf("Resolve() error = %v, want ErrNotFound", err)
+	}
+	if _, err := resolve.New(locator(cpDelhi)).Resolve(ctx, resolve.Query{Text: " , Delhi"}); err == nil {
+		t.Error("Resolve() of no place error = nil")
+	}
+
+	m := &zomatotest.Mock{
+		LocationsFunc: func(ctx context.Context, req zomato.LocationsReq) (zomato.LocationsResp, error) {
+			return zomato.LocationsResp{}, &zomato.ErrAPI{StatusCode: http.StatusServiceUnavailable}
+		},
+	}
+	r := resolve.New(m)
+	for i := 0; i < 2; i++ {
+		if _, err := r.Resolve(ctx, resolve.Query{Text: "Connaught Place"}); err == nil {
+			t.Error("Resolve() error = nil, want the API error")
+		}
+	}
+	if calls := len(m.CallsTo("Locations")); calls != 2 {
+		t.Errorf("Locations called %d times, want errors not cached", calls)
+	}
+}
@@ -0,0 +1,55 @@
+package resolve
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Similarity returns how alike place names 'a' and 'b' are, from 0 to 1.
+// It is the Dice coefficient of the letter pairs of their words, ignoring
+// case, punctuation and word order.
+func Similarity(a, b string) float64 {
+	a, b = normalize(a), normalize(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	pa, na := bigrams(a)
+	pb, nb := bigrams(b)
+	var shared int
+	for p, c := range pa {
+		if pb[p] < c {
+			c = pb[p]
+		}
+		shared += c
+	}
+	return 2 * float64(shared) / float64(na+nb)
+}
+
+// bigrams counts the letter pairs of the words of 's', single letter words
+// counting as a pair with a space.
+func bigrams(s string) (map[string]int, int) {
+	pairs := make(map[string]int)
+	var n int
+	for _, w := range strings.Fields(s) {
+		rs := []rune(w)
+		if len(rs) == 1 {
+			rs = append(rs, ' ')
+		}
+		for i := 0; i+1 < len(rs); i++ {
+			pairs[string(rs[i:i+2])]++
+			n++
+		}
+	}
+	return pairs, n
+}
+
+// normalize lower cases 's', keeping words of letters and digits only.
+func normalize(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
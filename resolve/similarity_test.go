@@ -0,0 +1,34 @@
+package resolve_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-india/zomato/resolve"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"Connaught Place", "connaught place", 1},
+		{"Connaught Place", "Connaught-Place!", 1},
+		{"night", "nacht", 0.25},
+		{"Connaught Place", "Place Connaught", 1},
+		{"Delhi", "Delhi NCR", 0.8},
+		{"CP", "Connaught Place", 0},
+		{"", "Delhi", 0},
+		{"?!", "?!", 0},
+	}
+
+	for _, tt := range tests {
+		got := resolve.Similarity(tt.a, tt.b)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Similarity(%q, %q) = %f, want %f", tt.a, tt.b, got, tt.want)
+		}
+		if back := resolve.Similarity(tt.b, tt.a); back != got {
+			t.Errorf("Similarity(%q, %q) not symmetric: %f, %f", tt.a, tt.b, got, back)
+		}
+	}
+}
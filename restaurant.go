@@ -1,43 +1,14 @@
 package zomato
 
 import (
-	"context"
 	"encoding/json"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
 )
 
-// DailyMenuReq parameters
-type DailyMenuReq struct {
-	// ID of restaurant whose details are requested
-	RestaurantID int64 `url:"res_id" validate:"required"`
-}
-
-// Request encodes DailyMenuReq parameters returning a new http.Request
-func (r DailyMenuReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
-
-	urlStr := DefaultBaseURL + "/v2.1/dailymenu"
-
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
-	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
 // DailyMenuResp holds daily menus of a restaurant
 type DailyMenuResp struct {
 	Status     *string `json:"status,omitempty"`
@@ -57,8 +28,8 @@ type Dish struct {
 type DailyMenu struct {
 	ID        *int64     `json:"daily_menu_id,string,omitempty"` // ID of the restaurant
 	Name      *string    `json:"name,omitempty"`                 // Name of the restaurant
-	StartDate *time.Time // `json:"start_date,omitempty"`           // Daily Menu start timestamp
-	EndDate   *time.Time // `json:"end_date,omitempty"`             // Daily Menu end timestamp
+	StartDate *time.Time `json:"-"`                              // Daily Menu start timestamp
+	EndDate   *time.Time `json:"-"`                              // Daily Menu end timestamp
 	Dishes    []struct {
 		Dish *Dish `json:"dish,omitempty"`
 	} `json:"dishes,omitempty"` // Menu item in the category
@@ -98,53 +69,23 @@ func (d *DailyMenu) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// DailyMenu gets daily menu using Zomato restaurant ID.
-func (c Client) DailyMenu(ctx context.Context, restaurantID int64) (resp DailyMenuResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, DailyMenuReq{RestaurantID: restaurantID})), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
-}
-
-// RestaurantReq parameters
-type RestaurantReq struct {
-	// ID of restaurant whose details are requested
-	RestaurantID int64 `url:"res_id" validate:"required"`
-}
-
-// Request encodes RestaurantReq parameters returning a new http.Request
-func (r RestaurantReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
+// MarshalJSON convert struct to JSON data
+func (d DailyMenu) MarshalJSON() ([]byte, error) {
+	type Alias DailyMenu
+	t := struct {
+		Alias
 
-	urlStr := DefaultBaseURL + "/v2.1/restaurant"
+		StartDate string `json:"start_date,omitempty"`
+		EndDate   string `json:"end_date,omitempty"`
+	}{Alias: Alias(d)}
 
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
+	if d.StartDate != nil {
+		t.StartDate = d.StartDate.Format("2006-01-02 15:04:05")
 	}
-
-	return http.NewRequest(http.MethodGet, urlStr, nil)
-}
-
-// Restaurant gets restaurant details.
-//
-// Get detailed restaurant information using Zomato restaurant ID.
-// Partner Access is required to access photos and reviews.
-func (c Client) Restaurant(ctx context.Context, restaurantID int64) (resp Restaurant, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
+	if d.EndDate != nil {
+		t.EndDate = d.EndDate.Format("2006-01-02 15:04:05")
 	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, RestaurantReq{RestaurantID: restaurantID})), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
+	return json.Marshal(t)
 }
 
 // Restaurant holds a restaurant details
@@ -154,14 +95,17 @@ type Restaurant struct {
 	URL      *string             `json:"url,omitempty"`       // URL of the restaurant page
 	Location *RestaurantLocation `json:"location,omitempty"`  // Restaurant location details
 
-	// List of cuisines served at the restaurant in csv format
-	Cuisines []string // `json:"cuisines,omitempty"`
+	// List of cuisines served at the restaurant, sent in csv format
+	Cuisines []string `json:"-"`
 	// Average price of a meal for two people
 	AverageCostForTwo *int64 `json:"average_cost_for_two,omitempty"`
 	// Price bracket of the restaurant (1 being pocket friendly and 4 being the costliest)
 	PriceRange *uint8 `json:"price_range,omitempty"`
 	// Local currency symbol; to be used with price
 	Currency *string `json:"currency,omitempty"`
+	// Opening hours, as in "12 Noon to 3:30 PM, 7 PM to 11:30 PM (Mon-Sun)";
+	// see OpeningHours
+	Timings *string `json:"timings,omitempty"`
 	// Restaurant rating details
 	UserRating *UserRating `json:"user_rating,omitempty"`
 
@@ -183,12 +127,12 @@ type Restaurant struct {
 	BookURL          *string `json:"book_url,omitempty"`
 
 	// Whether the restaurant has online delivery enabled or not
-	HasOnlineDelivery *bool // `json:"has_online_delivery,omitempty"`
+	HasOnlineDelivery *bool `json:"-"`
 	// Valid only if has_online_delivery = 1;
 	// whether the restaurant is accepting online orders right now
-	IsDeliveringNow   *bool // `json:"is_delivering_now,omitempty"`
-	HasTableBooking   *bool // `json:"has_table_booking,omitempty"`
-	SwitchToOrderMenu *bool // `json:"switch_to_order_menu,omitempty"`
+	IsDeliveringNow   *bool `json:"-"`
+	HasTableBooking   *bool `json:"-"`
+	SwitchToOrderMenu *bool `json:"-"`
 
 	// TODO find their structure
 	Offers             []interface{} `json:"offers,omitempty"`
@@ -220,35 +164,88 @@ func (r *Restaurant) UnmarshalJSON(data []byte) error {
 	t := struct {
 		Alias
 		Cuisines          string `json:"cuisines,omitempty"`
-		HasOnlineDelivery uint8  `json:"has_online_delivery,omitempty"`
-		IsDeliveringNow   uint8  `json:"is_delivering_now,omitempty"`
-		HasTableBooking   uint8  `json:"has_table_booking,omitempty"`
-		SwitchToOrderMenu uint8  `json:"switch_to_order_menu,omitempty"`
+		HasOnlineDelivery *uint8 `json:"has_online_delivery,omitempty"`
+		IsDeliveringNow   *uint8 `json:"is_delivering_now,omitempty"`
+		HasTableBooking   *uint8 `json:"has_table_booking,omitempty"`
+		SwitchToOrderMenu *uint8 `json:"switch_to_order_menu,omitempty"`
 	}{}
 	if err := json.Unmarshal(data, &t); err != nil {
 		return errors.Wrap(err, "UnmarshalJSON failed")
 	}
 
 	*r = Restaurant(t.Alias)
-	r.HasOnlineDelivery = newBool(t.HasOnlineDelivery == 1)
-	r.IsDeliveringNow = newBool(t.IsDeliveringNow == 1)
-	r.HasTableBooking = newBool(t.HasTableBooking == 1)
-	r.SwitchToOrderMenu = newBool(t.SwitchToOrderMenu == 1)
-	r.Cuisines = strings.Split(t.Cuisines, ",")
+	// Flags missing from partial responses stay nil
+	r.HasOnlineDelivery = uint8ToBool(t.HasOnlineDelivery)
+	r.IsDeliveringNow = uint8ToBool(t.IsDeliveringNow)
+	r.HasTableBooking = uint8ToBool(t.HasTableBooking)
+	r.SwitchToOrderMenu = uint8ToBool(t.SwitchToOrderMenu)
+	r.Cuisines = splitCuisines(t.Cuisines)
+
+	if loc, err := r.TimeZone(); err == nil {
+		for _, e := range r.ZomatoEvents {
+			if e.Event != nil {
+				e.Event.In(loc)
+			}
+		}
+	}
 	return nil
 }
 
+// splitCuisines returns the cuisines of csv 's', trimmed, leaving out
+// empty ones.
+func splitCuisines(s string) []string {
+	var cuisines []string
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			cuisines = append(cuisines, c)
+		}
+	}
+	return cuisines
+}
+
+// MarshalJSON convert struct to JSON data
+func (r Restaurant) MarshalJSON() ([]byte, error) {
+	type Alias Restaurant
+	t := struct {
+		Alias
+		Cuisines          string `json:"cuisines"`
+		HasOnlineDelivery *uint8 `json:"has_online_delivery,omitempty"`
+		IsDeliveringNow   *uint8 `json:"is_delivering_now,omitempty"`
+		HasTableBooking   *uint8 `json:"has_table_booking,omitempty"`
+		SwitchToOrderMenu *uint8 `json:"switch_to_order_menu,omitempty"`
+
+		// API sends empty lists as [], keep them apart from missing ones
+		Offers             *[]interface{} `json:"offers,omitempty"`
+		EstablishmentTypes *[]interface{} `json:"establishment_types,omitempty"`
+	}{
+		Alias:             Alias(r),
+		Cuisines:          strings.Join(r.Cuisines, ", "),
+		HasOnlineDelivery: boolToUint8Ptr(r.HasOnlineDelivery),
+		IsDeliveringNow:   boolToUint8Ptr(r.IsDeliveringNow),
+		HasTableBooking:   boolToUint8Ptr(r.HasTableBooking),
+		SwitchToOrderMenu: boolToUint8Ptr(r.SwitchToOrderMenu),
+	}
+
+	if r.Offers != nil {
+		t.Offers = &r.Offers
+	}
+	if r.EstablishmentTypes != nil {
+		t.EstablishmentTypes = &r.EstablishmentTypes
+	}
+	return json.Marshal(t)
+}
+
 // RestaurantLocation holds restaurant location details
 type RestaurantLocation struct {
-	Address         *string  `json:"address,omitempty"`  // Complete address of the restaurant
-	Locality        *string  `json:"locality,omitempty"` // Name of the locality
-	City            *string  `json:"city,omitempty"`     // Name of the city
-	CityID          *int64   `json:"city_id,omitempty"`
-	Latitude        *float64 `json:"latitude,string,omitempty"`  // Coordinates of the restaurant
-	Longitude       *float64 `json:"longitude,string,omitempty"` // Coordinates of the restaurant
-	Zipcode         *int64   // `json:"zipcode,omitempty"`          // Zipcode
-	CountryID       *int64   `json:"country_id,omitempty"` // ID of the country
-	LocalityVerbose *string  `json:"locality_verbose,omitempty"`
+	Address         *string     `json:"address,omitempty"`  // Complete address of the restaurant
+	Locality        *string     `json:"locality,omitempty"` // Name of the locality
+	City            *string     `json:"city,omitempty"`     // Name of the city
+	CityID          *int64      `json:"city_id,omitempty"`
+	Latitude        *float64    `json:"latitude,string,omitempty"`  // Coordinates of the restaurant
+	Longitude       *float64    `json:"longitude,string,omitempty"` // Coordinates of the restaurant
+	Zipcode         *PostalCode `json:"-"`                          // Zipcode, as sent
+	CountryID       *int64      `json:"country_id,omitempty"`       // ID of the country
+	LocalityVerbose *string     `json:"locality_verbose,omitempty"`
 }
 
 // UnmarshalJSON convert JSON data to struct
@@ -256,7 +253,7 @@ func (r *RestaurantLocation) UnmarshalJSON(data []byte) error {
 	type Alias RestaurantLocation
 	t := struct {
 		Alias
-		Zipcode string `json:"zipcode"`
+		Zipcode PostalCode `json:"zipcode"`
 	}{}
 	if err := json.Unmarshal(data, &t); err != nil {
 		return errors.Wrap(err, "UnmarshalJSON failed")
@@ -265,17 +262,35 @@ func (r *RestaurantLocation) UnmarshalJSON(data []byte) error {
 	*r = RestaurantLocation(t.Alias)
 
 	if t.Zipcode != "" {
-		zc, err := strconv.Atoi(t.Zipcode)
-		if err != nil {
-			return errors.Wrap(err, "parse Zipcode failed")
-		}
-		z := int64(zc)
-		r.Zipcode = &z
+		r.Zipcode = &t.Zipcode
 	}
 
 	return nil
 }
 
+// MarshalJSON convert struct to JSON data
+func (r RestaurantLocation) MarshalJSON() ([]byte, error) {
+	type Alias RestaurantLocation
+	t := struct {
+		Alias
+		Zipcode string `json:"zipcode"`
+	}{Alias: Alias(r)}
+
+	if r.Zipcode != nil {
+		t.Zipcode = string(*r.Zipcode)
+	}
+	return json.Marshal(t)
+}
+
+// PostalCode returns the zipcode normalized for the country of the
+// restaurant, if it is a valid one.
+func (r RestaurantLocation) PostalCode() (string, bool) {
+	if r.Zipcode == nil || r.CountryID == nil || !r.Zipcode.Valid(*r.CountryID) {
+		return "", false
+	}
+	return r.Zipcode.Normalize(*r.CountryID), true
+}
+
 // UserRating stores user rating details
 type UserRating struct {
 	// Restaurant rating on a scale of 0.0 to 5.0 in increments of 0.1
@@ -303,7 +318,7 @@ type Photo struct {
 	RestaurantID *int64  `json:"res_id,string,omitempty"` // ID of restaurant for which the image was uploaded
 	Caption      *string `json:"caption,omitempty"`       // Caption of the photo
 	// Unix timestamp when the photo was uploaded
-	Timestamp *time.Time // `json:"timestamp,string"`
+	Timestamp *time.Time `json:"-"`
 	// User friendly time string; denotes when the photo was uploaded
 	FriendlyTime  *string `json:"friendly_time,omitempty"`
 	Width         *int64  `json:"width,string,omitempty"`          // Image width in pixel; usually 640
@@ -332,6 +347,20 @@ func (p *Photo) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON convert struct to JSON data
+func (p Photo) MarshalJSON() ([]byte, error) {
+	type Alias Photo
+	t := struct {
+		Alias
+		Timestamp int64 `json:"timestamp,string,omitempty"`
+	}{Alias: Alias(p)}
+
+	if p.Timestamp != nil {
+		t.Timestamp = p.Timestamp.Unix()
+	}
+	return json.Marshal(t)
+}
+
 // User holds user details
 type User struct {
 	// User's name
@@ -356,16 +385,16 @@ type User struct {
 type Event struct {
 	ID *int64 `json:"event_id,omitempty"`
 
-	StartDate *time.Time // `json:"start_date"`
-	EndDate   *time.Time // `json:"end_date"`
-	EndTime   *time.Time // `json:"end_time"`
-	StartTime *time.Time // `json:"start_time"`
-	DateAdded *time.Time // `json:"date_added"`
+	StartDate *time.Time `json:"-"`
+	EndDate   *time.Time `json:"-"`
+	EndTime   *time.Time `json:"-"`
+	StartTime *time.Time `json:"-"`
+	DateAdded *time.Time `json:"-"`
 
-	IsActive     *bool // `json:"is_active"`
-	IsValid      *bool // `json:"is_valid"`
-	ShowShareURL *bool // `json:"show_share_url"`
-	IsEndTimeSet *bool // `json:"is_end_time_set"`
+	IsActive     *bool `json:"-"`
+	IsValid      *bool `json:"-"`
+	ShowShareURL *bool `json:"-"`
+	IsEndTimeSet *bool `json:"-"`
 
 	Photos []struct {
 		Photo *Photo `json:"photo,omitempty"`
@@ -458,34 +487,52 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// ReviewsReq parameters
-type ReviewsReq struct {
-	// ID of restaurant whose details are requested
-	RestaurantID int64 `url:"res_id" validate:"required"`
-	// Fetch results after this offset
-	Start uint64 `url:"start,omitempty"`
-	// Max number of results to retrieve
-	Count uint64 `url:"count,omitempty"`
-}
+// MarshalJSON convert struct to JSON data
+func (e Event) MarshalJSON() ([]byte, error) {
+	type Alias Event
+	t := struct {
+		Alias
 
-// Request encodes ReviewsReq parameters returning a new http.Request
-func (r ReviewsReq) Request() (*http.Request, error) {
-	err := validate.Struct(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid request")
-	}
+		StartDate string `json:"start_date,omitempty"`
+		EndDate   string `json:"end_date,omitempty"`
+		StartTime string `json:"start_time,omitempty"`
+		EndTime   string `json:"end_time,omitempty"`
+		DateAdded string `json:"date_added,omitempty"`
 
-	urlStr := DefaultBaseURL + "/v2.1/reviews"
+		IsActive     uint8 `json:"is_active"`
+		IsValid      uint8 `json:"is_valid"`
+		ShowShareURL uint8 `json:"show_share_url"`
+		IsEndTimeSet uint8 `json:"is_end_time_set"`
 
-	values, err := query.Values(r)
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding query params failed")
-	}
-	if params := values.Encode(); params != "" {
-		urlStr += "?" + params
+		// API sends empty lists as [], keep them apart from missing ones
+		Restaurants *[]Restaurant `json:"restaurants,omitempty"`
+	}{
+		Alias:        Alias(e),
+		IsActive:     boolToUint8(e.IsActive),
+		IsValid:      boolToUint8(e.IsValid),
+		ShowShareURL: boolToUint8(e.ShowShareURL),
+		IsEndTimeSet: boolToUint8(e.IsEndTimeSet),
 	}
 
-	return http.NewRequest(http.MethodGet, urlStr, nil)
+	if e.StartDate != nil {
+		t.StartDate = e.StartDate.Format("2006-01-02")
+	}
+	if e.EndDate != nil {
+		t.EndDate = e.EndDate.Format("2006-01-02")
+	}
+	if e.StartTime != nil {
+		t.StartTime = e.StartTime.Format("15:04:05")
+	}
+	if e.EndTime != nil {
+		t.EndTime = e.EndTime.Format("15:04:05")
+	}
+	if e.DateAdded != nil {
+		t.DateAdded = e.DateAdded.Format("2006-01-02 15:04:05")
+	}
+	if e.Restaurants != nil {
+		t.Restaurants = &e.Restaurants
+	}
+	return json.Marshal(t)
 }
 
 // ReviewsResp holds reviews for a restaurant
@@ -504,9 +551,9 @@ type ReviewsResp struct {
 // Review holds review details
 type Review struct {
 	// ID of the review
-	ID *int64 // `json:"id,string,omitempty"`
+	ID *int64 `json:"-"`
 	// Rating on scale of 0 to 5 in increments of 0.5
-	Rating *float64 // `json:"rating,string,omitempty"`
+	Rating *float64 `json:"-"`
 	// Review text
 	ReviewText *string `json:"review_text,omitempty"`
 	// Color hex code used with the rating on Zomato
@@ -516,13 +563,13 @@ type Review struct {
 	// Short description of the rating
 	RatingText *string `json:"rating_text,omitempty"`
 	// Unix timestamp for review_time_friendly
-	Timestamp *time.Time // `json:"timestamp,string,omitempty"`
+	Timestamp *time.Time `json:"-"`
 	// No of likes received for review
-	Likes *int64 // `json:"likes,string,omitempty"`
+	Likes *int64 `json:"-"`
 	// User details of author of review
 	User *User `json:"user"`
 	// No of comments on review
-	CommentsCount *int64 // `json:"comments_count,string,omitempty"`
+	CommentsCount *int64 `json:"-"`
 }
 
 // UnmarshalJSON convert JSON data to struct
@@ -588,15 +635,30 @@ func (r *Review) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Reviews gets restaurant reviews.
-//
-// Get restaurant reviews using the Zomato restaurant ID.
-// Only 5 latest reviews are available under the Basic API plan.
-func (c Client) Reviews(ctx context.Context, req ReviewsReq) (resp ReviewsResp, err error) {
-	if c.Auth == nil {
-		return resp, ErrNoAuth
-	}
-
-	err = c.Do(c.Auth(WithCtx(ctx, req)), &resp)
-	return resp, errors.Wrap(err, "Client.Do failed")
+// MarshalJSON convert struct to JSON data
+func (r Review) MarshalJSON() ([]byte, error) {
+	type Alias Review
+	t := struct {
+		Alias
+		Timestamp     *int64   `json:"timestamp,omitempty"`
+		Rating        *float64 `json:"rating,omitempty"`
+		Likes         *int64   `json:"likes,omitempty"`
+		ID            *string  `json:"id,omitempty"`
+		CommentsCount *int64   `json:"comments_count,omitempty"`
+	}{
+		Alias:         Alias(r),
+		Rating:        r.Rating,
+		Likes:         r.Likes,
+		CommentsCount: r.CommentsCount,
+	}
+
+	if r.Timestamp != nil {
+		ts := r.Timestamp.Unix()
+		t.Timestamp = &ts
+	}
+	if r.ID != nil {
+		id := strconv.FormatInt(*r.ID, 10)
+		t.ID = &id
+	}
+	return json.Marshal(t)
 }
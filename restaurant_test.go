@@ -2,6 +2,9 @@ package zomato_test
 
 import (
 	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-india/zomato"
@@ -54,3 +57,56 @@ func TestReviews(t *testing.T) {
 		t.Fatal("invalid response length")
 	}
 }
+
+func TestRestaurantCuisines(t *testing.T) {
+	tests := []struct {
+		json string
+		want []string
+	}{
+		{`{"cuisines":"North Indian, Mughlai,Kebab"}`, []string{"North Indian", "Mughlai", "Kebab"}},
+		{`{"cuisines":" Chinese , "}`, []string{"Chinese"}},
+		{`{"cuisines":""}`, nil},
+		{`{}`, nil},
+	}
+
+	for _, tt := range tests {
+		var r zomato.Restaurant
+		if err := json.Unmarshal([]byte(tt.json), &r); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(r.Cuisines, tt.want) {
+			t.Errorf("Unmarshal(%s) cuisines = %q, want %q", tt.json, r.Cuisines, tt.want)
+		}
+	}
+
+	data, err := json.Marshal(zomato.Restaurant{Cuisines: []string{"North Indian", "Mughlai"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"cuisines":"North Indian, Mughlai"`) {
+		t.Errorf("Marshal() = %s, want cuisines as the API sends them", data)
+	}
+}
+
+func TestRestaurantFlags(t *testing.T) {
+	var r zomato.Restaurant
+	data := `{"has_online_delivery":1,"is_delivering_now":0}`
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.HasOnlineDelivery == nil || !*r.HasOnlineDelivery || r.IsDeliveringNow == nil || *r.IsDeliveringNow {
+		t.Errorf("Unmarshal(%s) = %v, %v, want true, false", data, r.HasOnlineDelivery, r.IsDeliveringNow)
+	}
+	// Flags missing from partial responses are unknown
+	if r.HasTableBooking != nil || r.SwitchToOrderMenu != nil {
+		t.Errorf("Unmarshal(%s) missing flags = %v, %v, want nil", data, r.HasTableBooking, r.SwitchToOrderMenu)
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"has_online_delivery":1,"is_delivering_now":0`) || strings.Contains(string(out), "has_table_booking") {
+		t.Errorf("Marshal() = %s, want the flags of %s", out, data)
+	}
+}
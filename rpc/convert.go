@@ -0,0 +1,417 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/go-india/zomato"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromSearchReq converts a zomato.SearchReq to its message.
+func FromSearchReq(r zomato.SearchReq) *SearchReq {
+	return &SearchReq{
+		EntityId:      r.EntityID,
+		EntityType:    string(r.EntityType),
+		Query:         r.Query,
+		Start:         r.Start,
+		Count:         r.Count,
+		Latitude:      r.Latitude,
+		Longitude:     r.Longitude,
+		Radius:        r.Radius,
+		Cuisines:      r.Cuisines,
+		Establishment: r.Establishment,
+		Collection:    r.Collection,
+		Category:      r.Category,
+		Sort:          string(r.Sort),
+		Order:         string(r.Order),
+	}
+}
+
+// ToSearchReq converts a SearchReq message to a zomato.SearchReq.
+func ToSearchReq(r *SearchReq) zomato.SearchReq {
+	return zomato.SearchReq{
+		EntityID:      r.GetEntityId(),
+		EntityType:    zomato.EntityType(r.GetEntityType()),
+		Query:         r.GetQuery(),
+		Start:         r.GetStart(),
+		Count:         r.GetCount(),
+		Latitude:      r.GetLatitude(),
+		Longitude:     r.GetLongitude(),
+		Radius:        r.GetRadius(),
+		Cuisines:      r.GetCuisines(),
+		Establishment: r.GetEstablishment(),
+		Collection:    r.GetCollection(),
+		Category:      r.GetCategory(),
+		Sort:          zomato.Sort(r.GetSort()),
+		Order:         zomato.Order(r.GetOrder()),
+	}
+}
+
+// FromSearchResp converts a zomato.SearchResp to its message.
+func FromSearchResp(r zomato.SearchResp) *SearchResp {
+	m := &SearchResp{
+		ResultsFound: r.ResultsFound,
+		ResultsStart: r.ResultsStart,
+		ResultsShown: r.ResultsShown,
+	}
+	for _, res := range r.Restaurants {
+		if res.Restaurant != nil {
+			m.Restaurants = append(m.Restaurants, FromRestaurant(*res.Restaurant))
+		}
+	}
+	return m
+}
+
+// ToSearchResp converts a SearchResp message to a zomato.SearchResp.
+func ToSearchResp(m *SearchResp) zomato.SearchResp {
+	r := zomato.SearchResp{
+		ResultsFound: m.GetResultsFound(),
+		ResultsStart: m.GetResultsStart(),
+		ResultsShown: m.GetResultsShown(),
+	}
+	for _, res := range m.GetRestaurants() {
+		res := ToRestaurant(res)
+		r.Restaurants = append(r.Restaurants, struct {
+			Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+		}{&res})
+	}
+	return r
+}
+
+// FromRestaurant converts a zomato.Restaurant to its message.
+func FromRestaurant(r zomato.Restaurant) *Restaurant {
+	m := &Restaurant{
+		Id:                r.ID,
+		Name:              r.Name,
+		Url:               r.URL,
+		Cuisines:          r.Cuisines,
+		AverageCostForTwo: r.AverageCostForTwo,
+		Currency:          r.Currency,
+		ThumbnailUrl:      r.ThumbnailURL,
+		PhotosUrl:         r.PhotosURL,
+		MenuUrl:           r.MenuURL,
+		FeaturedImageUrl:  r.FeaturedImageURL,
+		EventsUrl:         r.EventsURL,
+		DeeplinkUrl:       r.DeeplinkURL,
+		OrderUrl:          r.OrderURL,
+		OrderDeeplinkUrl:  r.OrderDeeplinkURL,
+		BookUrl:           r.BookURL,
+		HasOnlineDelivery: r.HasOnlineDelivery,
+		IsDeliveringNow:   r.IsDeliveringNow,
+		HasTableBooking:   r.HasTableBooking,
+		SwitchToOrderMenu: r.SwitchToOrderMenu,
+		ReviewsCount:      r.ReviewsCount,
+		PhotoCount:        r.PhotoCount,
+		PhoneNumbers:      r.PhoneNumbers,
+	}
+	if r.PriceRange != nil {
+		p := uint32(*r.PriceRange)
+		m.PriceRange = &p
+	}
+	if r.Location != nil {
+		m.Location = FromRestaurantLocation(*r.Location)
+	}
+	if r.UserRating != nil {
+		m.UserRating = FromUserRating(*r.UserRating)
+	}
+	for _, e := range r.ZomatoEvents {
+		if e.Event != nil {
+			m.Events = append(m.Events, FromEvent(*e.Event))
+		}
+	}
+	for _, rv := range r.Reviews {
+		m.Reviews = append(m.Reviews, FromReview(rv))
+	}
+	return m
+}
+
+// ToRestaurant converts a Restaurant message to a zomato.Restaurant.
+func ToRestaurant(m *Restaurant) zomato.Restaurant {
+	r := zomato.Restaurant{
+		ID:                m.Id,
+		Name:              m.Name,
+		URL:               m.Url,
+		Cuisines:          m.GetCuisines(),
+		AverageCostForTwo: m.AverageCostForTwo,
+		Currency:          m.Currency,
+		ThumbnailURL:      m.ThumbnailUrl,
+		PhotosURL:         m.PhotosUrl,
+		MenuURL:           m.MenuUrl,
+		FeaturedImageURL:  m.FeaturedImageUrl,
+		EventsURL:         m.EventsUrl,
+		DeeplinkURL:       m.DeeplinkUrl,
+		OrderURL:          m.OrderUrl,
+		OrderDeeplinkURL:  m.OrderDeeplinkUrl,
+		BookURL:           m.BookUrl,
+		HasOnlineDelivery: m.HasOnlineDelivery,
+		IsDeliveringNow:   m.IsDeliveringNow,
+		HasTableBooking:   m.HasTableBooking,
+		SwitchToOrderMenu: m.SwitchToOrderMenu,
+		ReviewsCount:      m.ReviewsCount,
+		PhotoCount:        m.PhotoCount,
+		PhoneNumbers:      m.PhoneNumbers,
+	}
+	if m.PriceRange != nil {
+		p := uint8(*m.PriceRange)
+		r.PriceRange = &p
+	}
+	if m.Location != nil {
+		l := ToRestaurantLocation(m.Location)
+		r.Location = &l
+	}
+	if m.UserRating != nil {
+		u := ToUserRating(m.UserRating)
+		r.UserRating = &u
+	}
+	for _, e := range m.GetEvents() {
+		e := ToEvent(e)
+		r.ZomatoEvents = append(r.ZomatoEvents, struct {
+			Event *zomato.Event `json:"event,omitempty"`
+		}{&e})
+	}
+	for _, rv := range m.GetReviews() {
+		r.Reviews = append(r.Reviews, ToReview(rv))
+	}
+	return r
+}
+
+// FromRestaurantLocation converts a zomato.RestaurantLocation to its
+// message.
+func FromRestaurantLocation(l zomato.RestaurantLocation) *RestaurantLocation {
+	return &RestaurantLocation{
+		Address:         l.Address,
+		Locality:        l.Locality,
+		City:            l.City,
+		CityId:          l.CityID,
+		Latitude:        l.Latitude,
+		Longitude:       l.Longitude,
+		Zipcode:         (*string)(l.Zipcode),
+		CountryId:       l.CountryID,
+		LocalityVerbose: l.LocalityVerbose,
+	}
+}
+
+// ToRestaurantLocation converts a RestaurantLocation message to a
+// zomato.RestaurantLocation.
+func ToRestaurantLocation(m *RestaurantLocation) zomato.RestaurantLocation {
+	return zomato.RestaurantLocation{
+		Address:         m.Address,
+		Locality:        m.Locality,
+		City:            m.City,
+		CityID:          m.CityId,
+		Latitude:        m.Latitude,
+		Longitude:       m.Longitude,
+		Zipcode:         (*zomato.PostalCode)(m.Zipcode),
+		CountryID:       m.CountryId,
+		LocalityVerbose: m.LocalityVerbose,
+	}
+}
+
+// FromUserRating converts a zomato.UserRating to its message.
+func FromUserRating(r zomato.UserRating) *UserRating {
+	return &UserRating{
+		AggregateRating: r.AggregateRating,
+		RatingText:      r.RatingText,
+		RatingColor:     r.RatingColor,
+		Votes:           r.Votes,
+	}
+}
+
+// ToUserRating converts a UserRating message to a zomato.UserRating.
+func ToUserRating(m *UserRating) zomato.UserRating {
+	return zomato.UserRating{
+		AggregateRating: m.AggregateRating,
+		RatingText:      m.RatingText,
+		RatingColor:     m.RatingColor,
+		Votes:           m.Votes,
+	}
+}
+
+// FromEvent converts a zomato.Event to its message.
+func FromEvent(e zomato.Event) *Event {
+	return &Event{
+		Id:                e.ID,
+		StartDate:         timestamp(e.StartDate),
+		EndDate:           timestamp(e.EndDate),
+		StartTime:         timestamp(e.StartTime),
+		EndTime:           timestamp(e.EndTime),
+		DateAdded:         timestamp(e.DateAdded),
+		IsActive:          e.IsActive,
+		IsValid:           e.IsValid,
+		ShowShareUrl:      e.ShowShareURL,
+		IsEndTimeSet:      e.IsEndTimeSet,
+		ShareUrl:          e.ShareURL,
+		Title:             e.Title,
+		Description:       e.Description,
+		DisplayTime:       e.DisplayTime,
+		DisplayDate:       e.DisplayDate,
+		Disclaimer:        e.Disclaimer,
+		EventCategory:     e.EventCategory,
+		EventCategoryName: e.EventCategoryName,
+		BookLinkUrl:       e.BookLinkURL,
+		FriendlyStartDate: e.FriendlyStartDate,
+		FriendlyEndDate:   e.FriendlyEndDate,
+		FriendlyTiming:    e.FriendlyTiming,
+	}
+}
+
+// ToEvent converts an Event message to a zomato.Event.
+func ToEvent(m *Event) zomato.Event {
+	return zomato.Event{
+		ID:                m.Id,
+		StartDate:         goTime(m.StartDate),
+		EndDate:           goTime(m.EndDate),
+		StartTime:         goTime(m.StartTime),
+		EndTime:           goTime(m.EndTime),
+		DateAdded:         goTime(m.DateAdded),
+		IsActive:          m.IsActive,
+		IsValid:           m.IsValid,
+		ShowShareURL:      m.ShowShareUrl,
+		IsEndTimeSet:      m.IsEndTimeSet,
+		ShareURL:          m.ShareUrl,
+		Title:             m.Title,
+		Description:       m.Description,
+		DisplayTime:       m.DisplayTime,
+		DisplayDate:       m.DisplayDate,
+		Disclaimer:        m.Disclaimer,
+		EventCategory:     m.EventCategory,
+		EventCategoryName: m.EventCategoryName,
+		BookLinkURL:       m.BookLinkUrl,
+		FriendlyStartDate: m.FriendlyStartDate,
+		FriendlyEndDate:   m.FriendlyEndDate,
+		FriendlyTiming:    m.FriendlyTiming,
+	}
+}
+
+// FromReviewsReq converts a zomato.ReviewsReq to its message.
+func FromReviewsReq(r zomato.ReviewsReq) *ReviewsReq {
+	return &ReviewsReq{RestaurantId: r.RestaurantID, Start: r.Start, Count: r.Count}
+}
+
+// ToReviewsReq converts a ReviewsReq message to a zomato.ReviewsReq.
+func ToReviewsReq(m *ReviewsReq) zomato.ReviewsReq {
+	return zomato.ReviewsReq{RestaurantID: m.GetRestaurantId(), Start: m.GetStart(), Count: m.GetCount()}
+}
+
+// FromReviewsResp converts a zomato.ReviewsResp to its message.
+func FromReviewsResp(r zomato.ReviewsResp) *ReviewsResp {
+	m := &ReviewsResp{
+		ReviewsCount: r.ReviewsCount,
+		ReviewsStart: r.ReviewsStart,
+		ReviewsShown: r.ReviewsShown,
+	}
+	for _, rv := range r.UserReviews {
+		if rv.Review != nil {
+			m.UserReviews = append(m.UserReviews, FromReview(*rv.Review))
+		}
+	}
+	return m
+}
+
+// ToReviewsResp converts a ReviewsResp message to a zomato.ReviewsResp.
+func ToReviewsResp(m *ReviewsResp) zomato.ReviewsResp {
+	r := zomato.ReviewsResp{
+		ReviewsCount: m.ReviewsCount,
+		ReviewsStart: m.ReviewsStart,
+		ReviewsShown: m.ReviewsShown,
+	}
+	for _, rv := range m.GetUserReviews() {
+		rv := ToReview(rv)
+		r.UserReviews = append(r.UserReviews, struct {
+			Review *zomato.Review `json:"review,omitempty"`
+		}{&rv})
+	}
+	return r
+}
+
+// FromReview converts a zomato.Review to its message.
+func FromReview(r zomato.Review) *Review {
+	m := &Review{
+		Id:                 r.ID,
+		Rating:             r.Rating,
+		ReviewText:         r.ReviewText,
+		RatingColor:        r.RatingColor,
+		ReviewTimeFriendly: r.ReviewTimeFriendly,
+		RatingText:         r.RatingText,
+		Timestamp:          timestamp(r.Timestamp),
+		Likes:              r.Likes,
+		CommentsCount:      r.CommentsCount,
+	}
+	if r.User != nil {
+		m.User = FromUser(*r.User)
+	}
+	return m
+}
+
+// ToReview converts a Review message to a zomato.Review.
+func ToReview(m *Review) zomato.Review {
+	r := zomato.Review{
+		ID:                 m.Id,
+		Rating:             m.Rating,
+		ReviewText:         m.ReviewText,
+		RatingColor:        m.RatingColor,
+		ReviewTimeFriendly: m.ReviewTimeFriendly,
+		RatingText:         m.RatingText,
+		Timestamp:          goTime(m.Timestamp),
+		Likes:              m.Likes,
+		CommentsCount:      m.CommentsCount,
+	}
+	if m.User != nil {
+		u := ToUser(m.User)
+		r.User = &u
+	}
+	return r
+}
+
+// FromUser converts a zomato.User to its message.
+func FromUser(u zomato.User) *User {
+	m := &User{
+		Name:               u.Name,
+		ZomatoHandle:       u.ZomatoHandle,
+		FoodieLevel:        u.FoodieLevel,
+		FoodieColor:        u.FoodieColor,
+		ProfileUrl:         u.ProfileURL,
+		ProfileDeeplinkUrl: u.ProfileDeeplinkURL,
+		ProfileImageUrl:    u.ProfileImageURL,
+	}
+	if u.FoodieLevelNumber != nil {
+		n := uint32(*u.FoodieLevelNumber)
+		m.FoodieLevelNumber = &n
+	}
+	return m
+}
+
+// ToUser converts a User message to a zomato.User.
+func ToUser(m *User) zomato.User {
+	u := zomato.User{
+		Name:               m.Name,
+		ZomatoHandle:       m.ZomatoHandle,
+		FoodieLevel:        m.FoodieLevel,
+		FoodieColor:        m.FoodieColor,
+		ProfileURL:         m.ProfileUrl,
+		ProfileDeeplinkURL: m.ProfileDeeplinkUrl,
+		ProfileImageURL:    m.ProfileImageUrl,
+	}
+	if m.FoodieLevelNumber != nil {
+		n := uint8(*m.FoodieLevelNumber)
+		u.FoodieLevelNumber = &n
+	}
+	return u
+}
+
+// timestamp converts 't' to a Timestamp, nil if 't' is nil
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// goTime converts 'ts' to a time, nil if 'ts' is nil
+func goTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
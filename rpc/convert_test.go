@@ -0,0 +1,83 @@
+package rpc_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFromRestaurant(t *testing.T) {
+	var r zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &r)
+
+	m := rpc.FromRestaurant(r)
+	if m.GetId() != 463 || m.GetName() != "Karim's" || m.GetUserRating().GetAggregateRating() != 3.7 {
+		t.Errorf("FromRestaurant() = %v, want Karim's rated 3.7", m)
+	}
+	if got := m.GetLocation().GetCityId(); got != *r.Location.CityID {
+		t.Errorf("FromRestaurant() city id = %d, want %d", got, *r.Location.CityID)
+	}
+	if !reflect.DeepEqual(m.GetCuisines(), r.Cuisines) {
+		t.Errorf("FromRestaurant() cuisines = %q, want %q", m.GetCuisines(), r.Cuisines)
+	}
+	if !proto.Equal(rpc.FromRestaurant(rpc.ToRestaurant(m)), m) {
+		t.Errorf("FromRestaurant(ToRestaurant(m)) != m")
+	}
+}
+
+func TestFromSearchResp(t *testing.T) {
+	var resp zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &resp)
+
+	m := rpc.FromSearchResp(resp)
+	if len(m.GetRestaurants()) != len(resp.Restaurants) || m.GetResultsFound() != resp.ResultsFound {
+		t.Errorf("FromSearchResp() = %d of %d results, want %d of %d",
+			len(m.GetRestaurants()), m.GetResultsFound(), len(resp.Restaurants), resp.ResultsFound)
+	}
+	if !proto.Equal(rpc.FromSearchResp(rpc.ToSearchResp(m)), m) {
+		t.Errorf("FromSearchResp(ToSearchResp(m)) != m")
+	}
+}
+
+func TestFromReviewsResp(t *testing.T) {
+	var resp zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &resp)
+
+	m := rpc.FromReviewsResp(resp)
+	first := m.GetUserReviews()[0]
+	if first.GetId() != 34508218 || first.GetUser().GetName() != "Lustyfood" || first.GetRating() != 3 {
+		t.Errorf("FromReviewsResp() first review = %v, want 34508218 by Lustyfood", first)
+	}
+	if got, want := first.GetTimestamp().AsTime(), *resp.UserReviews[0].Review.Timestamp; !got.Equal(want) {
+		t.Errorf("FromReviewsResp() timestamp = %v, want %v", got, want)
+	}
+	if !proto.Equal(rpc.FromReviewsResp(rpc.ToReviewsResp(m)), m) {
+		t.Errorf("FromReviewsResp(ToReviewsResp(m)) != m")
+	}
+}
+
+func TestToEvent(t *testing.T) {
+	start := time.Date(2018, 4, 9, 0, 0, 0, 0, time.UTC)
+	id, title := int64(7), "Live Music"
+	e := zomato.Event{ID: &id, Title: &title, StartDate: &start}
+
+	got := rpc.ToEvent(rpc.FromEvent(e))
+	if !reflect.DeepEqual(got, e) {
+		t.Errorf("ToEvent(FromEvent(e)) = %+v, want %+v", got, e)
+	}
+}
+
+func TestToSearchReq(t *testing.T) {
+	req := zomato.SearchReq{
+		EntityID: 1, EntityType: zomato.CityEntity, Query: "kachori", Start: 20, Count: 10,
+		Cuisines: []string{"50", "1"}, Sort: zomato.Rating, Order: zomato.Descending,
+	}
+	if got := rpc.ToSearchReq(rpc.FromSearchReq(req)); !reflect.DeepEqual(got, req) {
+		t.Errorf("ToSearchReq(FromSearchReq(req)) = %+v, want %+v", got, req)
+	}
+}
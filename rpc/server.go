@@ -0,0 +1,179 @@
+// Package rpc serves the Zomato API over gRPC.
+//
+// zomato.proto defines the Zomato service and messages mirroring
+// SearchReq, SearchResp, Restaurant, ReviewsReq, ReviewsResp and the types
+// they hold. FromX and ToX convert between a message and type X of package
+// zomato; converted values share pointer fields.
+//
+//	s := grpc.NewServer()
+//	rpc.RegisterZomatoServer(s, rpc.NewServer(client))
+//
+// SearchStream and ReviewsStream stream results page by page, so callers
+// need not paginate.
+package rpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative zomato.proto
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Pagination limits of the API
+const (
+	pageSize   = 20
+	maxResults = 100
+)
+
+// Server implements ZomatoServer over an API
+type Server struct {
+	UnimplementedZomatoServer
+
+	api zomato.API
+}
+
+// NewServer returns a server answering calls with 'api'.
+func NewServer(api zomato.API) *Server {
+	return &Server{api: api}
+}
+
+// GetRestaurant implements ZomatoServer.
+func (s *Server) GetRestaurant(ctx context.Context, req *RestaurantReq) (*Restaurant, error) {
+	r, err := s.api.Restaurant(ctx, req.GetRestaurantId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return FromRestaurant(r), nil
+}
+
+// Search implements ZomatoServer.
+func (s *Server) Search(ctx context.Context, req *SearchReq) (*SearchResp, error) {
+	resp, err := s.api.Search(ctx, ToSearchReq(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return FromSearchResp(resp), nil
+}
+
+// SearchStream implements ZomatoServer. The API serves the first 100
+// results of a search, so at most 100 restaurants are streamed.
+func (s *Server) SearchStream(req *SearchReq, stream Zomato_SearchStreamServer) error {
+	r := ToSearchReq(req)
+	limit := r.Count
+	if limit == 0 || limit > maxResults {
+		limit = maxResults
+	}
+
+	for sent := uint64(0); sent < limit && r.Start < maxResults; {
+		r.Count = pageSize
+		if left := limit - sent; left < r.Count {
+			r.Count = left
+		}
+		if left := maxResults - r.Start; left < r.Count {
+			r.Count = left
+		}
+		resp, err := s.api.Search(stream.Context(), r)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, res := range resp.Restaurants {
+			if res.Restaurant == nil {
+				continue
+			}
+			if err := stream.Send(FromRestaurant(*res.Restaurant)); err != nil {
+				return err
+			}
+		}
+
+		n := uint64(len(resp.Restaurants))
+		sent += n
+		r.Start += n
+		if n == 0 || r.Start >= uint64(resp.ResultsFound) {
+			break
+		}
+	}
+	return nil
+}
+
+// Reviews implements ZomatoServer.
+func (s *Server) Reviews(ctx context.Context, req *ReviewsReq) (*ReviewsResp, error) {
+	resp, err := s.api.Reviews(ctx, ToReviewsReq(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return FromReviewsResp(resp), nil
+}
+
+// ReviewsStream implements ZomatoServer.
+func (s *Server) ReviewsStream(req *ReviewsReq, stream Zomato_ReviewsStreamServer) error {
+	r := ToReviewsReq(req)
+	limit := r.Count
+
+	for sent := uint64(0); limit == 0 || sent < limit; {
+		r.Count = pageSize
+		if left := limit - sent; limit > 0 && left < pageSize {
+			r.Count = left
+		}
+		resp, err := s.api.Reviews(stream.Context(), r)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, rv := range resp.UserReviews {
+			if rv.Review == nil {
+				continue
+			}
+			if err := stream.Send(FromReview(*rv.Review)); err != nil {
+				return err
+			}
+		}
+
+		n := uint64(len(resp.UserReviews))
+		sent += n
+		r.Start += n
+		if n < r.Count || resp.ReviewsCount != nil && r.Start >= uint64(*resp.ReviewsCount) {
+			break
+		}
+	}
+	return nil
+}
+
+// toStatus converts an API error to a gRPC status error.
+func toStatus(err error) error {
+	switch cause := errors.Cause(err).(type) {
+	case *zomato.ErrAPI:
+		return status.Error(httpCode(cause.StatusCode), err.Error())
+	case validator.ValidationErrors:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	switch cause := errors.Cause(err); cause {
+	case context.Canceled, context.DeadlineExceeded:
+		return status.FromContextError(cause).Err()
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// httpCode returns the gRPC code of an HTTP status code
+func httpCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
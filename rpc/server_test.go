@@ -0,0 +1,214 @@
+package rpc_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/rpc"
+	"github.com/go-india/zomato/zomatotest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var ctx = context.Background()
+
+// dial serves 'api' over an in-memory connection, returning a client of it
+func dial(t *testing.T, api zomato.API) rpc.ZomatoClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	rpc.RegisterZomatoServer(s, rpc.NewServer(api))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return rpc.NewZomatoClient(conn)
+}
+
+// paged mocks a search of 'found' restaurants and 'reviews' reviews,
+// serving the pages asked for
+func paged(found, reviews int64) *zomatotest.Mock {
+	return &zomatotest.Mock{
+		SearchFunc: func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error) {
+			resp := zomato.SearchResp{ResultsFound: found, ResultsStart: int64(req.Start)}
+			for id := int64(req.Start); id < found && id < int64(req.Start+req.Count); id++ {
+				id := id
+				resp.Restaurants = append(resp.Restaurants, struct {
+					Restaurant *zomato.Restaurant `json:"restaurant,omitempty"`
+				}{&zomato.Restaurant{ID: &id}})
+			}
+			resp.ResultsShown = int64(len(resp.Restaurants))
+			return resp, nil
+		},
+		ReviewsFunc: func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+			resp := zomato.ReviewsResp{ReviewsCount: &reviews}
+			for id := int64(req.Start); id < reviews && id < int64(req.Start+req.Count); id++ {
+				id := id
+				resp.UserReviews = append(resp.UserReviews, struct {
+					Review *zomato.Review `json:"review,omitempty"`
+				}{&zomato.Review{ID: &id}})
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestGetRestaurant(t *testing.T) {
+	var restaurant zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &restaurant)
+	client := dial(t, &zomatotest.Mock{
+		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+			if restaurantID != 463 {
+				return zomato.Restaurant{}, &zomato.ErrAPI{StatusCode: http.StatusNotFound}
+			}
+			return restaurant, nil
+		},
+	})
+
+	r, err := client.GetRestaurant(ctx, &rpc.RestaurantReq{RestaurantId: 463})
+	if err != nil || r.GetName() != "Karim's" {
+		t.Errorf("GetRestaurant(463) = %v, %v; want Karim's", r, err)
+	}
+	if _, err := client.GetRestaurant(ctx, &rpc.RestaurantReq{RestaurantId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetRestaurant(1) error = %v, want NotFound", err)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	client := dial(t, paged(45, 0))
+	resp, err := client.Search(ctx, &rpc.SearchReq{EntityId: 1, EntityType: "city", Count: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetRestaurants()) != 20 || resp.GetResultsFound() != 45 {
+		t.Errorf("Search() = %d of %d results, want 20 of 45", len(resp.GetRestaurants()), resp.GetResultsFound())
+	}
+}
+
+func TestSearchStream(t *testing.T) {
+	tests := []struct {
+		name         string
+		found        int64
+		start, count uint64
+		want         []int64 // first and last id streamed
+		pages        []uint64
+	}{
+		{"all", 45, 0, 0, []int64{0, 44}, []uint64{20, 20, 20}},
+		{"count", 45, 0, 25, []int64{0, 24}, []uint64{20, 5}},
+		{"start", 45, 30, 0, []int64{30, 44}, []uint64{20}},
+		{"limit", 500, 0, 0, []int64{0, 99}, []uint64{20, 20, 20, 20, 20}},
+		{"limit from start", 500, 90, 50, []int64{90, 99}, []uint64{10}},
+		{"none", 0, 0, 0, nil, []uint64{20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := paged(tt.found, 0)
+			stream, err := dial(t, m).SearchStream(ctx, &rpc.SearchReq{Start: tt.start, Count: tt.count})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int64
+			for {
+				r, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, r.GetId())
+			}
+
+			if got := ends(ids); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("streamed ids %v, want %v", got, tt.want)
+			}
+			var pages []uint64
+			for _, c := range m.CallsTo("Search") {
+				pages = append(pages, c.Req.(zomato.SearchReq).Count)
+			}
+			if !reflect.DeepEqual(pages, tt.pages) {
+				t.Errorf("pages of %v, want %v", pages, tt.pages)
+			}
+		})
+	}
+}
+
+func TestReviewsStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		reviews int64
+		count   uint64
+		want    []int64 // first and last id streamed
+		calls   int
+	}{
+		{"all", 50, 0, []int64{0, 49}, 3},
+		{"count", 50, 7, []int64{0, 6}, 1},
+		{"full pages", 40, 0, []int64{0, 39}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := paged(0, tt.reviews)
+			stream, err := dial(t, m).ReviewsStream(ctx, &rpc.ReviewsReq{RestaurantId: 463, Count: tt.count})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int64
+			for {
+				r, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, r.GetId())
+			}
+
+			if got := ends(ids); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("streamed ids %v, want %v", got, tt.want)
+			}
+			if calls := len(m.CallsTo("Reviews")); calls != tt.calls {
+				t.Errorf("Reviews called %d times, want %d", calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestReviewsStreamError(t *testing.T) {
+	client := dial(t, &zomatotest.Mock{
+		ReviewsFunc: func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+			return zomato.ReviewsResp{}, &zomato.ErrAPI{StatusCode: http.StatusTooManyRequests}
+		},
+	})
+	stream, err := client.ReviewsStream(ctx, &rpc.ReviewsReq{RestaurantId: 463})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Recv() error = %v, want ResourceExhausted", err)
+	}
+}
+
+// ends returns the first and last of 'ids'
+func ends(ids []int64) []int64 {
+	if len(ids) == 0 {
+		return nil
+	}
+	return []int64{ids[0], ids[len(ids)-1]}
+}
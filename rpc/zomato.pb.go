@@ -0,0 +1,1919 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: zomato.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RestaurantReq selects a restaurant
+type RestaurantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RestaurantId int64 `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+}
+
+func (x *RestaurantReq) Reset() {
+	*x = RestaurantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestaurantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestaurantReq) ProtoMessage() {}
+
+func (x *RestaurantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestaurantReq.ProtoReflect.Descriptor instead.
+func (*RestaurantReq) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RestaurantReq) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+// SearchReq holds search parameters
+type SearchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// location id
+	EntityId int64 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// location type
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// search keyword
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// fetch results after offset
+	Start uint64 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	// max number of results to display
+	Count     uint64  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// radius around (latitude, longitude) in meters
+	Radius float64 `protobuf:"fixed64,8,opt,name=radius,proto3" json:"radius,omitempty"`
+	// cuisine ids
+	Cuisines []string `protobuf:"bytes,9,rep,name=cuisines,proto3" json:"cuisines,omitempty"`
+	// establishment id obtained from establishments call
+	Establishment string `protobuf:"bytes,10,opt,name=establishment,proto3" json:"establishment,omitempty"`
+	// collection id obtained from collections call
+	Collection string `protobuf:"bytes,11,opt,name=collection,proto3" json:"collection,omitempty"`
+	// category ids obtained from categories call
+	Category string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	// one of cost, rating or real_distance
+	Sort string `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	// asc or desc
+	Order string `protobuf:"bytes,14,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *SearchReq) Reset() {
+	*x = SearchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReq) ProtoMessage() {}
+
+func (x *SearchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReq.ProtoReflect.Descriptor instead.
+func (*SearchReq) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchReq) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *SearchReq) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SearchReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchReq) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SearchReq) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchReq) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SearchReq) GetCuisines() []string {
+	if x != nil {
+		return x.Cuisines
+	}
+	return nil
+}
+
+func (x *SearchReq) GetEstablishment() string {
+	if x != nil {
+		return x.Establishment
+	}
+	return ""
+}
+
+func (x *SearchReq) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SearchReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchReq) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+// SearchResp holds a page of search results
+type SearchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResultsFound int64         `protobuf:"varint,1,opt,name=results_found,json=resultsFound,proto3" json:"results_found,omitempty"`
+	ResultsStart int64         `protobuf:"varint,2,opt,name=results_start,json=resultsStart,proto3" json:"results_start,omitempty"`
+	ResultsShown int64         `protobuf:"varint,3,opt,name=results_shown,json=resultsShown,proto3" json:"results_shown,omitempty"`
+	Restaurants  []*Restaurant `protobuf:"bytes,4,rep,name=restaurants,proto3" json:"restaurants,omitempty"`
+}
+
+func (x *SearchResp) Reset() {
+	*x = SearchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResp) ProtoMessage() {}
+
+func (x *SearchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResp.ProtoReflect.Descriptor instead.
+func (*SearchResp) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResp) GetResultsFound() int64 {
+	if x != nil {
+		return x.ResultsFound
+	}
+	return 0
+}
+
+func (x *SearchResp) GetResultsStart() int64 {
+	if x != nil {
+		return x.ResultsStart
+	}
+	return 0
+}
+
+func (x *SearchResp) GetResultsShown() int64 {
+	if x != nil {
+		return x.ResultsShown
+	}
+	return 0
+}
+
+func (x *SearchResp) GetRestaurants() []*Restaurant {
+	if x != nil {
+		return x.Restaurants
+	}
+	return nil
+}
+
+// Restaurant holds restaurant details
+type Restaurant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                *int64              `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name              *string             `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Url               *string             `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Location          *RestaurantLocation `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Cuisines          []string            `protobuf:"bytes,5,rep,name=cuisines,proto3" json:"cuisines,omitempty"`
+	AverageCostForTwo *int64              `protobuf:"varint,6,opt,name=average_cost_for_two,json=averageCostForTwo,proto3,oneof" json:"average_cost_for_two,omitempty"`
+	// 1 to 4, as on Zomato
+	PriceRange        *uint32     `protobuf:"varint,7,opt,name=price_range,json=priceRange,proto3,oneof" json:"price_range,omitempty"`
+	Currency          *string     `protobuf:"bytes,8,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	UserRating        *UserRating `protobuf:"bytes,9,opt,name=user_rating,json=userRating,proto3" json:"user_rating,omitempty"`
+	ThumbnailUrl      *string     `protobuf:"bytes,10,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	PhotosUrl         *string     `protobuf:"bytes,11,opt,name=photos_url,json=photosUrl,proto3,oneof" json:"photos_url,omitempty"`
+	MenuUrl           *string     `protobuf:"bytes,12,opt,name=menu_url,json=menuUrl,proto3,oneof" json:"menu_url,omitempty"`
+	FeaturedImageUrl  *string     `protobuf:"bytes,13,opt,name=featured_image_url,json=featuredImageUrl,proto3,oneof" json:"featured_image_url,omitempty"`
+	EventsUrl         *string     `protobuf:"bytes,14,opt,name=events_url,json=eventsUrl,proto3,oneof" json:"events_url,omitempty"`
+	DeeplinkUrl       *string     `protobuf:"bytes,15,opt,name=deeplink_url,json=deeplinkUrl,proto3,oneof" json:"deeplink_url,omitempty"`
+	OrderUrl          *string     `protobuf:"bytes,16,opt,name=order_url,json=orderUrl,proto3,oneof" json:"order_url,omitempty"`
+	OrderDeeplinkUrl  *string     `protobuf:"bytes,17,opt,name=order_deeplink_url,json=orderDeeplinkUrl,proto3,oneof" json:"order_deeplink_url,omitempty"`
+	BookUrl           *string     `protobuf:"bytes,18,opt,name=book_url,json=bookUrl,proto3,oneof" json:"book_url,omitempty"`
+	HasOnlineDelivery *bool       `protobuf:"varint,19,opt,name=has_online_delivery,json=hasOnlineDelivery,proto3,oneof" json:"has_online_delivery,omitempty"`
+	IsDeliveringNow   *bool       `protobuf:"varint,20,opt,name=is_delivering_now,json=isDeliveringNow,proto3,oneof" json:"is_delivering_now,omitempty"`
+	HasTableBooking   *bool       `protobuf:"varint,21,opt,name=has_table_booking,json=hasTableBooking,proto3,oneof" json:"has_table_booking,omitempty"`
+	SwitchToOrderMenu *bool       `protobuf:"varint,22,opt,name=switch_to_order_menu,json=switchToOrderMenu,proto3,oneof" json:"switch_to_order_menu,omitempty"`
+	Events            []*Event    `protobuf:"bytes,23,rep,name=events,proto3" json:"events,omitempty"`
+	// [Partner access] fields
+	ReviewsCount *int64    `protobuf:"varint,24,opt,name=reviews_count,json=reviewsCount,proto3,oneof" json:"reviews_count,omitempty"`
+	PhotoCount   *int64    `protobuf:"varint,25,opt,name=photo_count,json=photoCount,proto3,oneof" json:"photo_count,omitempty"`
+	PhoneNumbers *string   `protobuf:"bytes,26,opt,name=phone_numbers,json=phoneNumbers,proto3,oneof" json:"phone_numbers,omitempty"`
+	Reviews      []*Review `protobuf:"bytes,27,rep,name=reviews,proto3" json:"reviews,omitempty"`
+}
+
+func (x *Restaurant) Reset() {
+	*x = Restaurant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Restaurant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Restaurant) ProtoMessage() {}
+
+func (x *Restaurant) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Restaurant.ProtoReflect.Descriptor instead.
+func (*Restaurant) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Restaurant) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Restaurant) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Restaurant) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *Restaurant) GetLocation() *RestaurantLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Restaurant) GetCuisines() []string {
+	if x != nil {
+		return x.Cuisines
+	}
+	return nil
+}
+
+func (x *Restaurant) GetAverageCostForTwo() int64 {
+	if x != nil && x.AverageCostForTwo != nil {
+		return *x.AverageCostForTwo
+	}
+	return 0
+}
+
+func (x *Restaurant) GetPriceRange() uint32 {
+	if x != nil && x.PriceRange != nil {
+		return *x.PriceRange
+	}
+	return 0
+}
+
+func (x *Restaurant) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *Restaurant) GetUserRating() *UserRating {
+	if x != nil {
+		return x.UserRating
+	}
+	return nil
+}
+
+func (x *Restaurant) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetPhotosUrl() string {
+	if x != nil && x.PhotosUrl != nil {
+		return *x.PhotosUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetMenuUrl() string {
+	if x != nil && x.MenuUrl != nil {
+		return *x.MenuUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetFeaturedImageUrl() string {
+	if x != nil && x.FeaturedImageUrl != nil {
+		return *x.FeaturedImageUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetEventsUrl() string {
+	if x != nil && x.EventsUrl != nil {
+		return *x.EventsUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetDeeplinkUrl() string {
+	if x != nil && x.DeeplinkUrl != nil {
+		return *x.DeeplinkUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetOrderUrl() string {
+	if x != nil && x.OrderUrl != nil {
+		return *x.OrderUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetOrderDeeplinkUrl() string {
+	if x != nil && x.OrderDeeplinkUrl != nil {
+		return *x.OrderDeeplinkUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetBookUrl() string {
+	if x != nil && x.BookUrl != nil {
+		return *x.BookUrl
+	}
+	return ""
+}
+
+func (x *Restaurant) GetHasOnlineDelivery() bool {
+	if x != nil && x.HasOnlineDelivery != nil {
+		return *x.HasOnlineDelivery
+	}
+	return false
+}
+
+func (x *Restaurant) GetIsDeliveringNow() bool {
+	if x != nil && x.IsDeliveringNow != nil {
+		return *x.IsDeliveringNow
+	}
+	return false
+}
+
+func (x *Restaurant) GetHasTableBooking() bool {
+	if x != nil && x.HasTableBooking != nil {
+		return *x.HasTableBooking
+	}
+	return false
+}
+
+func (x *Restaurant) GetSwitchToOrderMenu() bool {
+	if x != nil && x.SwitchToOrderMenu != nil {
+		return *x.SwitchToOrderMenu
+	}
+	return false
+}
+
+func (x *Restaurant) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Restaurant) GetReviewsCount() int64 {
+	if x != nil && x.ReviewsCount != nil {
+		return *x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *Restaurant) GetPhotoCount() int64 {
+	if x != nil && x.PhotoCount != nil {
+		return *x.PhotoCount
+	}
+	return 0
+}
+
+func (x *Restaurant) GetPhoneNumbers() string {
+	if x != nil && x.PhoneNumbers != nil {
+		return *x.PhoneNumbers
+	}
+	return ""
+}
+
+func (x *Restaurant) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+// RestaurantLocation holds the location of a restaurant
+type RestaurantLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         *string  `protobuf:"bytes,1,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Locality        *string  `protobuf:"bytes,2,opt,name=locality,proto3,oneof" json:"locality,omitempty"`
+	City            *string  `protobuf:"bytes,3,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	CityId          *int64   `protobuf:"varint,4,opt,name=city_id,json=cityId,proto3,oneof" json:"city_id,omitempty"`
+	Latitude        *float64 `protobuf:"fixed64,5,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude       *float64 `protobuf:"fixed64,6,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	CountryId       *int64   `protobuf:"varint,8,opt,name=country_id,json=countryId,proto3,oneof" json:"country_id,omitempty"`
+	LocalityVerbose *string  `protobuf:"bytes,9,opt,name=locality_verbose,json=localityVerbose,proto3,oneof" json:"locality_verbose,omitempty"`
+	Zipcode         *string  `protobuf:"bytes,10,opt,name=zipcode,proto3,oneof" json:"zipcode,omitempty"`
+}
+
+func (x *RestaurantLocation) Reset() {
+	*x = RestaurantLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestaurantLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestaurantLocation) ProtoMessage() {}
+
+func (x *RestaurantLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestaurantLocation.ProtoReflect.Descriptor instead.
+func (*RestaurantLocation) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestaurantLocation) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *RestaurantLocation) GetLocality() string {
+	if x != nil && x.Locality != nil {
+		return *x.Locality
+	}
+	return ""
+}
+
+func (x *RestaurantLocation) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *RestaurantLocation) GetCityId() int64 {
+	if x != nil && x.CityId != nil {
+		return *x.CityId
+	}
+	return 0
+}
+
+func (x *RestaurantLocation) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *RestaurantLocation) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *RestaurantLocation) GetCountryId() int64 {
+	if x != nil && x.CountryId != nil {
+		return *x.CountryId
+	}
+	return 0
+}
+
+func (x *RestaurantLocation) GetLocalityVerbose() string {
+	if x != nil && x.LocalityVerbose != nil {
+		return *x.LocalityVerbose
+	}
+	return ""
+}
+
+func (x *RestaurantLocation) GetZipcode() string {
+	if x != nil && x.Zipcode != nil {
+		return *x.Zipcode
+	}
+	return ""
+}
+
+// UserRating holds the rating of a restaurant
+type UserRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AggregateRating *float64 `protobuf:"fixed64,1,opt,name=aggregate_rating,json=aggregateRating,proto3,oneof" json:"aggregate_rating,omitempty"`
+	RatingText      *string  `protobuf:"bytes,2,opt,name=rating_text,json=ratingText,proto3,oneof" json:"rating_text,omitempty"`
+	RatingColor     *string  `protobuf:"bytes,3,opt,name=rating_color,json=ratingColor,proto3,oneof" json:"rating_color,omitempty"`
+	Votes           *int64   `protobuf:"varint,4,opt,name=votes,proto3,oneof" json:"votes,omitempty"`
+}
+
+func (x *UserRating) Reset() {
+	*x = UserRating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRating) ProtoMessage() {}
+
+func (x *UserRating) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRating.ProtoReflect.Descriptor instead.
+func (*UserRating) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserRating) GetAggregateRating() float64 {
+	if x != nil && x.AggregateRating != nil {
+		return *x.AggregateRating
+	}
+	return 0
+}
+
+func (x *UserRating) GetRatingText() string {
+	if x != nil && x.RatingText != nil {
+		return *x.RatingText
+	}
+	return ""
+}
+
+func (x *UserRating) GetRatingColor() string {
+	if x != nil && x.RatingColor != nil {
+		return *x.RatingColor
+	}
+	return ""
+}
+
+func (x *UserRating) GetVotes() int64 {
+	if x != nil && x.Votes != nil {
+		return *x.Votes
+	}
+	return 0
+}
+
+// Event holds an event of a restaurant
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	DateAdded         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_added,json=dateAdded,proto3" json:"date_added,omitempty"`
+	IsActive          *bool                  `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IsValid           *bool                  `protobuf:"varint,8,opt,name=is_valid,json=isValid,proto3,oneof" json:"is_valid,omitempty"`
+	ShowShareUrl      *bool                  `protobuf:"varint,9,opt,name=show_share_url,json=showShareUrl,proto3,oneof" json:"show_share_url,omitempty"`
+	IsEndTimeSet      *bool                  `protobuf:"varint,10,opt,name=is_end_time_set,json=isEndTimeSet,proto3,oneof" json:"is_end_time_set,omitempty"`
+	ShareUrl          *string                `protobuf:"bytes,11,opt,name=share_url,json=shareUrl,proto3,oneof" json:"share_url,omitempty"`
+	Title             *string                `protobuf:"bytes,12,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description       *string                `protobuf:"bytes,13,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DisplayTime       *string                `protobuf:"bytes,14,opt,name=display_time,json=displayTime,proto3,oneof" json:"display_time,omitempty"`
+	DisplayDate       *string                `protobuf:"bytes,15,opt,name=display_date,json=displayDate,proto3,oneof" json:"display_date,omitempty"`
+	Disclaimer        *string                `protobuf:"bytes,16,opt,name=disclaimer,proto3,oneof" json:"disclaimer,omitempty"`
+	EventCategory     *int64                 `protobuf:"varint,17,opt,name=event_category,json=eventCategory,proto3,oneof" json:"event_category,omitempty"`
+	EventCategoryName *string                `protobuf:"bytes,18,opt,name=event_category_name,json=eventCategoryName,proto3,oneof" json:"event_category_name,omitempty"`
+	BookLinkUrl       *string                `protobuf:"bytes,19,opt,name=book_link_url,json=bookLinkUrl,proto3,oneof" json:"book_link_url,omitempty"`
+	FriendlyStartDate *string                `protobuf:"bytes,20,opt,name=friendly_start_date,json=friendlyStartDate,proto3,oneof" json:"friendly_start_date,omitempty"`
+	FriendlyEndDate   *string                `protobuf:"bytes,21,opt,name=friendly_end_date,json=friendlyEndDate,proto3,oneof" json:"friendly_end_date,omitempty"`
+	FriendlyTiming    *string                `protobuf:"bytes,22,opt,name=friendly_timing,json=friendlyTiming,proto3,oneof" json:"friendly_timing,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Event) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Event) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Event) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Event) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Event) GetDateAdded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAdded
+	}
+	return nil
+}
+
+func (x *Event) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *Event) GetIsValid() bool {
+	if x != nil && x.IsValid != nil {
+		return *x.IsValid
+	}
+	return false
+}
+
+func (x *Event) GetShowShareUrl() bool {
+	if x != nil && x.ShowShareUrl != nil {
+		return *x.ShowShareUrl
+	}
+	return false
+}
+
+func (x *Event) GetIsEndTimeSet() bool {
+	if x != nil && x.IsEndTimeSet != nil {
+		return *x.IsEndTimeSet
+	}
+	return false
+}
+
+func (x *Event) GetShareUrl() string {
+	if x != nil && x.ShareUrl != nil {
+		return *x.ShareUrl
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetDisplayTime() string {
+	if x != nil && x.DisplayTime != nil {
+		return *x.DisplayTime
+	}
+	return ""
+}
+
+func (x *Event) GetDisplayDate() string {
+	if x != nil && x.DisplayDate != nil {
+		return *x.DisplayDate
+	}
+	return ""
+}
+
+func (x *Event) GetDisclaimer() string {
+	if x != nil && x.Disclaimer != nil {
+		return *x.Disclaimer
+	}
+	return ""
+}
+
+func (x *Event) GetEventCategory() int64 {
+	if x != nil && x.EventCategory != nil {
+		return *x.EventCategory
+	}
+	return 0
+}
+
+func (x *Event) GetEventCategoryName() string {
+	if x != nil && x.EventCategoryName != nil {
+		return *x.EventCategoryName
+	}
+	return ""
+}
+
+func (x *Event) GetBookLinkUrl() string {
+	if x != nil && x.BookLinkUrl != nil {
+		return *x.BookLinkUrl
+	}
+	return ""
+}
+
+func (x *Event) GetFriendlyStartDate() string {
+	if x != nil && x.FriendlyStartDate != nil {
+		return *x.FriendlyStartDate
+	}
+	return ""
+}
+
+func (x *Event) GetFriendlyEndDate() string {
+	if x != nil && x.FriendlyEndDate != nil {
+		return *x.FriendlyEndDate
+	}
+	return ""
+}
+
+func (x *Event) GetFriendlyTiming() string {
+	if x != nil && x.FriendlyTiming != nil {
+		return *x.FriendlyTiming
+	}
+	return ""
+}
+
+// ReviewsReq selects a page of reviews of a restaurant
+type ReviewsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RestaurantId int64 `protobuf:"varint,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	// fetch results after this offset
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// max number of results to retrieve
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReviewsReq) Reset() {
+	*x = ReviewsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsReq) ProtoMessage() {}
+
+func (x *ReviewsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsReq.ProtoReflect.Descriptor instead.
+func (*ReviewsReq) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReviewsReq) GetRestaurantId() int64 {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return 0
+}
+
+func (x *ReviewsReq) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReviewsReq) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ReviewsResp holds a page of reviews
+type ReviewsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewsCount *int64    `protobuf:"varint,1,opt,name=reviews_count,json=reviewsCount,proto3,oneof" json:"reviews_count,omitempty"`
+	ReviewsStart *int64    `protobuf:"varint,2,opt,name=reviews_start,json=reviewsStart,proto3,oneof" json:"reviews_start,omitempty"`
+	ReviewsShown *int64    `protobuf:"varint,3,opt,name=reviews_shown,json=reviewsShown,proto3,oneof" json:"reviews_shown,omitempty"`
+	UserReviews  []*Review `protobuf:"bytes,4,rep,name=user_reviews,json=userReviews,proto3" json:"user_reviews,omitempty"`
+}
+
+func (x *ReviewsResp) Reset() {
+	*x = ReviewsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsResp) ProtoMessage() {}
+
+func (x *ReviewsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsResp.ProtoReflect.Descriptor instead.
+func (*ReviewsResp) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReviewsResp) GetReviewsCount() int64 {
+	if x != nil && x.ReviewsCount != nil {
+		return *x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *ReviewsResp) GetReviewsStart() int64 {
+	if x != nil && x.ReviewsStart != nil {
+		return *x.ReviewsStart
+	}
+	return 0
+}
+
+func (x *ReviewsResp) GetReviewsShown() int64 {
+	if x != nil && x.ReviewsShown != nil {
+		return *x.ReviewsShown
+	}
+	return 0
+}
+
+func (x *ReviewsResp) GetUserReviews() []*Review {
+	if x != nil {
+		return x.UserReviews
+	}
+	return nil
+}
+
+// Review holds a review of a restaurant
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *int64 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// 0 to 5 in steps of 0.5
+	Rating             *float64               `protobuf:"fixed64,2,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	ReviewText         *string                `protobuf:"bytes,3,opt,name=review_text,json=reviewText,proto3,oneof" json:"review_text,omitempty"`
+	RatingColor        *string                `protobuf:"bytes,4,opt,name=rating_color,json=ratingColor,proto3,oneof" json:"rating_color,omitempty"`
+	ReviewTimeFriendly *string                `protobuf:"bytes,5,opt,name=review_time_friendly,json=reviewTimeFriendly,proto3,oneof" json:"review_time_friendly,omitempty"`
+	RatingText         *string                `protobuf:"bytes,6,opt,name=rating_text,json=ratingText,proto3,oneof" json:"rating_text,omitempty"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Likes              *int64                 `protobuf:"varint,8,opt,name=likes,proto3,oneof" json:"likes,omitempty"`
+	User               *User                  `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	CommentsCount      *int64                 `protobuf:"varint,10,opt,name=comments_count,json=commentsCount,proto3,oneof" json:"comments_count,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Review) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetRating() float64 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetReviewText() string {
+	if x != nil && x.ReviewText != nil {
+		return *x.ReviewText
+	}
+	return ""
+}
+
+func (x *Review) GetRatingColor() string {
+	if x != nil && x.RatingColor != nil {
+		return *x.RatingColor
+	}
+	return ""
+}
+
+func (x *Review) GetReviewTimeFriendly() string {
+	if x != nil && x.ReviewTimeFriendly != nil {
+		return *x.ReviewTimeFriendly
+	}
+	return ""
+}
+
+func (x *Review) GetRatingText() string {
+	if x != nil && x.RatingText != nil {
+		return *x.RatingText
+	}
+	return ""
+}
+
+func (x *Review) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Review) GetLikes() int64 {
+	if x != nil && x.Likes != nil {
+		return *x.Likes
+	}
+	return 0
+}
+
+func (x *Review) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Review) GetCommentsCount() int64 {
+	if x != nil && x.CommentsCount != nil {
+		return *x.CommentsCount
+	}
+	return 0
+}
+
+// User holds the author of a review
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ZomatoHandle       *string `protobuf:"bytes,2,opt,name=zomato_handle,json=zomatoHandle,proto3,oneof" json:"zomato_handle,omitempty"`
+	FoodieLevel        *string `protobuf:"bytes,3,opt,name=foodie_level,json=foodieLevel,proto3,oneof" json:"foodie_level,omitempty"`
+	FoodieLevelNumber  *uint32 `protobuf:"varint,4,opt,name=foodie_level_number,json=foodieLevelNumber,proto3,oneof" json:"foodie_level_number,omitempty"`
+	FoodieColor        *string `protobuf:"bytes,5,opt,name=foodie_color,json=foodieColor,proto3,oneof" json:"foodie_color,omitempty"`
+	ProfileUrl         *string `protobuf:"bytes,6,opt,name=profile_url,json=profileUrl,proto3,oneof" json:"profile_url,omitempty"`
+	ProfileDeeplinkUrl *string `protobuf:"bytes,7,opt,name=profile_deeplink_url,json=profileDeeplinkUrl,proto3,oneof" json:"profile_deeplink_url,omitempty"`
+	ProfileImageUrl    *string `protobuf:"bytes,8,opt,name=profile_image_url,json=profileImageUrl,proto3,oneof" json:"profile_image_url,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zomato_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_zomato_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_zomato_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *User) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *User) GetZomatoHandle() string {
+	if x != nil && x.ZomatoHandle != nil {
+		return *x.ZomatoHandle
+	}
+	return ""
+}
+
+func (x *User) GetFoodieLevel() string {
+	if x != nil && x.FoodieLevel != nil {
+		return *x.FoodieLevel
+	}
+	return ""
+}
+
+func (x *User) GetFoodieLevelNumber() uint32 {
+	if x != nil && x.FoodieLevelNumber != nil {
+		return *x.FoodieLevelNumber
+	}
+	return 0
+}
+
+func (x *User) GetFoodieColor() string {
+	if x != nil && x.FoodieColor != nil {
+		return *x.FoodieColor
+	}
+	return ""
+}
+
+func (x *User) GetProfileUrl() string {
+	if x != nil && x.ProfileUrl != nil {
+		return *x.ProfileUrl
+	}
+	return ""
+}
+
+func (x *User) GetProfileDeeplinkUrl() string {
+	if x != nil && x.ProfileDeeplinkUrl != nil {
+		return *x.ProfileDeeplinkUrl
+	}
+	return ""
+}
+
+func (x *User) GetProfileImageUrl() string {
+	if x != nil && x.ProfileImageUrl != nil {
+		return *x.ProfileImageUrl
+	}
+	return ""
+}
+
+var File_zomato_proto protoreflect.FileDescriptor
+
+var file_zomato_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61,
+	0x75, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x85, 0x03,
+	0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x68,
+	0x6f, 0x77, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xc7, 0x0b, 0x0a, 0x0a, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72,
+	0x61, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x03, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x46, 0x6f,
+	0x72, 0x54, 0x77, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x05, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0c, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x55, 0x72, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x55, 0x72, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x31, 0x0a, 0x12, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52,
+	0x10, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x65, 0x65, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b,
+	0x52, 0x0b, 0x64, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x65, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d,
+	0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x55,
+	0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0e, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x55,
+	0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x0f, 0x52, 0x11, 0x68, 0x61, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x77, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x48, 0x10, 0x52, 0x0f, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x68,
+	0x61, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48, 0x11, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x14,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x6d, 0x65, 0x6e, 0x75, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x12, 0x52, 0x11, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x6e, 0x75, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x13, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x48, 0x14, 0x52, 0x0a, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x15, 0x52, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x1b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x77, 0x6f, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x75,
+	0x72, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x77,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0xc1, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61,
+	0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x56,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x7a, 0x69, 0x70,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x07, 0x7a, 0x69,
+	0x70, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64,
+	0x65, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0xec, 0x09, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0f, 0x69, 0x73,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x69,
+	0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0b, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x11, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x4c,
+	0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0e, 0x52, 0x11, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x6c, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f,
+	0x0a, 0x11, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0f, 0x52, 0x0f, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x6c, 0x79, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2c, 0x0a, 0x0f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x10, 0x52, 0x0e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c,
+	0x79, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0x5d,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf4, 0x01,
+	0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a,
+	0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x73, 0x68, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x73,
+	0x68, 0x6f, 0x77, 0x6e, 0x22, 0x81, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x12, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x7a, 0x6f,
+	0x6d, 0x61, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0c, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x66, 0x6f,
+	0x6f, 0x64, 0x69, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13,
+	0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x11, 0x66, 0x6f, 0x6f,
+	0x64, 0x69, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x66, 0x6f, 0x6f, 0x64, 0x69,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x65, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
+	0x12, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x66, 0x6f, 0x6f, 0x64, 0x69, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x65, 0x70, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x32, 0x99, 0x02, 0x0a, 0x06,
+	0x5a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x12, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x7a,
+	0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x75, 0x72, 0x61, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x07,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x7a, 0x6f,
+	0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x35, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x12, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x7a, 0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x30, 0x01, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x69, 0x6e, 0x64, 0x69, 0x61, 0x2f, 0x7a,
+	0x6f, 0x6d, 0x61, 0x74, 0x6f, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_zomato_proto_rawDescOnce sync.Once
+	file_zomato_proto_rawDescData = file_zomato_proto_rawDesc
+)
+
+func file_zomato_proto_rawDescGZIP() []byte {
+	file_zomato_proto_rawDescOnce.Do(func() {
+		file_zomato_proto_rawDescData = protoimpl.X.CompressGZIP(file_zomato_proto_rawDescData)
+	})
+	return file_zomato_proto_rawDescData
+}
+
+var file_zomato_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_zomato_proto_goTypes = []any{
+	(*RestaurantReq)(nil),         // 0: zomato.RestaurantReq
+	(*SearchReq)(nil),             // 1: zomato.SearchReq
+	(*SearchResp)(nil),            // 2: zomato.SearchResp
+	(*Restaurant)(nil),            // 3: zomato.Restaurant
+	(*RestaurantLocation)(nil),    // 4: zomato.RestaurantLocation
+	(*UserRating)(nil),            // 5: zomato.UserRating
+	(*Event)(nil),                 // 6: zomato.Event
+	(*ReviewsReq)(nil),            // 7: zomato.ReviewsReq
+	(*ReviewsResp)(nil),           // 8: zomato.ReviewsResp
+	(*Review)(nil),                // 9: zomato.Review
+	(*User)(nil),                  // 10: zomato.User
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_zomato_proto_depIdxs = []int32{
+	3,  // 0: zomato.SearchResp.restaurants:type_name -> zomato.Restaurant
+	4,  // 1: zomato.Restaurant.location:type_name -> zomato.RestaurantLocation
+	5,  // 2: zomato.Restaurant.user_rating:type_name -> zomato.UserRating
+	6,  // 3: zomato.Restaurant.events:type_name -> zomato.Event
+	9,  // 4: zomato.Restaurant.reviews:type_name -> zomato.Review
+	11, // 5: zomato.Event.start_date:type_name -> google.protobuf.Timestamp
+	11, // 6: zomato.Event.end_date:type_name -> google.protobuf.Timestamp
+	11, // 7: zomato.Event.start_time:type_name -> google.protobuf.Timestamp
+	11, // 8: zomato.Event.end_time:type_name -> google.protobuf.Timestamp
+	11, // 9: zomato.Event.date_added:type_name -> google.protobuf.Timestamp
+	9,  // 10: zomato.ReviewsResp.user_reviews:type_name -> zomato.Review
+	11, // 11: zomato.Review.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 12: zomato.Review.user:type_name -> zomato.User
+	0,  // 13: zomato.Zomato.GetRestaurant:input_type -> zomato.RestaurantReq
+	1,  // 14: zomato.Zomato.Search:input_type -> zomato.SearchReq
+	1,  // 15: zomato.Zomato.SearchStream:input_type -> zomato.SearchReq
+	7,  // 16: zomato.Zomato.Reviews:input_type -> zomato.ReviewsReq
+	7,  // 17: zomato.Zomato.ReviewsStream:input_type -> zomato.ReviewsReq
+	3,  // 18: zomato.Zomato.GetRestaurant:output_type -> zomato.Restaurant
+	2,  // 19: zomato.Zomato.Search:output_type -> zomato.SearchResp
+	3,  // 20: zomato.Zomato.SearchStream:output_type -> zomato.Restaurant
+	8,  // 21: zomato.Zomato.Reviews:output_type -> zomato.ReviewsResp
+	9,  // 22: zomato.Zomato.ReviewsStream:output_type -> zomato.Review
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_zomato_proto_init() }
+func file_zomato_proto_init() {
+	if File_zomato_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zomato_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RestaurantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Restaurant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RestaurantLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UserRating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Review); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zomato_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_zomato_proto_msgTypes[3].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[4].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[5].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[6].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[8].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[9].OneofWrappers = []any{}
+	file_zomato_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zomato_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_zomato_proto_goTypes,
+		DependencyIndexes: file_zomato_proto_depIdxs,
+		MessageInfos:      file_zomato_proto_msgTypes,
+	}.Build()
+	File_zomato_proto = out.File
+	file_zomato_proto_rawDesc = nil
+	file_zomato_proto_goTypes = nil
+	file_zomato_proto_depIdxs = nil
+}
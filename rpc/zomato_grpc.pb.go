@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: zomato.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Zomato_GetRestaurant_FullMethodName = "/zomato.Zomato/GetRestaurant"
+	Zomato_Search_FullMethodName        = "/zomato.Zomato/Search"
+	Zomato_SearchStream_FullMethodName  = "/zomato.Zomato/SearchStream"
+	Zomato_Reviews_FullMethodName       = "/zomato.Zomato/Reviews"
+	Zomato_ReviewsStream_FullMethodName = "/zomato.Zomato/ReviewsStream"
+)
+
+// ZomatoClient is the client API for Zomato service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Zomato serves restaurant details, search and reviews
+type ZomatoClient interface {
+	// GetRestaurant returns the details of a restaurant
+	GetRestaurant(ctx context.Context, in *RestaurantReq, opts ...grpc.CallOption) (*Restaurant, error)
+	// Search returns a page of restaurants matching a search
+	Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error)
+	// SearchStream streams the restaurants matching a search from 'start',
+	// fetching pages as needed; 'count' limits the restaurants streamed
+	SearchStream(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Restaurant], error)
+	// Reviews returns a page of reviews of a restaurant
+	Reviews(ctx context.Context, in *ReviewsReq, opts ...grpc.CallOption) (*ReviewsResp, error)
+	// ReviewsStream streams the reviews of a restaurant from 'start',
+	// fetching pages as needed; 'count' limits the reviews streamed
+	ReviewsStream(ctx context.Context, in *ReviewsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Review], error)
+}
+
+type zomatoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewZomatoClient(cc grpc.ClientConnInterface) ZomatoClient {
+	return &zomatoClient{cc}
+}
+
+func (c *zomatoClient) GetRestaurant(ctx context.Context, in *RestaurantReq, opts ...grpc.CallOption) (*Restaurant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Restaurant)
+	err := c.cc.Invoke(ctx, Zomato_GetRestaurant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zomatoClient) Search(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (*SearchResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResp)
+	err := c.cc.Invoke(ctx, Zomato_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zomatoClient) SearchStream(ctx context.Context, in *SearchReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Restaurant], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Zomato_ServiceDesc.Streams[0], Zomato_SearchStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchReq, Restaurant]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zomato_SearchStreamClient = grpc.ServerStreamingClient[Restaurant]
+
+func (c *zomatoClient) Reviews(ctx context.Context, in *ReviewsReq, opts ...grpc.CallOption) (*ReviewsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewsResp)
+	err := c.cc.Invoke(ctx, Zomato_Reviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zomatoClient) ReviewsStream(ctx context.Context, in *ReviewsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Review], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Zomato_ServiceDesc.Streams[1], Zomato_ReviewsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReviewsReq, Review]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zomato_ReviewsStreamClient = grpc.ServerStreamingClient[Review]
+
+// ZomatoServer is the server API for Zomato service.
+// All implementations must embed UnimplementedZomatoServer
+// for forward compatibility.
+//
+// Zomato serves restaurant details, search and reviews
+type ZomatoServer interface {
+	// GetRestaurant returns the details of a restaurant
+	GetRestaurant(context.Context, *RestaurantReq) (*Restaurant, error)
+	// Search returns a page of restaurants matching a search
+	Search(context.Context, *SearchReq) (*SearchResp, error)
+	// SearchStream streams the restaurants matching a search from 'start',
+	// fetching pages as needed; 'count' limits the restaurants streamed
+	SearchStream(*SearchReq, grpc.ServerStreamingServer[Restaurant]) error
+	// Reviews returns a page of reviews of a restaurant
+	Reviews(context.Context, *ReviewsReq) (*ReviewsResp, error)
+	// ReviewsStream streams the reviews of a restaurant from 'start',
+	// fetching pages as needed; 'count' limits the reviews streamed
+	ReviewsStream(*ReviewsReq, grpc.ServerStreamingServer[Review]) error
+	mustEmbedUnimplementedZomatoServer()
+}
+
+// UnimplementedZomatoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedZomatoServer struct{}
+
+func (UnimplementedZomatoServer) GetRestaurant(context.Context, *RestaurantReq) (*Restaurant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestaurant not implemented")
+}
+func (UnimplementedZomatoServer) Search(context.Context, *SearchReq) (*SearchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedZomatoServer) SearchStream(*SearchReq, grpc.ServerStreamingServer[Restaurant]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+func (UnimplementedZomatoServer) Reviews(context.Context, *ReviewsReq) (*ReviewsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reviews not implemented")
+}
+func (UnimplementedZomatoServer) ReviewsStream(*ReviewsReq, grpc.ServerStreamingServer[Review]) error {
+	return status.Errorf(codes.Unimplemented, "method ReviewsStream not implemented")
+}
+func (UnimplementedZomatoServer) mustEmbedUnimplementedZomatoServer() {}
+func (UnimplementedZomatoServer) testEmbeddedByValue()                {}
+
+// UnsafeZomatoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ZomatoServer will
+// result in compilation errors.
+type UnsafeZomatoServer interface {
+	mustEmbedUnimplementedZomatoServer()
+}
+
+func RegisterZomatoServer(s grpc.ServiceRegistrar, srv ZomatoServer) {
+	// If the following call pancis, it indicates UnimplementedZomatoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Zomato_ServiceDesc, srv)
+}
+
+func _Zomato_GetRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestaurantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZomatoServer).GetRestaurant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zomato_GetRestaurant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZomatoServer).GetRestaurant(ctx, req.(*RestaurantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zomato_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZomatoServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zomato_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZomatoServer).Search(ctx, req.(*SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zomato_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZomatoServer).SearchStream(m, &grpc.GenericServerStream[SearchReq, Restaurant]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zomato_SearchStreamServer = grpc.ServerStreamingServer[Restaurant]
+
+func _Zomato_Reviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZomatoServer).Reviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zomato_Reviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZomatoServer).Reviews(ctx, req.(*ReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zomato_ReviewsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReviewsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZomatoServer).ReviewsStream(m, &grpc.GenericServerStream[ReviewsReq, Review]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zomato_ReviewsStreamServer = grpc.ServerStreamingServer[Review]
+
+// Zomato_ServiceDesc is the grpc.ServiceDesc for Zomato service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Zomato_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zomato.Zomato",
+	HandlerType: (*ZomatoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRestaurant",
+			Handler:    _Zomato_GetRestaurant_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Zomato_Search_Handler,
+		},
+		{
+			MethodName: "Reviews",
+			Handler:    _Zomato_Reviews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Zomato_SearchStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReviewsStream",
+			Handler:       _Zomato_ReviewsStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "zomato.proto",
+}
@@ -0,0 +1,286 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+var (
+	upsertLocation = upsert("locations", []string{"entity_type", "entity_id"},
+		"title", "latitude", "longitude", "city_id", "city_name", "country_id", "country_name",
+		"data", "fetched_at")
+	upsertRestaurant = upsert("restaurants", []string{"id"},
+		"name", "url", "address", "locality", "city", "city_id", "latitude", "longitude", "zipcode",
+		"country_id", "average_cost_for_two", "price_range", "currency", "rating", "rating_text", "votes",
+		"has_online_delivery", "is_delivering_now", "has_table_booking", "deeplink", "data", "fetched_at")
+	upsertCuisine     = upsert("cuisines", []string{"id"}, "name", "fetched_at")
+	upsertCityCuisine = upsert("city_cuisines", []string{"city_id", "cuisine_id"}, "fetched_at")
+	upsertUser        = upsert("users", []string{"handle"},
+		"name", "foodie_level", "foodie_level_num", "profile_url", "fetched_at")
+	upsertReview = upsert("reviews", []string{"id"},
+		"restaurant_id", "rating", "rating_text", "review_text", "review_time_friendly", "timestamp",
+		"likes", "comments_count", "user_handle", "data", "fetched_at")
+	upsertDailyMenu = upsert("daily_menus", []string{"id"},
+		"restaurant_id", "name", "start_date", "end_date", "data", "fetched_at")
+	upsertEvent = upsert("events", []string{"id"},
+		"title", "description", "start_date", "end_date", "category_name", "is_active", "data", "fetched_at")
+	upsertCollection = upsert("collections", []string{"city_id", "id"},
+		"title", "description", "url", "share_url", "image_url", "res_count", "data", "fetched_at")
+)
+
+// PutLocations upserts locations.
+func (s *Store) PutLocations(ctx context.Context, locations ...zomato.Location) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, l := range locations {
+			if l.EntityType == nil || l.EntityID == nil {
+				return errors.New("location without entity_type and entity_id")
+			}
+			data, err := json.Marshal(l)
+			if err != nil {
+				return errors.Wrap(err, "encode location failed")
+			}
+			_, err = tx.ExecContext(ctx, upsertLocation, l.EntityType, l.EntityID,
+				l.Title, l.Latitude, l.Longitude, l.CityID, l.CityName, l.CountryID, l.CountryName,
+				string(data), now)
+			if err != nil {
+				return errors.Wrapf(err, "put location %s %d failed", *l.EntityType, *l.EntityID)
+			}
+		}
+		return nil
+	})
+}
+
+// PutRestaurants upserts restaurants with their cuisines, events and
+// reviews.
+func (s *Store) PutRestaurants(ctx context.Context, restaurants ...zomato.Restaurant) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, r := range restaurants {
+			if err := putRestaurant(ctx, tx, r, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func putRestaurant(ctx context.Context, tx *sql.Tx, r zomato.Restaurant, now int64) error {
+	if r.ID == nil {
+		return errors.New("restaurant without id")
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "encode restaurant failed")
+	}
+
+	var l zomato.RestaurantLocation
+	if r.Location != nil {
+		l = *r.Location
+	}
+	var zipcode interface{}
+	if l.Zipcode != nil {
+		zipcode = string(*l.Zipcode)
+	}
+	var u zomato.UserRating
+	if r.UserRating != nil {
+		u = *r.UserRating
+	}
+
+	_, err = tx.ExecContext(ctx, upsertRestaurant, r.ID,
+		r.Name, r.URL, l.Address, l.Locality, l.City, l.CityID, l.Latitude, l.Longitude, zipcode,
+		l.CountryID, r.AverageCostForTwo, r.PriceRange, r.Currency, u.AggregateRating, u.RatingText, u.Votes,
+		boolean(r.HasOnlineDelivery), boolean(r.IsDeliveringNow), boolean(r.HasTableBooking), r.DeeplinkURL,
+		string(data), now)
+	if err != nil {
+		return errors.Wrapf(err, "put restaurant %d failed", *r.ID)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM restaurant_cuisines WHERE restaurant_id = ?`, r.ID); err != nil {
+		return errors.Wrapf(err, "put cuisines of restaurant %d failed", *r.ID)
+	}
+	for position, c := range r.Cuisines {
+		_, err := tx.ExecContext(ctx, `INSERT INTO restaurant_cuisines (restaurant_id, position, name) VALUES (?, ?, ?)`,
+			r.ID, position, c)
+		if err != nil {
+			return errors.Wrapf(err, "put cuisines of restaurant %d failed", *r.ID)
+		}
+	}
+
+	for _, e := range r.ZomatoEvents {
+		if e.Event == nil {
+			continue
+		}
+		if err := putEvent(ctx, tx, *r.ID, *e.Event, now); err != nil {
+			return err
+		}
+	}
+	for _, review := range r.Reviews {
+		if err := putReview(ctx, tx, *r.ID, review, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PutReviews upserts reviews of restaurant 'restaurantID' with their users.
+func (s *Store) PutReviews(ctx context.Context, restaurantID int64, reviews ...zomato.Review) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, r := range reviews {
+			if err := putReview(ctx, tx, restaurantID, r, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func putReview(ctx context.Context, tx *sql.Tx, restaurantID int64, r zomato.Review, now int64) error {
+	if r.ID == nil {
+		return errors.New("review without id")
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "encode review failed")
+	}
+
+	var handle *string
+	if u := r.User; u != nil && u.ZomatoHandle != nil {
+		handle = u.ZomatoHandle
+		_, err := tx.ExecContext(ctx, upsertUser, u.ZomatoHandle,
+			u.Name, u.FoodieLevel, u.FoodieLevelNumber, u.ProfileURL, now)
+		if err != nil {
+			return errors.Wrapf(err, "put user %s failed", *u.ZomatoHandle)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, upsertReview, r.ID,
+		restaurantID, r.Rating, r.RatingText, r.ReviewText, r.ReviewTimeFriendly, unix(r.Timestamp),
+		r.Likes, r.CommentsCount, handle, string(data), now)
+	return errors.Wrapf(err, "put review %d failed", *r.ID)
+}
+
+// PutDailyMenus upserts daily menus of restaurant 'restaurantID' with their
+// dishes.
+func (s *Store) PutDailyMenus(ctx context.Context, restaurantID int64, menus ...zomato.DailyMenu) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, m := range menus {
+			if m.ID == nil {
+				return errors.New("daily menu without id")
+			}
+			data, err := json.Marshal(m)
+			if err != nil {
+				return errors.Wrap(err, "encode daily menu failed")
+			}
+			_, err = tx.ExecContext(ctx, upsertDailyMenu, m.ID,
+				restaurantID, m.Name, unix(m.StartDate), unix(m.EndDate), string(data), now)
+			if err != nil {
+				return errors.Wrapf(err, "put daily menu %d failed", *m.ID)
+			}
+
+			if _, err := tx.ExecContext(ctx, `DELETE FROM dishes WHERE daily_menu_id = ?`, m.ID); err != nil {
+				return errors.Wrapf(err, "put dishes of daily menu %d failed", *m.ID)
+			}
+			for i, d := range m.Dishes {
+				if d.Dish == nil {
+					continue
+				}
+				_, err := tx.ExecContext(ctx, `INSERT INTO dishes (daily_menu_id, position, id, name, price) VALUES (?, ?, ?, ?, ?)`,
+					m.ID, i, d.Dish.ID, d.Dish.Name, d.Dish.Price)
+				if err != nil {
+					return errors.Wrapf(err, "put dishes of daily menu %d failed", *m.ID)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// PutEvents upserts events of restaurant 'restaurantID'. Restaurants listed
+// in an event are linked to it as well.
+func (s *Store) PutEvents(ctx context.Context, restaurantID int64, events ...zomato.Event) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, e := range events {
+			if err := putEvent(ctx, tx, restaurantID, e, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func putEvent(ctx context.Context, tx *sql.Tx, restaurantID int64, e zomato.Event, now int64) error {
+	if e.ID == nil {
+		return errors.New("event without id")
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "encode event failed")
+	}
+	_, err = tx.ExecContext(ctx, upsertEvent, e.ID,
+		e.Title, e.Description, unix(e.StartDate), unix(e.EndDate), e.EventCategoryName, boolean(e.IsActive),
+		string(data), now)
+	if err != nil {
+		return errors.Wrapf(err, "put event %d failed", *e.ID)
+	}
+
+	ids := []int64{restaurantID}
+	for _, r := range e.Restaurants {
+		if r.ID != nil {
+			ids = append(ids, *r.ID)
+		}
+	}
+	for _, id := range ids {
+		_, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO event_restaurants (event_id, restaurant_id) VALUES (?, ?)`,
+			e.ID, id)
+		if err != nil {
+			return errors.Wrapf(err, "link event %d failed", *e.ID)
+		}
+	}
+	return nil
+}
+
+// PutCollections upserts collections of city 'cityID'.
+func (s *Store) PutCollections(ctx context.Context, cityID int64, collections ...zomato.Collection) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, c := range collections {
+			if c.ID == nil {
+				return errors.New("collection without id")
+			}
+			data, err := json.Marshal(c)
+			if err != nil {
+				return errors.Wrap(err, "encode collection failed")
+			}
+			_, err = tx.ExecContext(ctx, upsertCollection, cityID, c.ID,
+				c.Title, c.Description, c.URL, c.ShareURL, c.ImageURL, c.RestaurantsCount, string(data), now)
+			if err != nil {
+				return errors.Wrapf(err, "put collection %d failed", *c.ID)
+			}
+		}
+		return nil
+	})
+}
+
+// PutCuisines upserts cuisines served in city 'cityID'.
+func (s *Store) PutCuisines(ctx context.Context, cityID int64, cuisines ...zomato.Cuisine) error {
+	now := s.Now().Unix()
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		for _, c := range cuisines {
+			if _, err := tx.ExecContext(ctx, upsertCuisine, c.ID, c.Name, now); err != nil {
+				return errors.Wrapf(err, "put cuisine %d failed", c.ID)
+			}
+			if _, err := tx.ExecContext(ctx, upsertCityCuisine, cityID, c.ID, now); err != nil {
+				return errors.Wrapf(err, "put cuisine %d of city %d failed", c.ID, cityID)
+			}
+		}
+		return nil
+	})
+}
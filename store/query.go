@@ -0,0 +1,281 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// RestaurantRecord is a stored restaurant
+type RestaurantRecord struct {
+	Restaurant zomato.Restaurant
+	FetchedAt  time.Time
+}
+
+// ReviewRecord is a stored review of a restaurant
+type ReviewRecord struct {
+	RestaurantID int64
+	Review       zomato.Review
+	FetchedAt    time.Time
+}
+
+// DailyMenuRecord is a stored daily menu of a restaurant
+type DailyMenuRecord struct {
+	RestaurantID int64
+	DailyMenu    zomato.DailyMenu
+	FetchedAt    time.Time
+}
+
+// EventRecord is a stored event
+type EventRecord struct {
+	Event     zomato.Event
+	FetchedAt time.Time
+}
+
+// CollectionRecord is a stored collection of a city
+type CollectionRecord struct {
+	CityID     int64
+	Collection zomato.Collection
+	FetchedAt  time.Time
+}
+
+// CuisineRecord is a stored cuisine
+type CuisineRecord struct {
+	Cuisine   zomato.Cuisine
+	FetchedAt time.Time
+}
+
+// LocationRecord is a stored location
+type LocationRecord struct {
+	Location  zomato.Location
+	FetchedAt time.Time
+}
+
+// RestaurantQuery filters stored restaurants; zero fields match all
+type RestaurantQuery struct {
+	// CityID of the restaurant location
+	CityID int64
+	// Cuisine served, matched case insensitively
+	Cuisine string
+	// EventID of an event at the restaurant
+	EventID int64
+	// Limit is the maximum number of restaurants returned
+	Limit int
+}
+
+// Restaurant returns restaurant 'id'.
+func (s *Store) Restaurant(ctx context.Context, id int64) (RestaurantRecord, error) {
+	rs, err := s.restaurants(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return RestaurantRecord{}, err
+	}
+	if len(rs) == 0 {
+		return RestaurantRecord{}, errors.Wrapf(ErrNotFound, "restaurant %d", id)
+	}
+	return rs[0], nil
+}
+
+// Restaurants returns restaurants matching query 'q' ordered by ID.
+func (s *Store) Restaurants(ctx context.Context, q RestaurantQuery) ([]RestaurantRecord, error) {
+	var where []string
+	var args []interface{}
+	if q.CityID != 0 {
+		where = append(where, `city_id = ?`)
+		args = append(args, q.CityID)
+	}
+	if q.Cuisine != "" {
+		where = append(where, `id IN (SELECT restaurant_id FROM restaurant_cuisines WHERE name = ?)`)
+		args = append(args, strings.TrimSpace(q.Cuisine))
+	}
+	if q.EventID != 0 {
+		where = append(where, `id IN (SELECT restaurant_id FROM event_restaurants WHERE event_id = ?)`)
+		args = append(args, q.EventID)
+	}
+
+	query := ""
+	if len(where) > 0 {
+		query = "WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id"
+	if q.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, q.Limit)
+	}
+	return s.restaurants(ctx, query, args...)
+}
+
+// RestaurantsByCity returns restaurants in city 'cityID'.
+func (s *Store) RestaurantsByCity(ctx context.Context, cityID int64) ([]RestaurantRecord, error) {
+	return s.Restaurants(ctx, RestaurantQuery{CityID: cityID})
+}
+
+// RestaurantsByCuisine returns restaurants serving cuisine 'cuisine'.
+func (s *Store) RestaurantsByCuisine(ctx context.Context, cuisine string) ([]RestaurantRecord, error) {
+	return s.Restaurants(ctx, RestaurantQuery{Cuisine: cuisine})
+}
+
+func (s *Store) restaurants(ctx context.Context, where string, args ...interface{}) ([]RestaurantRecord, error) {
+	var rs []RestaurantRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM restaurants `+where, args, func(rows *sql.Rows) error {
+		var r RestaurantRecord
+		if err := scan(rows, &r.Restaurant, &r.FetchedAt); err != nil {
+			return err
+		}
+		rs = append(rs, r)
+		return nil
+	})
+	return rs, errors.Wrap(err, "query restaurants failed")
+}
+
+// Reviews returns reviews of restaurant 'restaurantID', newest first.
+func (s *Store) Reviews(ctx context.Context, restaurantID int64) ([]ReviewRecord, error) {
+	var rs []ReviewRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM reviews WHERE restaurant_id = ? ORDER BY timestamp DESC, id DESC`,
+		[]interface{}{restaurantID}, func(rows *sql.Rows) error {
+			r := ReviewRecord{RestaurantID: restaurantID}
+			if err := scan(rows, &r.Review, &r.FetchedAt); err != nil {
+				return err
+			}
+			rs = append(rs, r)
+			return nil
+		})
+	return rs, errors.Wrap(err, "query reviews failed")
+}
+
+// DailyMenus returns daily menus of restaurant 'restaurantID', latest first.
+func (s *Store) DailyMenus(ctx context.Context, restaurantID int64) ([]DailyMenuRecord, error) {
+	var ms []DailyMenuRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM daily_menus WHERE restaurant_id = ? ORDER BY start_date DESC, id DESC`,
+		[]interface{}{restaurantID}, func(rows *sql.Rows) error {
+			m := DailyMenuRecord{RestaurantID: restaurantID}
+			if err := scan(rows, &m.DailyMenu, &m.FetchedAt); err != nil {
+				return err
+			}
+			ms = append(ms, m)
+			return nil
+		})
+	return ms, errors.Wrap(err, "query daily menus failed")
+}
+
+// Events returns events at restaurant 'restaurantID' ordered by start date.
+func (s *Store) Events(ctx context.Context, restaurantID int64) ([]EventRecord, error) {
+	var es []EventRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM events
+		WHERE id IN (SELECT event_id FROM event_restaurants WHERE restaurant_id = ?)
+		ORDER BY start_date, id`, []interface{}{restaurantID}, func(rows *sql.Rows) error {
+		var e EventRecord
+		if err := scan(rows, &e.Event, &e.FetchedAt); err != nil {
+			return err
+		}
+		es = append(es, e)
+		return nil
+	})
+	return es, errors.Wrap(err, "query events failed")
+}
+
+// Collections returns collections of city 'cityID' ordered by ID.
+func (s *Store) Collections(ctx context.Context, cityID int64) ([]CollectionRecord, error) {
+	var cs []CollectionRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM collections WHERE city_id = ? ORDER BY id`,
+		[]interface{}{cityID}, func(rows *sql.Rows) error {
+			c := CollectionRecord{CityID: cityID}
+			if err := scan(rows, &c.Collection, &c.FetchedAt); err != nil {
+				return err
+			}
+			cs = append(cs, c)
+			return nil
+		})
+	return cs, errors.Wrap(err, "query collections failed")
+}
+
+// Cuisines returns cuisines served in city 'cityID', or all cuisines if
+// 'cityID' is 0, ordered by name.
+func (s *Store) Cuisines(ctx context.Context, cityID int64) ([]CuisineRecord, error) {
+	query := `SELECT id, name, fetched_at FROM cuisines ORDER BY name`
+	var args []interface{}
+	if cityID != 0 {
+		query = `SELECT c.id, c.name, cc.fetched_at FROM cuisines c
+		JOIN city_cuisines cc ON cc.cuisine_id = c.id
+		WHERE cc.city_id = ? ORDER BY c.name`
+		args = append(args, cityID)
+	}
+
+	var cs []CuisineRecord
+	err := s.query(ctx, query, args, func(rows *sql.Rows) error {
+		var c CuisineRecord
+		var fetchedAt int64
+		if err := rows.Scan(&c.Cuisine.ID, &c.Cuisine.Name, &fetchedAt); err != nil {
+			return err
+		}
+		c.FetchedAt = time.Unix(fetchedAt, 0)
+		cs = append(cs, c)
+		return nil
+	})
+	return cs, errors.Wrap(err, "query cuisines failed")
+}
+
+// Location returns the location identified by 'entityType' and 'entityID'.
+func (s *Store) Location(ctx context.Context, entityType zomato.EntityType, entityID int64) (LocationRecord, error) {
+	ls, err := s.locations(ctx, `WHERE entity_type = ? AND entity_id = ?`, string(entityType), entityID)
+	if err != nil {
+		return LocationRecord{}, err
+	}
+	if len(ls) == 0 {
+		return LocationRecord{}, errors.Wrapf(ErrNotFound, "location %s %d", entityType, entityID)
+	}
+	return ls[0], nil
+}
+
+// Locations returns locations in city 'cityID', or all locations if
+// 'cityID' is 0, ordered by title.
+func (s *Store) Locations(ctx context.Context, cityID int64) ([]LocationRecord, error) {
+	if cityID == 0 {
+		return s.locations(ctx, `ORDER BY title, entity_type, entity_id`)
+	}
+	return s.locations(ctx, `WHERE city_id = ? ORDER BY title, entity_type, entity_id`, cityID)
+}
+
+func (s *Store) locations(ctx context.Context, where string, args ...interface{}) ([]LocationRecord, error) {
+	var ls []LocationRecord
+	err := s.query(ctx, `SELECT data, fetched_at FROM locations `+where, args, func(rows *sql.Rows) error {
+		var l LocationRecord
+		if err := scan(rows, &l.Location, &l.FetchedAt); err != nil {
+			return err
+		}
+		ls = append(ls, l)
+		return nil
+	})
+	return ls, errors.Wrap(err, "query locations failed")
+}
+
+// query runs 'query' calling 'f' for each row.
+func (s *Store) query(ctx context.Context, query string, args []interface{}, f func(rows *sql.Rows) error) error {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := f(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// scan decodes the data and fetched_at columns of 'rows' into 'v' and 'fetchedAt'.
+func scan(rows *sql.Rows, v interface{}, fetchedAt *time.Time) error {
+	var data string
+	var unix int64
+	if err := rows.Scan(&data, &unix); err != nil {
+		return err
+	}
+	*fetchedAt = time.Unix(unix, 0)
+	return errors.Wrap(json.Unmarshal([]byte(data), v), "decode record failed")
+}
@@ -0,0 +1,316 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/store"
+)
+
+func TestRestaurants(t *testing.T) {
+	fetched := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	s, _ := open(t, fetched)
+
+	var search zomato.SearchResp
+	testfixture.Decode(t, "Search.json", &search)
+	var restaurants []zomato.Restaurant
+	for _, r := range search.Restaurants {
+		restaurants = append(restaurants, *r.Restaurant)
+	}
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+	for _, r := range geocode.NearbyRestaurants {
+		restaurants = append(restaurants, *r.Restaurant)
+	}
+
+	if err := s.PutRestaurants(ctx, restaurants...); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := s.Restaurant(ctx, *restaurants[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(t, r.Restaurant, restaurants[0]) || !r.FetchedAt.Equal(fetched) {
+		t.Errorf("Restaurant = %+v fetched %v", r.Restaurant, r.FetchedAt)
+	}
+
+	all, err := s.Restaurants(ctx, store.RestaurantQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(restaurants) {
+		t.Errorf("got %d restaurants, want %d", len(all), len(restaurants))
+	}
+	for i := 1; i < len(all); i++ {
+		if *all[i-1].Restaurant.ID >= *all[i].Restaurant.ID {
+			t.Errorf("restaurants not ordered by ID")
+		}
+	}
+
+	// Query helpers
+	tests := []struct {
+		name  string
+		query store.RestaurantQuery
+		want  func(r zomato.Restaurant) bool
+	}{
+		{"city", store.RestaurantQuery{CityID: 1}, func(r zomato.Restaurant) bool {
+			return *r.Location.CityID == 1
+		}},
+		{"cuisine", store.RestaurantQuery{Cuisine: "north indian"}, func(r zomato.Restaurant) bool {
+			return hasCuisine(r, "North Indian")
+		}},
+		{"city and cuisine", store.RestaurantQuery{CityID: 1, Cuisine: "Street Food"}, func(r zomato.Restaurant) bool {
+			return *r.Location.CityID == 1 && hasCuisine(r, "Street Food")
+		}},
+		{"event", store.RestaurantQuery{EventID: 159665}, func(r zomato.Restaurant) bool {
+			return *r.ID == 309629
+		}},
+	}
+	for _, tt := range tests {
+		got, err := s.Restaurants(ctx, tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := 0
+		for _, r := range restaurants {
+			if tt.want(r) {
+				want++
+			}
+		}
+		if want == 0 || len(got) != want {
+			t.Errorf("%s: got %d restaurants, want %d", tt.name, len(got), want)
+		}
+		for _, r := range got {
+			if !tt.want(r.Restaurant) {
+				t.Errorf("%s: got %s", tt.name, *r.Restaurant.Name)
+			}
+		}
+	}
+
+	limited, err := s.Restaurants(ctx, store.RestaurantQuery{CityID: 1, Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited) != 3 {
+		t.Errorf("got %d restaurants, want limit 3", len(limited))
+	}
+	byCity, err := s.RestaurantsByCity(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byCuisine, err := s.RestaurantsByCuisine(ctx, "Mughlai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byCity) == 0 || len(byCuisine) == 0 {
+		t.Errorf("got %d restaurants by city, %d by cuisine", len(byCity), len(byCuisine))
+	}
+
+	events, err := s.Events(ctx, 309629)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || *events[0].Event.ID != 159665 {
+		t.Errorf("events = %+v", events)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	first := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	s, _ := open(t, first)
+
+	var r zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &r)
+	if err := s.PutRestaurants(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	second := first.Add(time.Hour)
+	s.Now = func() time.Time { return second }
+	name := "Karim's Jama Masjid"
+	r.Name = &name
+	r.Cuisines = []string{"Mughlai"}
+	if err := s.PutRestaurants(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := s.Restaurants(ctx, store.RestaurantQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || *all[0].Restaurant.Name != name || !all[0].FetchedAt.Equal(second) {
+		t.Fatalf("restaurants = %+v", all)
+	}
+	if got, _ := s.RestaurantsByCuisine(ctx, "Kebab"); len(got) != 0 {
+		t.Errorf("stale cuisine Kebab kept")
+	}
+	if got, _ := s.RestaurantsByCuisine(ctx, "Mughlai"); len(got) != 1 {
+		t.Errorf("cuisine Mughlai not found")
+	}
+}
+
+func TestReviews(t *testing.T) {
+	fetched := time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+	s, _ := open(t, fetched)
+
+	var resp zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &resp)
+	var reviews []zomato.Review
+	for _, r := range resp.UserReviews {
+		reviews = append(reviews, *r.Review)
+	}
+	if err := s.PutReviews(ctx, 463, reviews...); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutReviews(ctx, 463, reviews[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.Reviews(ctx, 463)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(reviews) {
+		t.Fatalf("got %d reviews, want %d", len(got), len(reviews))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1].Review.Timestamp.Before(*got[i].Review.Timestamp) {
+			t.Errorf("reviews not ordered newest first")
+		}
+	}
+	for _, r := range got {
+		if r.RestaurantID != 463 || !r.FetchedAt.Equal(fetched) {
+			t.Errorf("review %d of %d fetched %v", *r.Review.ID, r.RestaurantID, r.FetchedAt)
+		}
+	}
+	if !equal(t, got[len(got)-1].Review, reviews[len(reviews)-1]) {
+		t.Errorf("review = %+v, want %+v", got[len(got)-1].Review, reviews[len(reviews)-1])
+	}
+
+	if got, _ := s.Reviews(ctx, 1); len(got) != 0 {
+		t.Errorf("got %d reviews of another restaurant", len(got))
+	}
+}
+
+func TestDailyMenus(t *testing.T) {
+	s, _ := open(t, time.Now())
+
+	var resp zomato.DailyMenuResp
+	testfixture.Decode(t, "DailyMenu.json", &resp)
+	var menus []zomato.DailyMenu
+	for _, m := range resp.DailyMenus {
+		menus = append(menus, *m.DailyMenu)
+	}
+	if err := s.PutDailyMenus(ctx, 16514301, menus...); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutDailyMenus(ctx, 16514301, menus...); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.DailyMenus(ctx, 16514301)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(menus) || !equal(t, got[0].DailyMenu, menus[0]) {
+		t.Errorf("daily menus = %+v", got)
+	}
+
+	var dishes int
+	if err := s.DB().QueryRow(`SELECT COUNT(*) FROM dishes`).Scan(&dishes); err != nil {
+		t.Fatal(err)
+	}
+	if dishes != len(menus[0].Dishes) {
+		t.Errorf("got %d dishes, want %d", dishes, len(menus[0].Dishes))
+	}
+}
+
+func TestCollectionsCuisinesLocations(t *testing.T) {
+	s, _ := open(t, time.Now())
+
+	var collections zomato.CollectionsResp
+	testfixture.Decode(t, "Collections.json", &collections)
+	var cs []zomato.Collection
+	for _, c := range collections.Collections {
+		cs = append(cs, *c.Collection)
+	}
+	if err := s.PutCollections(ctx, 1, cs...); err != nil {
+		t.Fatal(err)
+	}
+	// Collection IDs repeat across cities
+	if err := s.PutCollections(ctx, 2, cs[0]); err != nil {
+		t.Fatal(err)
+	}
+	gotCollections, err := s.Collections(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotCollections) != len(cs) {
+		t.Errorf("got %d collections, want %d", len(gotCollections), len(cs))
+	}
+	if other, _ := s.Collections(ctx, 2); len(other) != 1 {
+		t.Errorf("got %d collections of city 2, want 1", len(other))
+	}
+
+	var cuisines zomato.CuisinesResp
+	testfixture.Decode(t, "Cuisines.json", &cuisines)
+	var cus []zomato.Cuisine
+	for _, c := range cuisines.Cuisines {
+		cus = append(cus, *c.Cuisine)
+	}
+	if err := s.PutCuisines(ctx, 1, cus...); err != nil {
+		t.Fatal(err)
+	}
+	gotCuisines, err := s.Cuisines(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotCuisines) != len(cus) {
+		t.Errorf("got %d cuisines, want %d", len(gotCuisines), len(cus))
+	}
+	if err := s.PutCuisines(ctx, 2, zomato.Cuisine{ID: 10001, Name: "Zanzibari"}); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := s.Cuisines(ctx, 0); len(all) != len(cus)+1 {
+		t.Errorf("got %d cuisines in all cities, want %d", len(all), len(cus)+1)
+	}
+
+	var locations zomato.LocationsResp
+	testfixture.Decode(t, "Locations.json", &locations)
+	var geocode zomato.GeoCodeResp
+	testfixture.Decode(t, "GeoCode.json", &geocode)
+	ls := append(locations.LocationSuggestions, *geocode.Location)
+	if err := s.PutLocations(ctx, ls...); err != nil {
+		t.Fatal(err)
+	}
+	l, err := s.Location(ctx, zomato.SubZone, 289)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(t, l.Location, *geocode.Location) {
+		t.Errorf("location = %+v", l.Location)
+	}
+	inCity, err := s.Locations(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := s.Locations(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inCity) != 2 || len(all) != 2 {
+		t.Errorf("got %d locations in city, %d in all, want 2", len(inCity), len(all))
+	}
+}
+
+func hasCuisine(r zomato.Restaurant, cuisine string) bool {
+	for _, c := range r.Cuisines {
+		if c == cuisine || c == " "+cuisine {
+			return true
+		}
+	}
+	return false
+}
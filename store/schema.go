@@ -0,0 +1,156 @@
+package store
+
+// migration is a schema change applied in a transaction
+type migration struct {
+	version    int
+	statements []string
+}
+
+// migrations are applied in order; append new migrations, never edit
+// applied ones.
+var migrations = []migration{
+	{1, []string{
+		`CREATE TABLE locations (
+			entity_type  TEXT NOT NULL,
+			entity_id    INTEGER NOT NULL,
+			title        TEXT,
+			latitude     REAL,
+			longitude    REAL,
+			city_id      INTEGER,
+			city_name    TEXT,
+			country_id   INTEGER,
+			country_name TEXT,
+			data         TEXT NOT NULL,
+			fetched_at   INTEGER NOT NULL,
+			PRIMARY KEY (entity_type, entity_id)
+		)`,
+		`CREATE INDEX locations_city_id ON locations (city_id)`,
+
+		`CREATE TABLE restaurants (
+			id                   INTEGER PRIMARY KEY,
+			name                 TEXT,
+			url                  TEXT,
+			address              TEXT,
+			locality             TEXT,
+			city                 TEXT,
+			city_id              INTEGER,
+			latitude             REAL,
+			longitude            REAL,
+			zipcode              TEXT,
+			country_id           INTEGER,
+			average_cost_for_two INTEGER,
+			price_range          INTEGER,
+			currency             TEXT,
+			rating               REAL,
+			rating_text          TEXT,
+			votes                INTEGER,
+			has_online_delivery  INTEGER,
+			is_delivering_now    INTEGER,
+			has_table_booking    INTEGER,
+			deeplink             TEXT,
+			data                 TEXT NOT NULL,
+			fetched_at           INTEGER NOT NULL
+		)`,
+		`CREATE INDEX restaurants_city_id ON restaurants (city_id)`,
+
+		`CREATE TABLE restaurant_cuisines (
+			restaurant_id INTEGER NOT NULL REFERENCES restaurants (id),
+			position      INTEGER NOT NULL,
+			name          TEXT NOT NULL COLLATE NOCASE,
+			PRIMARY KEY (restaurant_id, position)
+		)`,
+		`CREATE INDEX restaurant_cuisines_name ON restaurant_cuisines (name)`,
+
+		`CREATE TABLE cuisines (
+			id         INTEGER PRIMARY KEY,
+			name       TEXT NOT NULL COLLATE NOCASE,
+			fetched_at INTEGER NOT NULL
+		)`,
+		`CREATE INDEX cuisines_name ON cuisines (name)`,
+
+		`CREATE TABLE city_cuisines (
+			city_id    INTEGER NOT NULL,
+			cuisine_id INTEGER NOT NULL REFERENCES cuisines (id),
+			fetched_at INTEGER NOT NULL,
+			PRIMARY KEY (city_id, cuisine_id)
+		)`,
+
+		`CREATE TABLE users (
+			handle           TEXT PRIMARY KEY,
+			name             TEXT,
+			foodie_level     TEXT,
+			foodie_level_num INTEGER,
+			profile_url      TEXT,
+			fetched_at       INTEGER NOT NULL
+		)`,
+
+		`CREATE TABLE reviews (
+			id                   INTEGER PRIMARY KEY,
+			restaurant_id        INTEGER NOT NULL,
+			rating               REAL,
+			rating_text          TEXT,
+			review_text          TEXT,
+			review_time_friendly TEXT,
+			timestamp            INTEGER,
+			likes                INTEGER,
+			comments_count       INTEGER,
+			user_handle          TEXT REFERENCES users (handle),
+			data                 TEXT NOT NULL,
+			fetched_at           INTEGER NOT NULL
+		)`,
+		`CREATE INDEX reviews_restaurant_id ON reviews (restaurant_id)`,
+
+		`CREATE TABLE daily_menus (
+			id            INTEGER PRIMARY KEY,
+			restaurant_id INTEGER NOT NULL,
+			name          TEXT,
+			start_date    INTEGER,
+			end_date      INTEGER,
+			data          TEXT NOT NULL,
+			fetched_at    INTEGER NOT NULL
+		)`,
+		`CREATE INDEX daily_menus_restaurant_id ON daily_menus (restaurant_id)`,
+
+		`CREATE TABLE dishes (
+			daily_menu_id INTEGER NOT NULL REFERENCES daily_menus (id),
+			position      INTEGER NOT NULL,
+			id            INTEGER,
+			name          TEXT,
+			price         TEXT,
+			PRIMARY KEY (daily_menu_id, position)
+		)`,
+
+		`CREATE TABLE events (
+			id            INTEGER PRIMARY KEY,
+			title         TEXT,
+			description   TEXT,
+			start_date    INTEGER,
+			end_date      INTEGER,
+			category_name TEXT,
+			is_active     INTEGER,
+			data          TEXT NOT NULL,
+			fetched_at    INTEGER NOT NULL
+		)`,
+
+		`CREATE TABLE event_restaurants (
+			event_id      INTEGER NOT NULL REFERENCES events (id),
+			restaurant_id INTEGER NOT NULL,
+			PRIMARY KEY (event_id, restaurant_id)
+		)`,
+		`CREATE INDEX event_restaurants_restaurant_id ON event_restaurants (restaurant_id)`,
+
+		`CREATE TABLE collections (
+			city_id     INTEGER NOT NULL,
+			id          INTEGER NOT NULL,
+			title       TEXT,
+			description TEXT,
+			url         TEXT,
+			share_url   TEXT,
+			image_url   TEXT,
+			res_count   INTEGER,
+			data        TEXT NOT NULL,
+			fetched_at  INTEGER NOT NULL,
+			PRIMARY KEY (city_id, id)
+		)`,
+	}},
+}
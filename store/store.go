@@ -0,0 +1,167 @@
+// Package store keeps a local SQLite mirror of data fetched from the Zomato
+// API.
+//
+// Records are upserted into a normalized schema and stamped with the time
+// they were fetched. Each row also keeps the JSON of the record, so queries
+// return records exactly as they were stored.
+//
+//	s, err := store.Open("zomato.db")
+//	if err != nil {
+//		return err
+//	}
+//	defer s.Close()
+//
+//	err = s.PutRestaurants(ctx, restaurants...)
+//	delhi, err := s.RestaurantsByCity(ctx, 1)
+//
+// The database is opened with the pure Go driver modernc.org/sqlite, so no
+// cgo is required.
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	// Register the "sqlite" database/sql driver
+	_ "modernc.org/sqlite"
+)
+
+// ErrNotFound is returned when a record is not in the store
+var ErrNotFound = errors.New("not found in store")
+
+// Store holds fetched records in a SQLite database
+type Store struct {
+	// Now returns the fetch time of stored records; defaults to time.Now
+	Now func() time.Time
+
+	db *sql.DB
+}
+
+// Open opens the SQLite database at 'path', creating and migrating it as
+// needed.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open database failed")
+	}
+	// SQLite allows a single writer; share one connection
+	db.SetMaxOpenConns(1)
+
+	s, err := New(context.Background(), db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// New returns a store using the SQLite database 'db', migrating it to the
+// latest schema.
+func New(ctx context.Context, db *sql.DB) (*Store, error) {
+	s := &Store{Now: time.Now, db: db}
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DB returns the database of the store for custom queries.
+func (s *Store) DB() *sql.DB {
+	return s.db
+}
+
+// Version returns the schema version of the database.
+func (s *Store) Version(ctx context.Context) (int, error) {
+	var version int
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	return version, errors.Wrap(err, "read schema version failed")
+}
+
+// migrate applies pending migrations.
+func (s *Store) migrate(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return errors.Wrap(err, "create schema_migrations failed")
+	}
+
+	version, err := s.Version(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		err := s.tx(ctx, func(tx *sql.Tx) error {
+			for _, stmt := range m.statements {
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return err
+				}
+			}
+			_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`,
+				m.version, s.Now().Unix())
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "migration %d failed", m.version)
+		}
+	}
+	return nil
+}
+
+// tx runs 'f' in a transaction, committing if it succeeds.
+func (s *Store) tx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin transaction failed")
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return errors.Wrap(tx.Commit(), "commit failed")
+}
+
+// upsert returns an INSERT statement of 'columns' into 'table' updating
+// existing rows with the same 'key' columns.
+func upsert(table string, key []string, columns ...string) string {
+	all := append(append([]string{}, key...), columns...)
+	set := make([]string, len(columns))
+	for i, c := range columns {
+		set[i] = c + " = excluded." + c
+	}
+	return "INSERT INTO " + table + " (" + strings.Join(all, ", ") + ")" +
+		" VALUES (" + strings.TrimSuffix(strings.Repeat("?, ", len(all)), ", ") + ")" +
+		" ON CONFLICT (" + strings.Join(key, ", ") + ") DO UPDATE SET " + strings.Join(set, ", ")
+}
+
+// unix returns 't' as Unix seconds, or nil.
+func unix(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Unix()
+}
+
+// boolean returns 'b' as 0 or 1, or nil.
+func boolean(b *bool) interface{} {
+	if b == nil {
+		return nil
+	}
+	if *b {
+		return 1
+	}
+	return 0
+}
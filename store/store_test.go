@@ -0,0 +1,117 @@
+package store_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/store"
+	"github.com/pkg/errors"
+)
+
+var ctx = context.Background()
+
+// open returns a new store in a temporary directory fetching at 'now'
+func open(t *testing.T, now time.Time) (*store.Store, string) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "zomato.db")
+	s, err := store.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	s.Now = func() time.Time { return now }
+	return s, path
+}
+
+func TestOpen(t *testing.T) {
+	s, path := open(t, time.Now())
+	version, err := s.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 1 {
+		t.Errorf("version = %d, want 1", version)
+	}
+	if err := s.PutCuisines(ctx, 1, zomato.Cuisine{ID: 1, Name: "American"}); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	// Reopening keeps data and skips applied migrations
+	s, err = store.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if version, err := s.Version(ctx); err != nil || version != 1 {
+		t.Errorf("reopened version = %d, %v; want 1", version, err)
+	}
+	cs, err := s.Cuisines(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 || cs[0].Cuisine.Name != "American" {
+		t.Errorf("cuisines = %+v", cs)
+	}
+}
+
+func TestErrNotFound(t *testing.T) {
+	s, _ := open(t, time.Now())
+	if _, err := s.Restaurant(ctx, 1); errors.Cause(err) != store.ErrNotFound {
+		t.Errorf("Restaurant error = %v, want ErrNotFound", err)
+	}
+	if _, err := s.Location(ctx, zomato.CityEntity, 1); errors.Cause(err) != store.ErrNotFound {
+		t.Errorf("Location error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPutErrors(t *testing.T) {
+	s, _ := open(t, time.Now())
+	id := int64(1)
+	tests := []struct {
+		name string
+		put  func() error
+	}{
+		{"restaurant", func() error { return s.PutRestaurants(ctx, zomato.Restaurant{ID: &id}, zomato.Restaurant{}) }},
+		{"review", func() error { return s.PutReviews(ctx, 1, zomato.Review{}) }},
+		{"daily menu", func() error { return s.PutDailyMenus(ctx, 1, zomato.DailyMenu{}) }},
+		{"event", func() error { return s.PutEvents(ctx, 1, zomato.Event{}) }},
+		{"collection", func() error { return s.PutCollections(ctx, 1, zomato.Collection{}) }},
+		{"location", func() error { return s.PutLocations(ctx, zomato.Location{}) }},
+	}
+	for _, tt := range tests {
+		if err := tt.put(); err == nil {
+			t.Errorf("put %s without id did not fail", tt.name)
+		}
+	}
+
+	// Failed puts are rolled back
+	if _, err := s.Restaurant(ctx, id); errors.Cause(err) != store.ErrNotFound {
+		t.Errorf("restaurant of failed put stored: %v", err)
+	}
+}
+
+// equal reports whether 'got' and 'want' are equal as JSON
+func equal(t *testing.T, got, want interface{}) bool {
+	g, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reflect.DeepEqual(g, w)
+}
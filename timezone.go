@@ -0,0 +1,243 @@
+package zomato
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoTimeZone is returned for cities of unknown time zone
+var ErrNoTimeZone = errors.New("zomato: unknown time zone")
+
+// CityTimeZones maps city IDs to IANA time zone names. It is needed for
+// cities of countries spanning several time zones; add to it before use.
+var CityTimeZones = map[int64]string{
+	// Australia
+	259: "Australia/Melbourne", // Melbourne
+	260: "Australia/Sydney",    // Sydney
+	296: "Australia/Perth",     // Perth
+	297: "Australia/Adelaide",  // Adelaide
+	298: "Australia/Brisbane",  // Brisbane
+	313: "Australia/Sydney",    // Canberra
+
+	// Brazil
+	64: "America/Sao_Paulo", // Brasília
+	67: "America/Sao_Paulo", // São Paulo
+	73: "America/Sao_Paulo", // Rio de Janeiro
+
+	// Canada
+	89:   "America/Toronto",   // Toronto
+	256:  "America/Vancouver", // Vancouver
+	262:  "America/Edmonton",  // Calgary
+	295:  "America/Toronto",   // Ottawa
+	3515: "America/Toronto",   // Delhi, ON
+
+	// Indonesia
+	74:    "Asia/Jakarta",  // Jakarta
+	170:   "Asia/Makassar", // Bali
+	11052: "Asia/Jakarta",  // Bandung
+
+	// United States
+	276:  "America/Chicago",     // Dallas
+	277:  "America/Chicago",     // Houston
+	278:  "America/Chicago",     // Austin
+	279:  "America/Los_Angeles", // Seattle
+	280:  "America/New_York",    // New York City
+	281:  "America/Los_Angeles", // Los Angeles
+	282:  "America/Los_Angeles", // Las Vegas
+	283:  "America/New_York",    // Washington DC
+	286:  "America/Los_Angeles", // Portland
+	287:  "America/New_York",    // Philadelphia
+	288:  "America/New_York",    // Atlanta
+	289:  "America/New_York",    // Boston
+	291:  "America/New_York",    // Miami
+	292:  "America/Chicago",     // Chicago
+	301:  "America/Phoenix",     // Phoenix
+	302:  "America/Los_Angeles", // San Diego
+	305:  "America/Denver",      // Denver
+	306:  "America/Los_Angeles", // San Francisco
+	4968: "America/Chicago",     // Delhi, IA
+	5888: "America/Chicago",     // Delhi, LA
+	7791: "America/New_York",    // Delhi, NY
+	7987: "America/Los_Angeles", // Delhi, CA
+}
+
+// CountryTimeZones maps country IDs to the IANA time zone names of
+// countries whose cities are within one time zone. Chile, New Zealand and
+// Portugal map to the time zone of their mainland, leaving out Easter
+// Island, the Chatham Islands and the Azores, whose cities belong in
+// CityTimeZones.
+var CountryTimeZones = map[int64]string{
+	1:   "Asia/Kolkata",        // India
+	42:  "America/Santiago",    // Chile, mainland
+	54:  "Europe/Prague",       // Czech Republic
+	97:  "Europe/Dublin",       // Ireland
+	99:  "Europe/Rome",         // Italy
+	112: "Asia/Beirut",         // Lebanon
+	148: "Pacific/Auckland",    // New Zealand, mainland
+	162: "Asia/Manila",         // Philippines
+	163: "Europe/Warsaw",       // Poland
+	164: "Europe/Lisbon",       // Portugal, mainland
+	166: "Asia/Qatar",          // Qatar
+	184: "Asia/Singapore",      // Singapore
+	185: "Europe/Bratislava",   // Slovakia
+	189: "Africa/Johannesburg", // South Africa
+	191: "Asia/Colombo",        // Sri Lanka
+	208: "Europe/Istanbul",     // Turkey
+	214: "Asia/Dubai",          // UAE
+	215: "Europe/London",       // United Kingdom
+}
+
+var (
+	locationsMu sync.Mutex
+	locations   = make(map[string]*time.Location)
+)
+
+// TimeZone returns the time zone of city 'cityID' of country 'countryID',
+// or ErrNoTimeZone.
+func TimeZone(cityID, countryID int64) (*time.Location, error) {
+	name, ok := CityTimeZones[cityID]
+	if !ok {
+		name, ok = CountryTimeZones[countryID]
+	}
+	if !ok {
+		return nil, ErrNoTimeZone
+	}
+
+	locationsMu.Lock()
+	defer locationsMu.Unlock()
+	if loc, ok := locations[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load time zone %s failed", name)
+	}
+	locations[name] = loc
+	return loc, nil
+}
+
+// TimeZone returns the time zone of the city of the restaurant, or
+// ErrNoTimeZone.
+func (r Restaurant) TimeZone() (*time.Location, error) {
+	var cityID, countryID int64
+	if r.Location != nil && r.Location.CityID != nil {
+		cityID = *r.Location.CityID
+	}
+	if r.Location != nil && r.Location.CountryID != nil {
+		countryID = *r.Location.CountryID
+	}
+	return TimeZone(cityID, countryID)
+}
+
+// inZone returns the wall clock time of 't' in 'loc'.
+func inZone(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	z := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	return &z
+}
+
+// In sets the time zone of the times of 'e', which the API sends in the
+// local time of the city of the restaurant. Restaurants decoded with a
+// known TimeZone have it set for their events.
+func (e *Event) In(loc *time.Location) {
+	e.StartDate = inZone(e.StartDate, loc)
+	e.EndDate = inZone(e.EndDate, loc)
+	e.StartTime = inZone(e.StartTime, loc)
+	e.EndTime = inZone(e.EndTime, loc)
+	e.DateAdded = inZone(e.DateAdded, loc)
+}
+
+// In sets the time zone of the times of 'd', which the API sends in the
+// local time of the city of the restaurant.
+//
+//	loc, err := restaurant.TimeZone()
+//	if err == nil {
+//		menu.In(loc)
+//	}
+func (d *DailyMenu) In(loc *time.Location) {
+	d.StartDate = inZone(d.StartDate, loc)
+	d.EndDate = inZone(d.EndDate, loc)
+}
+
+// at returns 'date' at the time of day of 'clock'.
+func at(date time.Time, clock *time.Time) time.Time {
+	var h, m, s int
+	if clock != nil {
+		h, m, s = clock.Clock()
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), h, m, s, 0, date.Location())
+}
+
+// Start returns when the event starts, StartDate at StartTime.
+func (e Event) Start() (time.Time, bool) {
+	if e.StartDate == nil {
+		return time.Time{}, false
+	}
+	return at(*e.StartDate, e.StartTime), true
+}
+
+// End returns when the event ends, EndDate at EndTime if set or else at
+// the end of EndDate. Events ending at or before the time of day they start,
+// as at midnight, end the day after EndDate.
+func (e Event) End() (time.Time, bool) {
+	if e.EndDate == nil {
+		return time.Time{}, false
+	}
+	if !e.endTimeSet() {
+		return at(e.EndDate.AddDate(0, 0, 1), nil), true
+	}
+	end := at(*e.EndDate, e.EndTime)
+	if e.overnight() {
+		end = at(e.EndDate.AddDate(0, 0, 1), e.EndTime)
+	}
+	return end, true
+}
+
+// IsActiveAt reports whether the event is on at 't', between its Start and
+// End and, with EndTime set, between its StartTime and EndTime that day.
+func (e Event) IsActiveAt(t time.Time) bool {
+	start, ok := e.Start()
+	if !ok || t.Before(start) {
+		return false
+	}
+	if end, ok := e.End(); ok && !t.Before(end) {
+		return false
+	}
+	if !e.endTimeSet() {
+		return true
+	}
+
+	t = t.In(start.Location())
+	from, to := at(t, e.StartTime), at(t, e.EndTime)
+	if !e.overnight() {
+		return !t.Before(from) && t.Before(to)
+	}
+	return !t.Before(from) || t.Before(to)
+}
+
+func (e Event) endTimeSet() bool {
+	return e.IsEndTimeSet != nil && *e.IsEndTimeSet && e.EndTime != nil
+}
+
+// overnight reports whether the event ends at or before the time of day it
+// starts.
+func (e Event) overnight() bool {
+	h, m, s := e.EndTime.Clock()
+	end := (h*60+m)*60 + s
+	start := 0
+	if e.StartTime != nil {
+		h, m, s = e.StartTime.Clock()
+		start = (h*60+m)*60 + s
+	}
+	return end <= start
+}
+
+// ValidAt reports whether the menu is the one of 't', between its StartDate
+// and EndDate.
+func (d DailyMenu) ValidAt(t time.Time) bool {
+	return d.StartDate != nil && d.EndDate != nil && !t.Before(*d.StartDate) && !t.After(*d.EndDate)
+}
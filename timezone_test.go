@@ -0,0 +1,160 @@
+package zomato_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+)
+
+func TestTimeZone(t *testing.T) {
+	tests := []struct {
+		cityID, countryID int64
+		want              string
+		err               error
+	}{
+		{1, 1, "Asia/Kolkata", nil},
+		{280, 216, "America/New_York", nil},
+		{306, 216, "America/Los_Angeles", nil},
+		{292, 216, "America/Chicago", nil},
+		{89, 37, "America/Toronto", nil},
+		{256, 37, "America/Vancouver", nil},
+		{260, 14, "Australia/Sydney", nil},
+		{296, 14, "Australia/Perth", nil},
+		{67, 30, "America/Sao_Paulo", nil},
+		{74, 94, "Asia/Jakarta", nil},
+		{61, 215, "Europe/London", nil},
+		{0, 97, "Europe/Dublin", nil},
+		{0, 112, "Asia/Beirut", nil},
+		// A city of a country of several time zones missing from CityTimeZones
+		{9999, 216, "", zomato.ErrNoTimeZone},
+		{0, 0, "", zomato.ErrNoTimeZone},
+	}
+
+	for _, tt := range tests {
+		loc, err := zomato.TimeZone(tt.cityID, tt.countryID)
+		if err != tt.err {
+			t.Errorf("TimeZone(%d, %d) error = %v, want %v", tt.cityID, tt.countryID, err, tt.err)
+			continue
+		}
+		if err == nil && loc.String() != tt.want {
+			t.Errorf("TimeZone(%d, %d) = %s, want %s", tt.cityID, tt.countryID, loc, tt.want)
+		}
+	}
+
+	for _, zones := range []map[int64]string{zomato.CityTimeZones, zomato.CountryTimeZones} {
+		for id, name := range zones {
+			if _, err := time.LoadLocation(name); err != nil {
+				t.Errorf("time zone %s of %d: %v", name, id, err)
+			}
+		}
+	}
+}
+
+// event decodes a restaurant of Delhi with event 'event'
+func event(t *testing.T, event string) zomato.Event {
+	var r zomato.Restaurant
+	data := `{"location":{"city_id":1,"country_id":1},"zomato_events":[{"event":` + event + `}]}`
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatal(err)
+	}
+	return *r.ZomatoEvents[0].Event
+}
+
+func TestEventIsActiveAt(t *testing.T) {
+	ist, err := zomato.TimeZone(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := func(d, h, m int) time.Time { return time.Date(2018, 4, d, h, m, 0, 0, ist) }
+
+	// As in testdata/LocationDetails.json
+	daily := event(t, `{"start_date":"2018-04-06","end_date":"2018-04-09","start_time":"11:30:00","end_time":"22:30:00","is_end_time_set":1}`)
+	// As in testdata/GeoCode.json
+	midnight := event(t, `{"start_date":"2018-04-01","end_date":"2018-04-30","start_time":"12:00:00","end_time":"00:00:00","is_end_time_set":1}`)
+	overnight := event(t, `{"start_date":"2018-04-06","end_date":"2018-04-07","start_time":"20:00:00","end_time":"02:00:00","is_end_time_set":1}`)
+	days := event(t, `{"start_date":"2018-04-06","end_date":"2018-04-07","start_time":"10:00:00","end_time":"00:00:00","is_end_time_set":0}`)
+
+	tests := []struct {
+		name string
+		e    zomato.Event
+		at   time.Time
+		want bool
+	}{
+		{"daily before start", daily, day(6, 11, 29), false},
+		{"daily start", daily, day(6, 11, 30), true},
+		{"daily start in UTC", daily, time.Date(2018, 4, 6, 6, 0, 0, 0, time.UTC), true},
+		{"daily UTC wall clock", daily, time.Date(2018, 4, 6, 11, 30, 0, 0, time.UTC), true},
+		{"daily night", daily, day(7, 23, 0), false},
+		{"daily morning", daily, day(8, 9, 0), false},
+		{"daily last evening", daily, day(9, 22, 29), true},
+		{"daily end", daily, day(9, 22, 30), false},
+		{"daily after", daily, day(10, 12, 0), false},
+		{"midnight evening", midnight, day(30, 23, 59), true},
+		{"midnight morning", midnight, day(15, 11, 59), false},
+		{"midnight end", midnight, time.Date(2018, 5, 1, 0, 0, 0, 0, ist), false},
+		{"overnight before", overnight, day(6, 19, 59), false},
+		{"overnight night", overnight, day(7, 1, 0), true},
+		{"overnight day", overnight, day(7, 12, 0), false},
+		{"overnight last night", overnight, day(8, 1, 59), true},
+		{"overnight end", overnight, day(8, 2, 0), false},
+		{"days", days, day(7, 23, 0), true},
+		{"days end", days, day(8, 0, 0), false},
+		{"no dates", zomato.Event{}, day(7, 12, 0), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.e.IsActiveAt(tt.at); got != tt.want {
+			t.Errorf("%s: IsActiveAt(%s) = %t, want %t", tt.name, tt.at, got, tt.want)
+		}
+	}
+
+	start, _ := daily.Start()
+	end, _ := daily.End()
+	if want := time.Date(2018, 4, 6, 6, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("Start() = %s, want %s", start, want)
+	}
+	if want := time.Date(2018, 4, 9, 17, 0, 0, 0, time.UTC); !end.Equal(want) {
+		t.Errorf("End() = %s, want %s", end, want)
+	}
+}
+
+func TestDailyMenuValidAt(t *testing.T) {
+	data, err := ioutil.ReadFile(testDataDir + "DailyMenu.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp zomato.DailyMenuResp
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	menu := *resp.DailyMenus[0].DailyMenu
+
+	// 01:30 on 9 April in Delhi, still 8 April in UTC
+	at := time.Date(2018, 4, 8, 20, 0, 0, 0, time.UTC)
+	if menu.ValidAt(at) {
+		t.Errorf("ValidAt(%s) = true in UTC", at)
+	}
+
+	ist, err := zomato.TimeZone(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	menu.In(ist)
+	for at, want := range map[time.Time]bool{
+		time.Date(2018, 4, 8, 20, 0, 0, 0, time.UTC): true,
+		time.Date(2018, 4, 9, 0, 0, 0, 0, ist):       true,
+		time.Date(2018, 4, 9, 23, 59, 59, 0, ist):    true,
+		time.Date(2018, 4, 9, 20, 0, 0, 0, time.UTC): false,
+		time.Date(2018, 4, 8, 23, 59, 0, 0, ist):     false,
+	} {
+		if got := menu.ValidAt(at); got != want {
+			t.Errorf("ValidAt(%s) = %t, want %t", at, got, want)
+		}
+	}
+	if got := (zomato.DailyMenu{}).ValidAt(at); got {
+		t.Error("ValidAt() without dates = true")
+	}
+}
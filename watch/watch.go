@@ -0,0 +1,366 @@
+// Package watch polls watched restaurants and notifies their changes.
+//
+// A Watcher polls Restaurant, DailyMenu and Reviews of each target on its
+// own schedule, stretched to fit the daily call budget, and notifies
+// rating drops, new events, published daily menus and new reviews. The
+// first poll of each target sets the baseline and notifies nothing.
+//
+// Notifications are usually delivered as signed webhook POSTs:
+//
+//	hook := &watch.Webhook{URL: "https://ops.example.com/zomato", Secret: secret, DeadLetter: "dead.jsonl"}
+//	w := &watch.Watcher{
+//		API:     client,
+//		Targets: []watch.Target{{RestaurantID: 16774318, Restaurant: time.Hour, DailyMenu: 6 * time.Hour}},
+//		Notify:  hook.Deliver,
+//	}
+//	err := w.Run(ctx)
+package watch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/diff"
+	"github.com/pkg/errors"
+)
+
+// DefaultBudget is the daily call quota of a free API key
+const DefaultBudget = 1000
+
+// Kind is the kind of a notification
+type Kind string
+
+// Notification kinds
+const (
+	RatingDropped      Kind = "rating_dropped"
+	EventAdded         Kind = "event_added"
+	DailyMenuPublished Kind = "daily_menu_published"
+	ReviewAdded        Kind = "review_added"
+)
+
+// Notification is a change of a watched restaurant
+type Notification struct {
+	// ID identifies the change, it is the same across deliveries
+	ID           string    `json:"id"`
+	Kind         Kind      `json:"kind"`
+	RestaurantID int64     `json:"restaurant_id"`
+	Restaurant   string    `json:"restaurant,omitempty"` // Name of the restaurant
+	Time         time.Time `json:"time"`                 // When the change was detected
+
+	// Old and new rating of RatingDropped notifications
+	OldRating *float64 `json:"old_rating,omitempty"`
+	NewRating *float64 `json:"new_rating,omitempty"`
+
+	Event     *zomato.Event     `json:"event,omitempty"`
+	DailyMenu *zomato.DailyMenu `json:"daily_menu,omitempty"`
+	Review    *zomato.Review    `json:"review,omitempty"`
+}
+
+// Target is a watched restaurant
+type Target struct {
+	RestaurantID int64
+	// Intervals between polls of Restaurant, for ratings and events,
+	// DailyMenu and Reviews; 0 disables polling
+	Restaurant, DailyMenu, Reviews time.Duration
+	// MinDrop is the smallest rating drop notified; any drop if 0
+	MinDrop float64
+}
+
+// Watcher polls targets and notifies their changes.
+//
+// Watcher is not safe for use by multiple go routines.
+type Watcher struct {
+	API     zomato.API
+	Targets []Target
+	// Notify delivers notifications, such as Webhook.Deliver
+	Notify func(ctx context.Context, n Notification) error
+
+	// Budget is the number of calls per day; defaults to DefaultBudget.
+	// Poll intervals are stretched evenly when targets need more.
+	Budget int
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+	// Logf logs polls and errors if not nil
+	Logf func(format string, args ...interface{})
+
+	polls []*poll
+	day   time.Time
+	calls int
+}
+
+// poll kinds
+const (
+	restaurantPoll = "restaurant"
+	dailyMenuPoll  = "daily_menu"
+	reviewsPoll    = "reviews"
+)
+
+// poll is a scheduled poll of an endpoint for a target
+type poll struct {
+	target   Target
+	kind     string
+	interval time.Duration
+	next     time.Time
+
+	primed bool
+	// last is the previous restaurant or reviews
+	last diff.Snapshot
+	// menus holds the IDs of daily menus seen
+	menus map[int64]bool
+}
+
+// Poll runs the polls that are due, as far as the budget allows, and
+// notifies changes found. It returns the first error met after running
+// the other polls.
+func (w *Watcher) Poll(ctx context.Context) error {
+	now := w.now()
+	w.schedule(now)
+
+	due := make([]*poll, 0, len(w.polls))
+	for _, p := range w.polls {
+		if !p.next.After(now) {
+			due = append(due, p)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].next.Before(due[j].next) })
+
+	var first error
+	for _, p := range due {
+		if w.calls >= w.budget() {
+			w.logf("budget of %d calls exhausted until %s", w.budget(), w.day.Add(24*time.Hour).Format(time.RFC3339))
+			break
+		}
+		w.calls++
+		p.next = now.Add(p.interval)
+
+		ns, err := w.run(ctx, p, now)
+		if err != nil {
+			err = errors.Wrapf(err, "poll %s of %d failed", p.kind, p.target.RestaurantID)
+			w.logf("%v", err)
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		for _, n := range ns {
+			w.logf("%s %s", n.Kind, n.ID)
+			if err := w.Notify(ctx, n); err != nil {
+				err = errors.Wrapf(err, "notify %s failed", n.ID)
+				w.logf("%v", err)
+				if first == nil {
+					first = err
+				}
+			}
+		}
+	}
+	return first
+}
+
+// Next returns when the next poll is due, or when the budget renews if it
+// is exhausted.
+func (w *Watcher) Next() time.Time {
+	w.schedule(w.now())
+
+	var next time.Time
+	for _, p := range w.polls {
+		if next.IsZero() || p.next.Before(next) {
+			next = p.next
+		}
+	}
+	if renew := w.day.Add(24 * time.Hour); w.calls >= w.budget() && next.Before(renew) {
+		next = renew
+	}
+	return next
+}
+
+// Run polls until 'ctx' is done, logging errors instead of returning them.
+func (w *Watcher) Run(ctx context.Context) error {
+	for {
+		w.Poll(ctx)
+
+		next := w.Next()
+		if next.IsZero() {
+			return errors.New("watch: nothing to poll")
+		}
+		t := time.NewTimer(next.Sub(w.now()))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Intervals returns the poll intervals of 'target' after fitting all
+// targets in the budget.
+func (w *Watcher) Intervals(target Target) (restaurant, dailyMenu, reviews time.Duration) {
+	stretch := w.stretch()
+	scale := func(d time.Duration) time.Duration {
+		return time.Duration(float64(d) * stretch)
+	}
+	return scale(target.Restaurant), scale(target.DailyMenu), scale(target.Reviews)
+}
+
+// stretch returns the factor poll intervals are multiplied with to fit the
+// budget.
+func (w *Watcher) stretch() float64 {
+	var perDay float64
+	for _, t := range w.Targets {
+		for _, d := range []time.Duration{t.Restaurant, t.DailyMenu, t.Reviews} {
+			if d > 0 {
+				perDay += float64(24*time.Hour) / float64(d)
+			}
+		}
+	}
+	if budget := float64(w.budget()); perDay > budget {
+		return perDay / budget
+	}
+	return 1
+}
+
+// schedule sets up the polls of the targets on first use and renews the
+// budget daily.
+func (w *Watcher) schedule(now time.Time) {
+	if w.day.IsZero() || !now.Before(w.day.Add(24*time.Hour)) {
+		w.day, w.calls = now, 0
+	}
+	if w.polls != nil {
+		return
+	}
+
+	w.polls = []*poll{}
+	for _, t := range w.Targets {
+		r, m, rv := w.Intervals(t)
+		for _, p := range []*poll{
+			{target: t, kind: restaurantPoll, interval: r},
+			{target: t, kind: dailyMenuPoll, interval: m, menus: map[int64]bool{}},
+			{target: t, kind: reviewsPoll, interval: rv},
+		} {
+			if p.interval > 0 {
+				p.next = now
+				w.polls = append(w.polls, p)
+			}
+		}
+	}
+}
+
+// run polls 'p', returning the changes since its previous poll.
+func (w *Watcher) run(ctx context.Context, p *poll, now time.Time) ([]Notification, error) {
+	id := p.target.RestaurantID
+	switch p.kind {
+	case restaurantPoll:
+		r, err := w.API.Restaurant(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if r.ID == nil {
+			r.ID = &id
+		}
+		return p.compare(diff.Snapshot{Restaurants: []zomato.Restaurant{r}}, now), nil
+
+	case reviewsPoll:
+		resp, err := w.API.Reviews(ctx, zomato.ReviewsReq{RestaurantID: id})
+		if err != nil {
+			return nil, err
+		}
+		s := diff.Snapshot{Restaurants: []zomato.Restaurant{{ID: &id}}, Reviews: map[int64][]zomato.Review{}}
+		for _, r := range resp.UserReviews {
+			if r.Review != nil {
+				s.Reviews[id] = append(s.Reviews[id], *r.Review)
+			}
+		}
+		return p.compare(s, now), nil
+
+	default:
+		resp, err := w.API.DailyMenu(ctx, id)
+		if apiErr, ok := errors.Cause(err).(*zomato.ErrAPI); ok && apiErr.StatusCode == http.StatusBadRequest {
+			// The API rejects restaurants without daily menu
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var ns []Notification
+		for _, m := range resp.DailyMenus {
+			if m.DailyMenu == nil || m.DailyMenu.ID == nil || p.menus[*m.DailyMenu.ID] {
+				continue
+			}
+			p.menus[*m.DailyMenu.ID] = true
+			if p.primed {
+				ns = append(ns, Notification{
+					ID:   fmt.Sprintf("%s-%d-%d", DailyMenuPublished, id, *m.DailyMenu.ID),
+					Kind: DailyMenuPublished, RestaurantID: id, Time: now, DailyMenu: m.DailyMenu,
+				})
+			}
+		}
+		p.primed = true
+		return ns, nil
+	}
+}
+
+// compare returns the changes from the previous snapshot of 'p' to 's',
+// which becomes the previous one.
+func (p *poll) compare(s diff.Snapshot, now time.Time) []Notification {
+	last, primed := p.last, p.primed
+	p.last, p.primed = s, true
+	if !primed {
+		return nil
+	}
+
+	c := diff.Compare(last, s)
+	var ns []Notification
+	notification := func(kind Kind, r zomato.Restaurant, key interface{}) Notification {
+		n := Notification{
+			ID:   fmt.Sprintf("%s-%d-%v", kind, p.target.RestaurantID, key),
+			Kind: kind, RestaurantID: p.target.RestaurantID, Time: now,
+		}
+		if r.Name != nil {
+			n.Restaurant = *r.Name
+		}
+		return n
+	}
+	for _, r := range c.Ratings {
+		if r.Old == nil || r.New == nil || *r.Old-*r.New <= 0 || *r.Old-*r.New < p.target.MinDrop {
+			continue
+		}
+		n := notification(RatingDropped, r.Restaurant, now.Unix())
+		n.OldRating, n.NewRating = r.Old, r.New
+		ns = append(ns, n)
+	}
+	for i := range c.Events {
+		n := notification(EventAdded, c.Events[i].Restaurant, *c.Events[i].Event.ID)
+		n.Event = &c.Events[i].Event
+		ns = append(ns, n)
+	}
+	for i := range c.Reviews {
+		n := notification(ReviewAdded, c.Reviews[i].Restaurant, *c.Reviews[i].Review.ID)
+		n.Review = &c.Reviews[i].Review
+		ns = append(ns, n)
+	}
+	return ns
+}
+
+func (w *Watcher) budget() int {
+	if w.Budget <= 0 {
+		return DefaultBudget
+	}
+	return w.Budget
+}
+
+func (w *Watcher) now() time.Time {
+	if w.Now == nil {
+		return time.Now()
+	}
+	return w.Now()
+}
+
+func (w *Watcher) logf(format string, args ...interface{}) {
+	if w.Logf != nil {
+		w.Logf(format, args...)
+	}
+}
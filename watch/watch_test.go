@@ -0,0 +1,222 @@
+package watch_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/testfixture"
+	"github.com/go-india/zomato/watch"
+	"github.com/go-india/zomato/zomatotest"
+)
+
+var (
+	ctx   = context.Background()
+	start = time.Date(2018, 4, 9, 10, 0, 0, 0, time.UTC)
+)
+
+// restaurant463 serves restaurant 463 of the testdata with changes made to
+// its fields.
+type restaurant463 struct {
+	rating  float64
+	events  []int64
+	reviews int
+	menus   []int64
+}
+
+func (r *restaurant463) mock(t *testing.T) *zomatotest.Mock {
+	var restaurant zomato.Restaurant
+	testfixture.Decode(t, "Restaurant.json", &restaurant)
+	var reviews zomato.ReviewsResp
+	testfixture.Decode(t, "Reviews.json", &reviews)
+	var menus zomato.DailyMenuResp
+	testfixture.Decode(t, "DailyMenu.json", &menus)
+
+	return &zomatotest.Mock{
+		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+			res := restaurant
+			rating, aggregate := *res.UserRating, r.rating
+			rating.AggregateRating = &aggregate
+			res.UserRating = &rating
+			res.ZomatoEvents = nil
+			for _, id := range r.events {
+				id := id
+				res.ZomatoEvents = append(res.ZomatoEvents, struct {
+					Event *zomato.Event `json:"event,omitempty"`
+				}{&zomato.Event{ID: &id}})
+			}
+			return res, nil
+		},
+		ReviewsFunc: func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error) {
+			resp := reviews
+			resp.UserReviews = resp.UserReviews[len(resp.UserReviews)-r.reviews:]
+			return resp, nil
+		},
+		DailyMenuFunc: func(ctx context.Context, restaurantID int64) (zomato.DailyMenuResp, error) {
+			if len(r.menus) == 0 {
+				return zomato.DailyMenuResp{}, &zomato.ErrAPI{StatusCode: http.StatusBadRequest}
+			}
+			resp := zomato.DailyMenuResp{}
+			for _, id := range r.menus {
+				m := *menus.DailyMenus[0].DailyMenu
+				id := id
+				m.ID = &id
+				resp.DailyMenus = append(resp.DailyMenus, struct {
+					DailyMenu *zomato.DailyMenu `json:"daily_menu,omitempty"`
+				}{&m})
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	r := &restaurant463{rating: 3.7, reviews: 4}
+	m := r.mock(t)
+
+	now := start
+	var got []watch.Notification
+	w := &watch.Watcher{
+		API: m,
+		Targets: []watch.Target{{
+			RestaurantID: 463, Restaurant: time.Hour, DailyMenu: 2 * time.Hour, Reviews: time.Hour, MinDrop: 0.2,
+		}},
+		Notify: func(ctx context.Context, n watch.Notification) error {
+			got = append(got, n)
+			return nil
+		},
+		Now: func() time.Time { return now },
+	}
+
+	tests := []struct {
+		name   string
+		after  time.Duration
+		change func()
+		calls  int
+		want   []string
+	}{
+		{"baseline", 0, func() {}, 3, nil},
+		{"not due", 30 * time.Minute, func() { r.rating = 3.0 }, 0, nil},
+		{
+			name:  "restaurant and reviews",
+			after: time.Hour,
+			change: func() {
+				r.events = []int64{11}
+				r.reviews = 5
+				r.menus = []int64{19610530}
+			},
+			calls: 2,
+			want:  []string{"rating_dropped-463-1523271600", "event_added-463-11", "review_added-463-34508218"},
+		},
+		{"small drop", 2 * time.Hour, func() { r.rating = 2.9 }, 3, []string{"daily_menu_published-463-19610530"}},
+		{"rise", 3 * time.Hour, func() { r.rating = 4.0 }, 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = start.Add(tt.after)
+			tt.change()
+			m.Reset()
+			got = nil
+
+			if err := w.Poll(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if calls := len(m.Calls()); calls != tt.calls {
+				t.Errorf("Poll() made %d calls, want %d", calls, tt.calls)
+			}
+			var ids []string
+			for _, n := range got {
+				ids = append(ids, n.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("Poll() notified %q, want %q", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatcher_Notification(t *testing.T) {
+	r := &restaurant463{rating: 3.7}
+	now := start
+	var got []watch.Notification
+	w := &watch.Watcher{
+		API:     r.mock(t),
+		Targets: []watch.Target{{RestaurantID: 463, Restaurant: time.Hour}},
+		Notify: func(ctx context.Context, n watch.Notification) error {
+			got = append(got, n)
+			return nil
+		},
+		Now: func() time.Time { return now },
+	}
+	if err := w.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	r.rating = 3.2
+	now = now.Add(time.Hour)
+	if err := w.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("Poll() notified %d times, want 1", len(got))
+	}
+	n := got[0]
+	if n.Kind != watch.RatingDropped || n.RestaurantID != 463 || n.Restaurant != "Karim's" ||
+		*n.OldRating != 3.7 || *n.NewRating != 3.2 || !n.Time.Equal(now) {
+		t.Errorf("notification = %+v", n)
+	}
+}
+
+func TestWatcher_Budget(t *testing.T) {
+	r := &restaurant463{rating: 3.7, reviews: 5}
+	m := r.mock(t)
+	now := start
+	w := &watch.Watcher{
+		API: m,
+		Targets: []watch.Target{
+			{RestaurantID: 463, Restaurant: time.Hour, Reviews: time.Hour},
+			{RestaurantID: 464, Restaurant: 2 * time.Hour},
+		},
+		Notify: func(ctx context.Context, n watch.Notification) error { return nil },
+		Budget: 30,
+		Now:    func() time.Time { return now },
+	}
+
+	// 60 calls a day fit in 30 by polling half as often
+	rest, menu, reviews := w.Intervals(w.Targets[0])
+	if rest != 2*time.Hour || menu != 0 || reviews != 2*time.Hour {
+		t.Errorf("Intervals() = %v, %v, %v, want 2h0m0s, 0s, 2h0m0s", rest, menu, reviews)
+	}
+
+	if err := w.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.Next(), start.Add(2*time.Hour); !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+
+	// A budget of 2 calls leaves one poll due until it renews
+	m.Reset()
+	w2 := &watch.Watcher{API: m, Targets: w.Targets, Notify: w.Notify, Budget: 2, Now: w.Now}
+	if err := w2.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(m.Calls()), 2; got != want {
+		t.Errorf("Poll() made %d calls, want %d", got, want)
+	}
+	if got, want := w2.Next(), start.Add(24*time.Hour); !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+
+	now = start.Add(24 * time.Hour)
+	m.Reset()
+	if err := w2.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := m.CallsTo("Restaurant"), 1; len(got) != want || got[0].Req.(zomato.RestaurantReq).RestaurantID != 464 {
+		t.Errorf("Poll() after renewal made calls %v, want the skipped one first", m.Calls())
+	}
+}
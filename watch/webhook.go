@@ -0,0 +1,269 @@
+package watch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Webhook headers
+const (
+	// SignatureHeader holds "t=<unix time>,v1=<hex HMAC-SHA256 of t.body>"
+	SignatureHeader = "X-Zomato-Signature"
+	// DeliveryHeader holds the notification ID
+	DeliveryHeader = "X-Zomato-Delivery"
+	// KindHeader holds the notification kind
+	KindHeader = "X-Zomato-Event"
+)
+
+// Defaults of Webhook fields
+const (
+	DefaultRetries = 5
+	DefaultBackoff = time.Second
+)
+
+// ErrSignature is returned for requests without a valid signature
+var ErrSignature = errors.New("watch: invalid signature")
+
+// Webhook delivers notifications as signed JSON POSTs.
+//
+// Failed deliveries are retried with exponential backoff on network
+// errors, 408, 429 and 5xx responses. Notifications that could not be
+// delivered are appended to the dead letter file.
+// Webhook is safe for use by multiple go routines.
+type Webhook struct {
+	URL    string
+	Secret []byte
+	// Client sends the requests; defaults to http.DefaultClient
+	Client *http.Client
+
+	// Retries is the number of retries after the first attempt; defaults
+	// to DefaultRetries, negative disables them
+	Retries int
+	// Backoff is the wait before the first retry, doubled after each;
+	// defaults to DefaultBackoff
+	Backoff time.Duration
+	// DeadLetter is the path of the JSON lines file undelivered
+	// notifications are appended to; they are dropped if empty
+	DeadLetter string
+
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+
+	mu sync.Mutex // guards the dead letter file
+}
+
+// DeadLetter is a notification that could not be delivered
+type DeadLetter struct {
+	Notification Notification `json:"notification"`
+	URL          string       `json:"url"`
+	Attempts     int          `json:"attempts"`
+	Error        string       `json:"error"`
+	Time         time.Time    `json:"time"`
+}
+
+// Deliver posts notification 'n', retrying failed attempts. If all fail, it
+// records a dead letter and returns the last error.
+func (h *Webhook) Deliver(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return errors.Wrap(err, "encode notification failed")
+	}
+
+	retries := h.Retries
+	if retries == 0 {
+		retries = DefaultRetries
+	}
+	backoff := h.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+
+	var attempts int
+	for {
+		attempts++
+		wait, err := h.post(ctx, n, body)
+		if err == nil {
+			return nil
+		}
+		if wait < 0 || attempts > retries || ctx.Err() != nil {
+			return h.deadLetter(n, attempts, err)
+		}
+
+		if wait < backoff {
+			wait = backoff
+		}
+		backoff *= 2
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return h.deadLetter(n, attempts, err)
+		case <-t.C:
+		}
+	}
+}
+
+// post makes a delivery attempt. On failure it returns how long the
+// receiver asked to wait before retrying, or -1 if retrying is pointless.
+func (h *Webhook) post(ctx context.Context, n Notification, body []byte) (time.Duration, error) {
+	req, err := http.NewRequest(http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return -1, errors.Wrap(err, "new request failed")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(h.Secret, h.now(), body))
+	req.Header.Set(DeliveryHeader, n.ID)
+	req.Header.Set(KindHeader, string(n.Kind))
+
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "post failed")
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	switch code := resp.StatusCode; {
+	case code >= 200 && code < 300:
+		return 0, nil
+	case code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable:
+		secs, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return time.Duration(secs) * time.Second, errors.Errorf("receiver returned %d", code)
+	case code == http.StatusRequestTimeout || code >= 500:
+		return 0, errors.Errorf("receiver returned %d", code)
+	default:
+		return -1, errors.Errorf("receiver returned %d", code)
+	}
+}
+
+// deadLetter appends 'n' to the dead letter file and returns 'err'.
+func (h *Webhook) deadLetter(n Notification, attempts int, err error) error {
+	err = errors.Wrapf(err, "deliver %s after %d attempts failed", n.ID, attempts)
+	if h.DeadLetter == "" {
+		return err
+	}
+
+	line, merr := json.Marshal(DeadLetter{Notification: n, URL: h.URL, Attempts: attempts, Error: err.Error(), Time: h.now()})
+	if merr != nil {
+		return errors.Wrap(merr, "encode dead letter failed")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	f, ferr := os.OpenFile(h.DeadLetter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if ferr != nil {
+		return errors.Wrap(ferr, "write dead letter failed")
+	}
+	if _, ferr := f.Write(append(line, '\n')); ferr != nil {
+		f.Close()
+		return errors.Wrap(ferr, "write dead letter failed")
+	}
+	if ferr := f.Close(); ferr != nil {
+		return errors.Wrap(ferr, "write dead letter failed")
+	}
+	return err
+}
+
+// ReadDeadLetters returns the dead letters of the file at 'path'.
+func ReadDeadLetters(path string) ([]DeadLetter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read dead letters failed")
+	}
+	defer f.Close()
+
+	var dls []DeadLetter
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<24)
+	for scanner.Scan() {
+		var dl DeadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &dl); err != nil {
+			return nil, errors.Wrapf(err, "decode dead letter %d failed", len(dls)+1)
+		}
+		dls = append(dls, dl)
+	}
+	return dls, errors.Wrap(scanner.Err(), "read dead letters failed")
+}
+
+// Sign returns the signature header of 'body' sent at 't'.
+func Sign(secret []byte, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, mac(secret, ts, body))
+}
+
+// Verify checks signature header 'sig' of 'body', rejecting signatures
+// older than 'tolerance' at 'now' if it is not 0.
+func Verify(secret []byte, sig string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts, v1 string
+	for _, part := range strings.Split(sig, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			v1 = kv[1]
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || !hmac.Equal([]byte(v1), []byte(mac(secret, ts, body))) {
+		return ErrSignature
+	}
+	if age := now.Sub(time.Unix(unix, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return errors.Wrapf(ErrSignature, "signed %s ago", age)
+	}
+	return nil
+}
+
+// Receive reads the notification of webhook request 'r' after verifying
+// its signature.
+func Receive(r *http.Request, secret []byte, tolerance time.Duration) (Notification, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return Notification{}, errors.Wrap(err, "read notification failed")
+	}
+	if err := Verify(secret, r.Header.Get(SignatureHeader), body, time.Now(), tolerance); err != nil {
+		return Notification{}, err
+	}
+
+	var n Notification
+	return n, errors.Wrap(json.Unmarshal(body, &n), "decode notification failed")
+}
+
+func mac(secret []byte, ts string, body []byte) string {
+	m := hmac.New(sha256.New, secret)
+	m.Write([]byte(ts))
+	m.Write([]byte{'.'})
+	m.Write(body)
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+func (h *Webhook) now() time.Time {
+	if h.Now == nil {
+		return time.Now()
+	}
+	return h.Now()
+}
@@ -0,0 +1,152 @@
+package watch_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-india/zomato/watch"
+	"github.com/pkg/errors"
+)
+
+var secret = []byte("s3cret")
+
+// receiver is a local webhook receiver answering with 'codes' in turn,
+// then 200.
+type receiver struct {
+	mu       sync.Mutex
+	codes    []int
+	attempts int
+	received []watch.Notification
+	headers  []http.Header
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.attempts++
+
+	n, err := watch.Receive(r, secret, time.Minute)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if len(rc.codes) > 0 {
+		code := rc.codes[0]
+		rc.codes = rc.codes[1:]
+		w.WriteHeader(code)
+		return
+	}
+	rc.received = append(rc.received, n)
+	rc.headers = append(rc.headers, r.Header)
+}
+
+func TestWebhook_Deliver(t *testing.T) {
+	rating := 3.2
+	n := watch.Notification{ID: "rating_dropped-463-1", Kind: watch.RatingDropped, RestaurantID: 463, NewRating: &rating}
+
+	tests := []struct {
+		name     string
+		codes    []int
+		secret   []byte
+		retries  int
+		attempts int
+		dead     bool
+	}{
+		{"delivered", nil, secret, 2, 1, false},
+		{"retried", []int{500, 503, 429}, secret, 3, 4, false},
+		{"exhausted", []int{500, 500, 500, 500}, secret, 2, 3, true},
+		{"rejected", []int{410}, secret, 2, 1, true},
+		{"unsigned", nil, []byte("wrong"), 2, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &receiver{codes: tt.codes}
+			srv := httptest.NewServer(rc)
+			defer srv.Close()
+
+			dir, err := ioutil.TempDir("", "watch")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			h := &watch.Webhook{
+				URL: srv.URL, Secret: tt.secret, Retries: tt.retries,
+				Backoff: time.Millisecond, DeadLetter: filepath.Join(dir, "dead.jsonl"),
+			}
+			err = h.Deliver(ctx, n)
+			if (err != nil) != tt.dead {
+				t.Fatalf("Deliver() error = %v, want error %v", err, tt.dead)
+			}
+			if rc.attempts != tt.attempts {
+				t.Errorf("Deliver() made %d attempts, want %d", rc.attempts, tt.attempts)
+			}
+
+			dls, err := watch.ReadDeadLetters(h.DeadLetter)
+			if !tt.dead {
+				if !os.IsNotExist(errors.Cause(err)) {
+					t.Errorf("ReadDeadLetters() = %v, %v, want none", dls, err)
+				}
+				if len(rc.received) != 1 || rc.received[0].ID != n.ID || *rc.received[0].NewRating != rating {
+					t.Errorf("received %+v, want %+v", rc.received, n)
+				}
+				if got := rc.headers[0].Get(watch.DeliveryHeader); got != n.ID {
+					t.Errorf("%s = %q, want %q", watch.DeliveryHeader, got, n.ID)
+				}
+				if got := rc.headers[0].Get(watch.KindHeader); got != string(n.Kind) {
+					t.Errorf("%s = %q, want %q", watch.KindHeader, got, n.Kind)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(dls) != 1 || dls[0].Notification.ID != n.ID || dls[0].Attempts != tt.attempts || dls[0].URL != srv.URL {
+				t.Errorf("ReadDeadLetters() = %+v", dls)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1523268000, 0)
+	body := []byte(`{"id":"1"}`)
+	sig := watch.Sign(secret, now, body)
+	if want := "t=1523268000,v1="; sig[:len(want)] != want {
+		t.Errorf("Sign() = %q, want prefix %q", sig, want)
+	}
+
+	tests := []struct {
+		name   string
+		secret []byte
+		sig    string
+		body   string
+		now    time.Time
+		ok     bool
+	}{
+		{"valid", secret, sig, `{"id":"1"}`, now, true},
+		{"within tolerance", secret, sig, `{"id":"1"}`, now.Add(time.Minute), true},
+		{"expired", secret, sig, `{"id":"1"}`, now.Add(time.Hour), false},
+		{"tampered", secret, sig, `{"id":"2"}`, now, false},
+		{"wrong secret", []byte("other"), sig, `{"id":"1"}`, now, false},
+		{"malformed", secret, "v1=abc", `{"id":"1"}`, now, false},
+		{"empty", secret, "", `{"id":"1"}`, now, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := watch.Verify(tt.secret, tt.sig, []byte(tt.body), tt.now, 5*time.Minute)
+			if (err == nil) != tt.ok {
+				t.Errorf("Verify() = %v, want ok %v", err, tt.ok)
+			}
+			if err != nil && errors.Cause(err) != watch.ErrSignature {
+				t.Errorf("Verify() = %v, want ErrSignature", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package zomato
 
 import "gopkg.in/go-playground/validator.v9"
 
+//go:generate go run ./internal/cmd/zomato-gen -spec swagger/swagger.json -overlay swagger/overlay.json -o requests_gen.go -mock zomatotest/mock_gen.go
+
 // Sort defines sort types used for sorting in searching
 type Sort string
 
@@ -39,3 +41,29 @@ var validate = validator.New()
 
 // newBool initialises a new bool and returns its address
 func newBool(b bool) *bool { return &b }
+
+// boolToUint8 returns 1 if 'b' points to true, 0 otherwise
+func boolToUint8(b *bool) uint8 {
+	if b != nil && *b {
+		return 1
+	}
+	return 0
+}
+
+// uint8ToBool returns whether 'u' points to 1, or nil if 'u' is nil
+func uint8ToBool(u *uint8) *bool {
+	if u == nil {
+		return nil
+	}
+	return newBool(*u == 1)
+}
+
+// boolToUint8Ptr returns 1 or 0 as 'b' points to true or false, or nil if
+// 'b' is nil
+func boolToUint8Ptr(b *bool) *uint8 {
+	if b == nil {
+		return nil
+	}
+	u := boolToUint8(b)
+	return &u
+}
@@ -2,6 +2,7 @@ package zomato_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -9,10 +10,12 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/internal/contract"
 	"github.com/pkg/errors"
 )
 
@@ -104,3 +107,169 @@ func filename(t *testing.T) string {
 	name = strings.TrimPrefix(name, "Test")
 	return name + ".json"
 }
+
+// droppedKeys are the keys of golden files which models do not hold, by the
+// accepted contract finding in swagger/contract.accept explaining them
+var droppedKeys = map[string]string{
+	"LocationDetails.json $.popularity":      "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.nightlife_index": "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.nearby_res":      "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.top_cuisines":    "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.popularity_res":  "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.nightlife_res":   "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.subzone":         "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.subzone_id":      "LocationDetailsResp popularity missing",
+	"LocationDetails.json $.city":            "LocationDetailsResp popularity missing",
+}
+
+func TestMarshalJSON(t *testing.T) {
+	accepted, err := contract.ReadAccept("swagger/contract.accept")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		file string
+		new  func() interface{}
+	}{
+		{"Categories.json", func() interface{} { return &zomato.CategoriesResp{} }},
+		{"Cities.json", func() interface{} { return &zomato.CitiesResp{} }},
+		{"Collections.json", func() interface{} { return &zomato.CollectionsResp{} }},
+		{"Cuisines.json", func() interface{} { return &zomato.CuisinesResp{} }},
+		{"DailyMenu.json", func() interface{} { return &zomato.DailyMenuResp{} }},
+		{"Establishments.json", func() interface{} { return &zomato.EstablishmentsResp{} }},
+		{"GeoCode.json", func() interface{} { return &zomato.GeoCodeResp{} }},
+		{"LocationDetails.json", func() interface{} { return &zomato.LocationDetailsResp{} }},
+		{"Locations.json", func() interface{} { return &zomato.LocationsResp{} }},
+		{"Restaurant.json", func() interface{} { return &zomato.Restaurant{} }},
+		{"Reviews.json", func() interface{} { return &zomato.ReviewsResp{} }},
+		{"Search.json", func() interface{} { return &zomato.SearchResp{} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			golden, err := ioutil.ReadFile(testDataDir + tt.file)
+			if err != nil {
+				t.Fatalf("read golden file failed: %+v", err)
+			}
+
+			first := tt.new()
+			if err := json.Unmarshal(golden, first); err != nil {
+				t.Fatalf("unmarshal golden file failed: %+v", err)
+			}
+
+			data, err := json.Marshal(first)
+			if err != nil {
+				t.Fatalf("marshal failed: %+v", err)
+			}
+
+			second := tt.new()
+			if err := json.Unmarshal(data, second); err != nil {
+				t.Fatalf("unmarshal marshalled data failed: %+v", err)
+			}
+
+			if !reflect.DeepEqual(first, second) {
+				t.Fatal("unmarshal → marshal → unmarshal is lossy")
+			}
+
+			var want, got interface{}
+			json.Unmarshal(golden, &want)
+			json.Unmarshal(data, &got)
+			if key := unknownKey(want, got, "$"); key != "" {
+				t.Fatalf("marshalled key %s is not in the API wire format", key)
+			}
+			if key := typeMismatch(want, got, "$"); key != "" {
+				t.Fatalf("marshalled value %s is not of its API wire format type", key)
+			}
+			for _, key := range missingKeys(want, got, "$") {
+				if finding, ok := droppedKeys[tt.file+" "+key]; !ok || !accepted[finding] {
+					t.Errorf("API wire format key %s is not marshalled", key)
+				}
+			}
+		})
+	}
+}
+
+// unknownKey returns path of the first object key in 'got' which 'want' does not have.
+func unknownKey(want, got interface{}, path string) string {
+	switch g := got.(type) {
+	case map[string]interface{}:
+		w, _ := want.(map[string]interface{})
+		for k, v := range g {
+			wv, ok := w[k]
+			if !ok {
+				return path + "." + k
+			}
+			if key := unknownKey(wv, v, path+"."+k); key != "" {
+				return key
+			}
+		}
+	case []interface{}:
+		w, _ := want.([]interface{})
+		for i, v := range g {
+			if i >= len(w) {
+				return fmt.Sprintf("%s[%d]", path, i)
+			}
+			if key := unknownKey(w[i], v, fmt.Sprintf("%s[%d]", path, i)); key != "" {
+				return key
+			}
+		}
+	}
+	return ""
+}
+
+// typeMismatch returns the path of the first value in 'got' of another JSON
+// type than in 'want'.
+func typeMismatch(want, got interface{}, path string) string {
+	if want == nil {
+		return ""
+	}
+	if reflect.TypeOf(want) != reflect.TypeOf(got) {
+		return fmt.Sprintf("%s (%T, want %T)", path, got, want)
+	}
+	switch g := got.(type) {
+	case map[string]interface{}:
+		w := want.(map[string]interface{})
+		for k, v := range g {
+			if key := typeMismatch(w[k], v, path+"."+k); key != "" {
+				return key
+			}
+		}
+	case []interface{}:
+		w := want.([]interface{})
+		for i, v := range g {
+			if i < len(w) {
+				if key := typeMismatch(w[i], v, fmt.Sprintf("%s[%d]", path, i)); key != "" {
+					return key
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// missingKeys returns the paths of object keys in 'want' which 'got' does
+// not have, with array indexes left out.
+func missingKeys(want, got interface{}, path string) []string {
+	var keys []string
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, _ := got.(map[string]interface{})
+		for k, v := range w {
+			gv, ok := g[k]
+			if !ok {
+				keys = append(keys, path+"."+k)
+				continue
+			}
+			keys = append(keys, missingKeys(v, gv, path+"."+k)...)
+		}
+	case []interface{}:
+		g, _ := got.([]interface{})
+		for i, v := range w {
+			if i < len(g) {
+				keys = append(keys, missingKeys(v, g[i], path+"[]")...)
+			}
+		}
+	}
+	return keys
+}
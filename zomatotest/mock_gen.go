@@ -0,0 +1,148 @@
+// Code generated by zomato-gen from swagger/swagger.json; DO NOT EDIT.
+
+package zomatotest
+
+import (
+	"context"
+
+	"github.com/go-india/zomato"
+)
+
+// Mock implements zomato.API by calling its function fields, recording
+// each call. Methods whose function is nil return ErrNotMocked.
+type Mock struct {
+	recorder
+
+	CategoriesFunc      func(ctx context.Context) (zomato.CategoriesResp, error)
+	CitiesFunc          func(ctx context.Context, req zomato.CitiesReq) (zomato.CitiesResp, error)
+	CollectionsFunc     func(ctx context.Context, req zomato.CollectionsReq) (zomato.CollectionsResp, error)
+	CuisinesFunc        func(ctx context.Context, req zomato.CuisinesReq) (zomato.CuisinesResp, error)
+	DailyMenuFunc       func(ctx context.Context, restaurantID int64) (zomato.DailyMenuResp, error)
+	EstablishmentsFunc  func(ctx context.Context, req zomato.EstablishmentsReq) (zomato.EstablishmentsResp, error)
+	GeoCodeFunc         func(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error)
+	LocationDetailsFunc func(ctx context.Context, entityID int64, entityType zomato.EntityType) (zomato.LocationDetailsResp, error)
+	LocationsFunc       func(ctx context.Context, req zomato.LocationsReq) (zomato.LocationsResp, error)
+	RestaurantFunc      func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error)
+	ReviewsFunc         func(ctx context.Context, req zomato.ReviewsReq) (zomato.ReviewsResp, error)
+	SearchFunc          func(ctx context.Context, req zomato.SearchReq) (zomato.SearchResp, error)
+}
+
+var _ zomato.API = &Mock{}
+
+// Categories implements zomato.API
+func (m *Mock) Categories(ctx context.Context) (resp zomato.CategoriesResp, err error) {
+	m.record("Categories", zomato.CategoriesReq{})
+	if m.CategoriesFunc == nil {
+		return resp, notMocked("Categories")
+	}
+	return m.CategoriesFunc(ctx)
+}
+
+// Cities implements zomato.API
+func (m *Mock) Cities(ctx context.Context, req zomato.CitiesReq) (resp zomato.CitiesResp, err error) {
+	m.record("Cities", req)
+	if m.CitiesFunc == nil {
+		return resp, notMocked("Cities")
+	}
+	return m.CitiesFunc(ctx, req)
+}
+
+// Collections implements zomato.API
+func (m *Mock) Collections(ctx context.Context, req zomato.CollectionsReq) (resp zomato.CollectionsResp, err error) {
+	m.record("Collections", req)
+	if m.CollectionsFunc == nil {
+		return resp, notMocked("Collections")
+	}
+	return m.CollectionsFunc(ctx, req)
+}
+
+// Cuisines implements zomato.API
+func (m *Mock) Cuisines(ctx context.Context, req zomato.CuisinesReq) (resp zomato.CuisinesResp, err error) {
+	m.record("Cuisines", req)
+	if m.CuisinesFunc == nil {
+		return resp, notMocked("Cuisines")
+	}
+	return m.CuisinesFunc(ctx, req)
+}
+
+// DailyMenu implements zomato.API
+func (m *Mock) DailyMenu(ctx context.Context, restaurantID int64) (resp zomato.DailyMenuResp, err error) {
+	m.record("DailyMenu", zomato.DailyMenuReq{
+		RestaurantID: restaurantID,
+	})
+	if m.DailyMenuFunc == nil {
+		return resp, notMocked("DailyMenu")
+	}
+	return m.DailyMenuFunc(ctx, restaurantID)
+}
+
+// Establishments implements zomato.API
+func (m *Mock) Establishments(ctx context.Context, req zomato.EstablishmentsReq) (resp zomato.EstablishmentsResp, err error) {
+	m.record("Establishments", req)
+	if m.EstablishmentsFunc == nil {
+		return resp, notMocked("Establishments")
+	}
+	return m.EstablishmentsFunc(ctx, req)
+}
+
+// GeoCode implements zomato.API
+func (m *Mock) GeoCode(ctx context.Context, lat, long float64) (resp zomato.GeoCodeResp, err error) {
+	m.record("GeoCode", zomato.GeoCodeReq{
+		Latitude:  lat,
+		Longitude: long,
+	})
+	if m.GeoCodeFunc == nil {
+		return resp, notMocked("GeoCode")
+	}
+	return m.GeoCodeFunc(ctx, lat, long)
+}
+
+// LocationDetails implements zomato.API
+func (m *Mock) LocationDetails(ctx context.Context, entityID int64, entityType zomato.EntityType) (resp zomato.LocationDetailsResp, err error) {
+	m.record("LocationDetails", zomato.LocationDetailsReq{
+		EntityID:   entityID,
+		EntityType: entityType,
+	})
+	if m.LocationDetailsFunc == nil {
+		return resp, notMocked("LocationDetails")
+	}
+	return m.LocationDetailsFunc(ctx, entityID, entityType)
+}
+
+// Locations implements zomato.API
+func (m *Mock) Locations(ctx context.Context, req zomato.LocationsReq) (resp zomato.LocationsResp, err error) {
+	m.record("Locations", req)
+	if m.LocationsFunc == nil {
+		return resp, notMocked("Locations")
+	}
+	return m.LocationsFunc(ctx, req)
+}
+
+// Restaurant implements zomato.API
+func (m *Mock) Restaurant(ctx context.Context, restaurantID int64) (resp zomato.Restaurant, err error) {
+	m.record("Restaurant", zomato.RestaurantReq{
+		RestaurantID: restaurantID,
+	})
+	if m.RestaurantFunc == nil {
+		return resp, notMocked("Restaurant")
+	}
+	return m.RestaurantFunc(ctx, restaurantID)
+}
+
+// Reviews implements zomato.API
+func (m *Mock) Reviews(ctx context.Context, req zomato.ReviewsReq) (resp zomato.ReviewsResp, err error) {
+	m.record("Reviews", req)
+	if m.ReviewsFunc == nil {
+		return resp, notMocked("Reviews")
+	}
+	return m.ReviewsFunc(ctx, req)
+}
+
+// Search implements zomato.API
+func (m *Mock) Search(ctx context.Context, req zomato.SearchReq) (resp zomato.SearchResp, err error) {
+	m.record("Search", req)
+	if m.SearchFunc == nil {
+		return resp, notMocked("Search")
+	}
+	return m.SearchFunc(ctx, req)
+}
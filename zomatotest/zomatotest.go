@@ -0,0 +1,71 @@
+// Package zomatotest provides a mock of zomato.API for tests.
+//
+//	m := &zomatotest.Mock{
+//		RestaurantFunc: func(ctx context.Context, restaurantID int64) (zomato.Restaurant, error) {
+//			return zomato.Restaurant{Name: &name}, nil
+//		},
+//	}
+//	code.Under(test, m)
+//	calls := m.Calls() // [{Restaurant {RestaurantID:463}}]
+//
+// Arguments of methods not taking a request are recorded as the request of
+// the endpoint.
+package zomatotest
+
+import (
+	"sync"
+
+	"github.com/go-india/zomato"
+	"github.com/pkg/errors"
+)
+
+// ErrNotMocked is returned by calls to methods without a function
+var ErrNotMocked = errors.New("zomatotest: method not mocked")
+
+// Call is a recorded call of a Mock method
+type Call struct {
+	Method string
+	Req    zomato.Requester
+}
+
+// recorder records calls, safe for use by multiple go routines
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made so far, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made so far to 'method', in order.
+func (r *recorder) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made so far.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func (r *recorder) record(method string, req zomato.Requester) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Req: req})
+}
+
+// notMocked returns ErrNotMocked for 'method'.
+func notMocked(method string) error {
+	return errors.Wrap(ErrNotMocked, method)
+}
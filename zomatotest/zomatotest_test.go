@@ -0,0 +1,62 @@
+package zomatotest_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/go-india/zomato"
+	"github.com/go-india/zomato/zomatotest"
+	"github.com/pkg/errors"
+)
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+	m := &zomatotest.Mock{
+		GeoCodeFunc: func(ctx context.Context, lat, long float64) (zomato.GeoCodeResp, error) {
+			return zomato.GeoCodeResp{}, nil
+		},
+	}
+
+	if _, err := m.GeoCode(ctx, 28.5, 77.2); err != nil {
+		t.Errorf("GeoCode error = %v", err)
+	}
+	if _, err := m.Search(ctx, zomato.SearchReq{Query: "biryani"}); errors.Cause(err) != zomatotest.ErrNotMocked {
+		t.Errorf("Search error = %v, want ErrNotMocked", err)
+	}
+	m.LocationDetails(ctx, 1, zomato.CityEntity)
+
+	want := []zomatotest.Call{
+		{Method: "GeoCode", Req: zomato.GeoCodeReq{Latitude: 28.5, Longitude: 77.2}},
+		{Method: "Search", Req: zomato.SearchReq{Query: "biryani"}},
+		{Method: "LocationDetails", Req: zomato.LocationDetailsReq{EntityID: 1, EntityType: zomato.CityEntity}},
+	}
+	if got := m.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %+v, want %+v", got, want)
+	}
+	if got := m.CallsTo("Search"); !reflect.DeepEqual(got, want[1:2]) {
+		t.Errorf("calls to Search = %+v", got)
+	}
+
+	m.Reset()
+	if got := m.Calls(); len(got) != 0 {
+		t.Errorf("calls after reset = %+v", got)
+	}
+}
+
+func TestMockConcurrent(t *testing.T) {
+	m := &zomatotest.Mock{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Categories(context.Background())
+		}()
+	}
+	wg.Wait()
+	if got := len(m.CallsTo("Categories")); got != 10 {
+		t.Errorf("got %d calls, want 10", got)
+	}
+}